@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 
 	"fmt"
+	"io"
 	"io/ioutil"
 	"log"
 	"os"
@@ -30,10 +31,12 @@ import (
 	"github.com/gaterace/dml-go/pkg/dml"
 	pb "github.com/gaterace/mproject/pkg/mserviceproject"
 	"github.com/kylelemons/go-gypsy/yaml"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/grpclog"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 
 	flag "github.com/juju/gnuflag"
 )
@@ -57,6 +60,24 @@ var version = flag.Int64("version", -1, "version")
 var priority = flag.Int64("priority", -1, "priority")
 var parent = flag.Int64("parent", 0, "parent id")
 var position = flag.Int64("position", -1, "position")
+var seq = flag.Int64("seq", 0, "event sequence")
+var url = flag.String("url", "", "webhook url")
+var events = flag.String("events", "", "list of webhook event types")
+var active = flag.Bool("active", true, "webhook is active")
+var hid = flag.Int64("hid", -1, "webhook identifier")
+var did = flag.Int64("did", -1, "webhook delivery identifier")
+var limit = flag.Int64("limit", 0, "maximum number of results")
+var optout = flag.Bool("optout", false, "opt out of email notifications")
+var alloc = flag.Int64("alloc", -1, "percent allocation to project")
+var weeks = flag.Int64("weeks", 0, "number of weeks")
+var perid = flag.Int64("perid", 0, "person identifier")
+var uid = flag.Int64("uid", -1, "mservice user identifier")
+var gid = flag.Int64("gid", -1, "project grant identifier")
+var access = flag.String("access", "", "project access, one of owner, editor or viewer")
+var role = flag.String("role", "", "api key role, a projsvc claim value")
+var scopes = flag.String("scopes", "", "list of api key method scopes")
+var akid = flag.Int64("akid", -1, "api key identifier")
+var file = flag.String("file", "", "JSON file with the list of batch items")
 
 func main() {
 	flag.Parse(true)
@@ -121,15 +142,58 @@ func main() {
 		fmt.Printf("    %s get_task_wrapper_by_id --tid <task_id>\n", prog)
 		fmt.Printf("    %s reorder_child_tasks --tid <task_id> --list <child_id_list>\n", prog)
 
-		fmt.Printf("    %s create_team_member --pid <project_id> --name <name> --email <email> --rid <role_id>\n", prog)
+		fmt.Printf("    %s create_team_member --pid <project_id> (--name <name> --email <email> | --perid <person_id>) --rid <role_id>\n", prog)
 		fmt.Printf("    %s update_team_member --mid <member_id> --version <version> --name <name> --email <email> --rid <role_id>\n", prog)
 		fmt.Printf("    %s delete_team_member --mid <member_id> --version <version>\n", prog)
 		fmt.Printf("    %s get_team_member_by_id --mid <member_id>\n", prog)
 		fmt.Printf("    %s get_team_member_by_project --pid <project_id>\n", prog)
 		fmt.Printf("    %s get_team_member_by_task --tid <task_id>\n", prog)
 		fmt.Printf("    %s add_team_member_to_task --tid <task_id> --mid <member_id>\n", prog)
-		fmt.Printf("    %s remove_team_member_from_task --tid <task_id> --mid <member_id>\n", prog)
-		fmt.Printf("    %s add_task_hours --tid <task_id> --mid <member_id> --hours <hours>\n", prog)
+		fmt.Printf("    %s remove_team_member_from_task --tid <task_id> --mid <member_id> --version <version>\n", prog)
+		fmt.Printf("    %s add_task_hours --tid <task_id> --mid <member_id> --version <version> --hours <hours>\n", prog)
+		fmt.Printf("    %s set_task_hours --tid <task_id> --mid <member_id> --version <version> --hours <hours>\n", prog)
+		fmt.Printf("    %s set_member_notify --mid <member_id> [--optout]\n", prog)
+		fmt.Printf("    %s get_member_notify --mid <member_id>\n", prog)
+		fmt.Printf("    %s set_member_capacity --mid <member_id> --version <version> --hours <hours_per_day> --alloc <percent>\n", prog)
+		fmt.Printf("    %s get_member_capacity --mid <member_id>\n", prog)
+		fmt.Printf("    %s add_member_day_off --mid <member_id> --sdate <day_off> [--desc <reason>]\n", prog)
+		fmt.Printf("    %s remove_member_day_off --mid <member_id> --sdate <day_off>\n", prog)
+		fmt.Printf("    %s set_assignment_estimate --tid <task_id> --mid <member_id> --hours <hours>\n", prog)
+		fmt.Printf("    %s get_capacity_report --pid <project_id> [--sdate <start_date>] [--weeks <weeks>]\n", prog)
+		fmt.Printf("    %s get_overallocations --pid <project_id> [--sdate <start_date>] [--edate <end_date>]\n", prog)
+		fmt.Printf("    %s suggest_leveling --pid <project_id> [--sdate <start_date>]\n", prog)
+		fmt.Printf("    %s watch_project --pid <project_id> [--seq <sequence>]\n", prog)
+
+		fmt.Printf("    %s create_person --name <name> --email <email>\n", prog)
+		fmt.Printf("    %s update_person --perid <person_id> --version <version> --name <name> --email <email>\n", prog)
+		fmt.Printf("    %s delete_person --perid <person_id> --version <version>\n", prog)
+		fmt.Printf("    %s get_person_by_id --perid <person_id>\n", prog)
+		fmt.Printf("    %s get_person_by_email --email <email>\n", prog)
+		fmt.Printf("    %s get_persons\n", prog)
+		fmt.Printf("    %s get_person_projects --perid <person_id>\n", prog)
+		fmt.Printf("    %s get_person_tasks --perid <person_id>\n", prog)
+		fmt.Printf("    %s set_person_user --perid <person_id> --version <version> --uid <user_id>\n", prog)
+		fmt.Printf("    %s my_tasks\n", prog)
+
+		fmt.Printf("    %s create_project_grant --pid <project_id> (--uid <user_id> | --rid <role_id>) --access <access>\n", prog)
+		fmt.Printf("    %s update_project_grant --gid <grant_id> --version <version> --access <access>\n", prog)
+		fmt.Printf("    %s delete_project_grant --gid <grant_id> --version <version>\n", prog)
+		fmt.Printf("    %s get_project_grants --pid <project_id>\n", prog)
+
+		fmt.Printf("    %s create_api_key --name <name> --role <role> --edate <expires> [--scopes <scope_list>] [--uid <user_id>]\n", prog)
+		fmt.Printf("    %s revoke_api_key --akid <api_key_id> --version <version>\n", prog)
+		fmt.Printf("    %s get_api_keys\n", prog)
+
+		fmt.Printf("    %s batch_create_tasks --pid <project_id> --file <json_file>\n", prog)
+		fmt.Printf("    %s batch_update_tasks --pid <project_id> --file <json_file>\n", prog)
+		fmt.Printf("    %s batch_assign_members --pid <project_id> --file <json_file>\n", prog)
+
+		fmt.Printf("    %s create_webhook --url <url> --events <event_type_list> [--active=false]\n", prog)
+		fmt.Printf("    %s update_webhook --hid <webhook_id> --version <version> --url <url> --events <event_type_list> [--active=false]\n", prog)
+		fmt.Printf("    %s delete_webhook --hid <webhook_id> --version <version>\n", prog)
+		fmt.Printf("    %s get_webhooks\n", prog)
+		fmt.Printf("    %s get_webhook_deliveries --hid <webhook_id> [--limit <limit>]\n", prog)
+		fmt.Printf("    %s redeliver_webhook --did <delivery_id>\n", prog)
 
 		fmt.Printf("    %s get_server_version\n", prog)
 
@@ -150,6 +214,8 @@ func main() {
 	// var project_id int64
 	var task_hours *dml.Decimal
 	var id_list []int64
+	var event_types []string
+	var batch_data []byte
 
 	switch cmd {
 	case "create_project":
@@ -505,12 +571,12 @@ func main() {
 			validParams = false
 		}
 
-		if *name == "" {
+		if (*name == "") && (*perid == 0) {
 			fmt.Println("name parameter missing")
 			validParams = false
 		}
 
-		if *email == "" {
+		if (*email == "") && (*perid == 0) {
 			fmt.Println("email parameter missing")
 			validParams = false
 		}
@@ -588,7 +654,11 @@ func main() {
 			fmt.Println("task_id parameter missing")
 			validParams = false
 		}
-	case "add_task_hours":
+		if *version == -1 {
+			fmt.Println("version parameter missing")
+			validParams = false
+		}
+	case "add_task_hours", "set_task_hours":
 		if *mid == -1 {
 			fmt.Println("member_id parameter missing")
 			validParams = false
@@ -597,6 +667,41 @@ func main() {
 			fmt.Println("task_id parameter missing")
 			validParams = false
 		}
+		if *version == -1 {
+			fmt.Println("version parameter missing")
+			validParams = false
+		}
+		if *hours == "" {
+			fmt.Println("hours parameter missing")
+			validParams = false
+		} else {
+			task_hours, err = dml.DecimalFromString(*hours)
+			if err != nil {
+				fmt.Println("hours parameter not valid")
+				validParams = false
+			}
+		}
+	case "set_member_notify":
+		if *mid == -1 {
+			fmt.Println("member_id parameter missing")
+			validParams = false
+		}
+
+	case "get_member_notify":
+		if *mid == -1 {
+			fmt.Println("member_id parameter missing")
+			validParams = false
+		}
+
+	case "set_member_capacity":
+		if *mid == -1 {
+			fmt.Println("member_id parameter missing")
+			validParams = false
+		}
+		if *version == -1 {
+			fmt.Println("version parameter missing, use 0 if capacity not yet set")
+			validParams = false
+		}
 		if *hours == "" {
 			fmt.Println("hours parameter missing")
 			validParams = false
@@ -607,6 +712,316 @@ func main() {
 				validParams = false
 			}
 		}
+		if *alloc == -1 {
+			fmt.Println("alloc parameter missing")
+			validParams = false
+		}
+
+	case "get_member_capacity":
+		if *mid == -1 {
+			fmt.Println("member_id parameter missing")
+			validParams = false
+		}
+
+	case "add_member_day_off", "remove_member_day_off":
+		if *mid == -1 {
+			fmt.Println("member_id parameter missing")
+			validParams = false
+		}
+
+		date := *sdate
+		if !dateValidator.MatchString(date) {
+			fmt.Println("day_off parameter missing or not in yyyy-mm-dd format")
+			validParams = false
+		}
+
+		start_date = dml.DateTimeFromString(date)
+
+	case "set_assignment_estimate":
+		if *mid == -1 {
+			fmt.Println("member_id parameter missing")
+			validParams = false
+		}
+		if *tid == -1 {
+			fmt.Println("task_id parameter missing")
+			validParams = false
+		}
+		if *hours == "" {
+			fmt.Println("hours parameter missing")
+			validParams = false
+		} else {
+			task_hours, err = dml.DecimalFromString(*hours)
+			if err != nil {
+				fmt.Println("hours parameter not valid")
+				validParams = false
+			}
+		}
+
+	case "get_capacity_report":
+		if *pid == -1 {
+			fmt.Println("project_id parameter missing")
+			validParams = false
+		}
+
+		date := *sdate
+		if date != "" {
+			if !dateValidator.MatchString(date) {
+				fmt.Println("start_date parameter not in yyyy-mm-dd format")
+				validParams = false
+			}
+
+			start_date = dml.DateTimeFromString(date)
+		}
+
+	case "create_person":
+		if *name == "" {
+			fmt.Println("name parameter missing")
+			validParams = false
+		}
+		if *email == "" {
+			fmt.Println("email parameter missing")
+			validParams = false
+		}
+
+	case "update_person":
+		if *perid == 0 {
+			fmt.Println("person_id parameter missing")
+			validParams = false
+		}
+		if *version == -1 {
+			fmt.Println("version parameter missing")
+			validParams = false
+		}
+		if *name == "" {
+			fmt.Println("name parameter missing")
+			validParams = false
+		}
+		if *email == "" {
+			fmt.Println("email parameter missing")
+			validParams = false
+		}
+
+	case "delete_person":
+		if *perid == 0 {
+			fmt.Println("person_id parameter missing")
+			validParams = false
+		}
+		if *version == -1 {
+			fmt.Println("version parameter missing")
+			validParams = false
+		}
+
+	case "get_person_by_id", "get_person_projects", "get_person_tasks":
+		if *perid == 0 {
+			fmt.Println("person_id parameter missing")
+			validParams = false
+		}
+
+	case "get_person_by_email":
+		if *email == "" {
+			fmt.Println("email parameter missing")
+			validParams = false
+		}
+
+	case "get_persons":
+		validParams = true
+
+	case "set_person_user":
+		if *perid == 0 {
+			fmt.Println("person_id parameter missing")
+			validParams = false
+		}
+		if *version == -1 {
+			fmt.Println("version parameter missing")
+			validParams = false
+		}
+		if *uid == -1 {
+			fmt.Println("user_id parameter missing")
+			validParams = false
+		}
+
+	case "my_tasks":
+		validParams = true
+
+	case "create_project_grant":
+		if *pid == -1 {
+			fmt.Println("project_id parameter missing")
+			validParams = false
+		}
+		if (*uid == -1) && (*rid == -1) {
+			fmt.Println("user_id or role_id parameter missing")
+			validParams = false
+		}
+		if *access == "" {
+			fmt.Println("access parameter missing")
+			validParams = false
+		}
+
+	case "update_project_grant":
+		if *gid == -1 {
+			fmt.Println("grant_id parameter missing")
+			validParams = false
+		}
+		if *version == -1 {
+			fmt.Println("version parameter missing")
+			validParams = false
+		}
+		if *access == "" {
+			fmt.Println("access parameter missing")
+			validParams = false
+		}
+
+	case "delete_project_grant":
+		if *gid == -1 {
+			fmt.Println("grant_id parameter missing")
+			validParams = false
+		}
+		if *version == -1 {
+			fmt.Println("version parameter missing")
+			validParams = false
+		}
+
+	case "get_project_grants":
+		if *pid == -1 {
+			fmt.Println("project_id parameter missing")
+			validParams = false
+		}
+
+	case "create_api_key":
+		if *name == "" {
+			fmt.Println("name parameter missing")
+			validParams = false
+		}
+		if *role == "" {
+			fmt.Println("role parameter missing")
+			validParams = false
+		}
+
+		date := *edate
+		if !dateValidator.MatchString(date) {
+			fmt.Println("expires parameter missing or not in yyyy-mm-dd format")
+			validParams = false
+		}
+
+		end_date = dml.DateTimeFromString(date)
+
+	case "revoke_api_key":
+		if *akid == -1 {
+			fmt.Println("api_key_id parameter missing")
+			validParams = false
+		}
+		if *version == -1 {
+			fmt.Println("version parameter missing")
+			validParams = false
+		}
+
+	case "get_api_keys":
+
+	case "batch_create_tasks", "batch_update_tasks", "batch_assign_members":
+		if *pid == -1 {
+			fmt.Println("project_id parameter missing")
+			validParams = false
+		}
+		if *file == "" {
+			fmt.Println("file parameter missing")
+			validParams = false
+		} else {
+			batch_data, err = ioutil.ReadFile(*file)
+			if err != nil {
+				fmt.Println("file parameter not readable")
+				validParams = false
+			}
+		}
+
+	case "get_overallocations", "suggest_leveling":
+		if *pid == -1 {
+			fmt.Println("project_id parameter missing")
+			validParams = false
+		}
+
+		date := *sdate
+		if date != "" {
+			if !dateValidator.MatchString(date) {
+				fmt.Println("start_date parameter not in yyyy-mm-dd format")
+				validParams = false
+			}
+
+			start_date = dml.DateTimeFromString(date)
+		}
+
+		date = *edate
+		if date != "" {
+			if !dateValidator.MatchString(date) {
+				fmt.Println("end_date parameter not in yyyy-mm-dd format")
+				validParams = false
+			}
+
+			end_date = dml.DateTimeFromString(date)
+		}
+
+	case "watch_project":
+		if *pid == -1 {
+			fmt.Println("project_id parameter missing")
+			validParams = false
+		}
+
+	case "create_webhook":
+		if *url == "" {
+			fmt.Println("url parameter missing")
+			validParams = false
+		}
+		if *events == "" {
+			fmt.Println("events parameter missing")
+			validParams = false
+		} else {
+			event_types = strings.Split(*events, ",")
+		}
+
+	case "update_webhook":
+		if *hid == -1 {
+			fmt.Println("webhook_id parameter missing")
+			validParams = false
+		}
+		if *version == -1 {
+			fmt.Println("version parameter missing")
+			validParams = false
+		}
+		if *url == "" {
+			fmt.Println("url parameter missing")
+			validParams = false
+		}
+		if *events == "" {
+			fmt.Println("events parameter missing")
+			validParams = false
+		} else {
+			event_types = strings.Split(*events, ",")
+		}
+
+	case "delete_webhook":
+		if *hid == -1 {
+			fmt.Println("webhook_id parameter missing")
+			validParams = false
+		}
+		if *version == -1 {
+			fmt.Println("version parameter missing")
+			validParams = false
+		}
+
+	case "get_webhooks":
+		validParams = true
+
+	case "get_webhook_deliveries":
+		if *hid == -1 {
+			fmt.Println("webhook_id parameter missing")
+			validParams = false
+		}
+
+	case "redeliver_webhook":
+		if *did == -1 {
+			fmt.Println("delivery_id parameter missing")
+			validParams = false
+		}
+
 	case "get_server_version":
 		validParams = true
 
@@ -671,6 +1086,12 @@ func main() {
 	}
 
 	md := metadata.Pairs("token", savedToken)
+
+	// automation authenticates with an api key instead of a user's token
+	apiKey := os.Getenv("MPROJECT_API_KEY")
+	if apiKey != "" {
+		md = metadata.Pairs("apikey", apiKey)
+	}
 	mctx := metadata.NewOutgoingContext(ctx, md)
 
 	switch cmd {
@@ -984,6 +1405,7 @@ func main() {
 		req.Name = *name
 		req.Email = *email
 		req.ProjectRoleId = int32(*rid)
+		req.PersonId = *perid
 		resp, err := client.CreateTeamMember(mctx, &req)
 		printResponse(resp, err)
 
@@ -1033,6 +1455,7 @@ func main() {
 		req := pb.RemoveTeamMemberFromTaskRequest{}
 		req.MemberId = *mid
 		req.TaskId = *tid
+		req.Version = int32(*version)
 		resp, err := client.RemoveTeamMemberFromTask(mctx, &req)
 		printResponse(resp, err)
 
@@ -1040,9 +1463,308 @@ func main() {
 		req := pb.AddTaskHoursRequest{}
 		req.MemberId = *mid
 		req.TaskId = *tid
+		req.Version = int32(*version)
 		req.TaskHours = task_hours
 		resp, err := client.AddTaskHours(mctx, &req)
 		printResponse(resp, err)
+	case "set_task_hours":
+		req := pb.SetTaskHoursRequest{}
+		req.MemberId = *mid
+		req.TaskId = *tid
+		req.Version = int32(*version)
+		req.TaskHours = task_hours
+		resp, err := client.SetTaskHours(mctx, &req)
+		printResponse(resp, err)
+	case "set_member_notify":
+		req := pb.SetMemberNotifyRequest{}
+		req.MemberId = *mid
+		req.OptOut = *optout
+		resp, err := client.SetMemberNotify(mctx, &req)
+		printResponse(resp, err)
+
+	case "get_member_notify":
+		req := pb.GetMemberNotifyRequest{}
+		req.MemberId = *mid
+		resp, err := client.GetMemberNotify(mctx, &req)
+		printResponse(resp, err)
+
+	case "set_member_capacity":
+		req := pb.SetMemberCapacityRequest{}
+		req.MemberId = *mid
+		req.Version = int32(*version)
+		req.HoursPerDay = task_hours
+		req.AllocationPercent = int32(*alloc)
+		resp, err := client.SetMemberCapacity(mctx, &req)
+		printResponse(resp, err)
+
+	case "get_member_capacity":
+		req := pb.GetMemberCapacityRequest{}
+		req.MemberId = *mid
+		resp, err := client.GetMemberCapacity(mctx, &req)
+		printResponse(resp, err)
+
+	case "add_member_day_off":
+		req := pb.AddMemberDayOffRequest{}
+		req.MemberId = *mid
+		req.DayOff = start_date
+		req.Reason = *description
+		resp, err := client.AddMemberDayOff(mctx, &req)
+		printResponse(resp, err)
+
+	case "remove_member_day_off":
+		req := pb.RemoveMemberDayOffRequest{}
+		req.MemberId = *mid
+		req.DayOff = start_date
+		resp, err := client.RemoveMemberDayOff(mctx, &req)
+		printResponse(resp, err)
+
+	case "set_assignment_estimate":
+		req := pb.SetAssignmentEstimateRequest{}
+		req.MemberId = *mid
+		req.TaskId = *tid
+		req.EstimatedHours = task_hours
+		resp, err := client.SetAssignmentEstimate(mctx, &req)
+		printResponse(resp, err)
+
+	case "get_capacity_report":
+		req := pb.GetCapacityReportRequest{}
+		req.ProjectId = *pid
+		req.StartDate = start_date
+		req.Weeks = int32(*weeks)
+		resp, err := client.GetCapacityReport(mctx, &req)
+		printResponse(resp, err)
+
+	case "get_overallocations":
+		req := pb.GetOverallocationsRequest{}
+		req.ProjectId = *pid
+		req.StartDate = start_date
+		req.EndDate = end_date
+		resp, err := client.GetOverallocations(mctx, &req)
+		printResponse(resp, err)
+
+	case "suggest_leveling":
+		req := pb.SuggestLevelingRequest{}
+		req.ProjectId = *pid
+		req.StartDate = start_date
+		resp, err := client.SuggestLeveling(mctx, &req)
+		printResponse(resp, err)
+
+	case "create_person":
+		req := pb.CreatePersonRequest{}
+		req.Name = *name
+		req.Email = *email
+		resp, err := client.CreatePerson(mctx, &req)
+		printResponse(resp, err)
+
+	case "update_person":
+		req := pb.UpdatePersonRequest{}
+		req.PersonId = *perid
+		req.Version = int32(*version)
+		req.Name = *name
+		req.Email = *email
+		resp, err := client.UpdatePerson(mctx, &req)
+		printResponse(resp, err)
+
+	case "delete_person":
+		req := pb.DeletePersonRequest{}
+		req.PersonId = *perid
+		req.Version = int32(*version)
+		resp, err := client.DeletePerson(mctx, &req)
+		printResponse(resp, err)
+
+	case "get_person_by_id":
+		req := pb.GetPersonByIdRequest{}
+		req.PersonId = *perid
+		resp, err := client.GetPersonById(mctx, &req)
+		printResponse(resp, err)
+
+	case "get_person_by_email":
+		req := pb.GetPersonByEmailRequest{}
+		req.Email = *email
+		resp, err := client.GetPersonByEmail(mctx, &req)
+		printResponse(resp, err)
+
+	case "get_persons":
+		req := pb.GetPersonsRequest{}
+		resp, err := client.GetPersons(mctx, &req)
+		printResponse(resp, err)
+
+	case "get_person_projects":
+		req := pb.GetPersonProjectsRequest{}
+		req.PersonId = *perid
+		resp, err := client.GetPersonProjects(mctx, &req)
+		printResponse(resp, err)
+
+	case "get_person_tasks":
+		req := pb.GetPersonTasksRequest{}
+		req.PersonId = *perid
+		resp, err := client.GetPersonTasks(mctx, &req)
+		printResponse(resp, err)
+
+	case "set_person_user":
+		req := pb.SetPersonUserRequest{}
+		req.PersonId = *perid
+		req.Version = int32(*version)
+		req.UserId = *uid
+		resp, err := client.SetPersonUser(mctx, &req)
+		printResponse(resp, err)
+
+	case "my_tasks":
+		req := pb.GetMyTasksRequest{}
+		resp, err := client.GetMyTasks(mctx, &req)
+		printResponse(resp, err)
+
+	case "create_project_grant":
+		req := pb.CreateProjectGrantRequest{}
+		req.ProjectId = *pid
+		if *uid != -1 {
+			req.UserId = *uid
+		}
+		if *rid != -1 {
+			req.ProjectRoleId = int32(*rid)
+		}
+		req.Access = *access
+		resp, err := client.CreateProjectGrant(mctx, &req)
+		printResponse(resp, err)
+
+	case "update_project_grant":
+		req := pb.UpdateProjectGrantRequest{}
+		req.GrantId = *gid
+		req.Version = int32(*version)
+		req.Access = *access
+		resp, err := client.UpdateProjectGrant(mctx, &req)
+		printResponse(resp, err)
+
+	case "delete_project_grant":
+		req := pb.DeleteProjectGrantRequest{}
+		req.GrantId = *gid
+		req.Version = int32(*version)
+		resp, err := client.DeleteProjectGrant(mctx, &req)
+		printResponse(resp, err)
+
+	case "get_project_grants":
+		req := pb.GetProjectGrantsRequest{}
+		req.ProjectId = *pid
+		resp, err := client.GetProjectGrants(mctx, &req)
+		printResponse(resp, err)
+
+	case "create_api_key":
+		req := pb.CreateApiKeyRequest{}
+		req.Name = *name
+		req.Role = *role
+		if *scopes != "" {
+			req.Scopes = strings.Split(*scopes, ",")
+		}
+		if *uid != -1 {
+			req.UserId = *uid
+		}
+		req.Expires = end_date
+		resp, err := client.CreateApiKey(mctx, &req)
+		printResponse(resp, err)
+
+	case "revoke_api_key":
+		req := pb.RevokeApiKeyRequest{}
+		req.ApiKeyId = *akid
+		req.Version = int32(*version)
+		resp, err := client.RevokeApiKey(mctx, &req)
+		printResponse(resp, err)
+
+	case "get_api_keys":
+		req := pb.GetApiKeysRequest{}
+		resp, err := client.GetApiKeys(mctx, &req)
+		printResponse(resp, err)
+
+	case "batch_create_tasks":
+		req := pb.BatchCreateTasksRequest{}
+		req.ProjectId = *pid
+		err = json.Unmarshal(batch_data, &req.Tasks)
+		if err != nil {
+			fmt.Println("file is not a JSON list of tasks")
+			os.Exit(1)
+		}
+		resp, err := client.BatchCreateTasks(mctx, &req)
+		printResponse(resp, err)
+
+	case "batch_update_tasks":
+		req := pb.BatchUpdateTasksRequest{}
+		req.ProjectId = *pid
+		err = json.Unmarshal(batch_data, &req.Tasks)
+		if err != nil {
+			fmt.Println("file is not a JSON list of tasks")
+			os.Exit(1)
+		}
+		resp, err := client.BatchUpdateTasks(mctx, &req)
+		printResponse(resp, err)
+
+	case "batch_assign_members":
+		req := pb.BatchAssignMembersRequest{}
+		req.ProjectId = *pid
+		err = json.Unmarshal(batch_data, &req.Assignments)
+		if err != nil {
+			fmt.Println("file is not a JSON list of assignments")
+			os.Exit(1)
+		}
+		resp, err := client.BatchAssignMembers(mctx, &req)
+		printResponse(resp, err)
+
+	case "watch_project":
+		req := pb.WatchProjectRequest{}
+		req.ProjectId = *pid
+		req.FromSequence = *seq
+		stream, err := client.WatchProject(mctx, &req)
+		for err == nil {
+			var resp *pb.WatchProjectResponse
+			resp, err = stream.Recv()
+			if err == nil {
+				printResponse(resp, nil)
+			}
+		}
+		if err != io.EOF {
+			printError(err)
+		}
+
+	case "create_webhook":
+		req := pb.CreateWebhookRequest{}
+		req.Url = *url
+		req.EventTypes = event_types
+		req.IsActive = *active
+		resp, err := client.CreateWebhook(mctx, &req)
+		printResponse(resp, err)
+
+	case "update_webhook":
+		req := pb.UpdateWebhookRequest{}
+		req.WebhookId = *hid
+		req.Version = int32(*version)
+		req.Url = *url
+		req.EventTypes = event_types
+		req.IsActive = *active
+		resp, err := client.UpdateWebhook(mctx, &req)
+		printResponse(resp, err)
+
+	case "delete_webhook":
+		req := pb.DeleteWebhookRequest{}
+		req.WebhookId = *hid
+		req.Version = int32(*version)
+		resp, err := client.DeleteWebhook(mctx, &req)
+		printResponse(resp, err)
+
+	case "get_webhooks":
+		req := pb.GetWebhooksRequest{}
+		resp, err := client.GetWebhooks(mctx, &req)
+		printResponse(resp, err)
+
+	case "get_webhook_deliveries":
+		req := pb.GetWebhookDeliveriesRequest{}
+		req.WebhookId = *hid
+		req.Limit = int32(*limit)
+		resp, err := client.GetWebhookDeliveries(mctx, &req)
+		printResponse(resp, err)
+
+	case "redeliver_webhook":
+		req := pb.RedeliverWebhookRequest{}
+		req.DeliveryId = *did
+		resp, err := client.RedeliverWebhook(mctx, &req)
+		printResponse(resp, err)
 	case "get_server_version":
 		req := pb.GetServerVersionRequest{}
 		req.DummyParam = 1
@@ -1063,6 +1785,48 @@ func printResponse(resp interface{}, err error) {
 		}
 	}
 	if err != nil {
+		printError(err)
+	}
+}
+
+// Helper to print an api method error. A gRPC status error from a server using grpc_status is
+// printed like a response with an in-band error_code, along with the status code and any batch results.
+func printError(err error) {
+	st, ok := status.FromError(err)
+	if !ok {
 		fmt.Printf("err: %s\n", err)
+		return
+	}
+
+	var results []*pb.BatchResult
+	for _, detail := range st.Details() {
+		if batch, ok := detail.(interface{ GetResults() []*pb.BatchResult }); ok {
+			results = batch.GetResults()
+		}
 	}
+
+	for _, detail := range st.Details() {
+		info, ok := detail.(*errdetails.ErrorInfo)
+		if !ok || (info.GetDomain() != "mproject") {
+			continue
+		}
+
+		errCode, _ := strconv.Atoi(info.GetMetadata()["error_code"])
+		resp := map[string]interface{}{
+			"error_code":    errCode,
+			"error_message": st.Message(),
+			"status":        st.Code().String(),
+		}
+		if len(results) > 0 {
+			resp["results"] = results
+		}
+
+		jtext, err := json.MarshalIndent(resp, "", "  ")
+		if err == nil {
+			fmt.Println(string(jtext))
+			return
+		}
+	}
+
+	fmt.Printf("err: %s\n", err)
 }
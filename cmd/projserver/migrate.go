@@ -0,0 +1,139 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/go-kit/kit/log"
+	"github.com/spf13/cobra"
+
+	"github.com/gaterace/mproject/pkg/projstore"
+)
+
+// Add the migrate subcommands, which manage the database schema with the migrations built into projserver.
+func setupMigrateCommands(cmd *cobra.Command, c *cli) {
+	migrateCmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Manage the database schema.",
+	}
+
+	migrateCmd.AddCommand(&cobra.Command{
+		Use:   "up",
+		Short: "Apply all pending migrations.",
+		Args:  cobra.NoArgs,
+		RunE:  c.migrateUp,
+	})
+
+	migrateCmd.AddCommand(&cobra.Command{
+		Use:   "down",
+		Short: "Revert the latest applied migration.",
+		Args:  cobra.NoArgs,
+		RunE:  c.migrateDown,
+	})
+
+	migrateCmd.AddCommand(&cobra.Command{
+		Use:   "status",
+		Short: "List the migrations and whether each is applied.",
+		Args:  cobra.NoArgs,
+		RunE:  c.migrateStatus,
+	})
+
+	cmd.AddCommand(migrateCmd)
+}
+
+// Helper to open the configured database for a migrate subcommand.
+func (c *cli) openDatabase() (*projstore.DB, error) {
+	return SetupDatabaseConnections(&c.cfg, log.NewLogfmtLogger(os.Stderr))
+}
+
+func (c *cli) migrateUp(cmd *cobra.Command, args []string) error {
+	sqlDb, err := c.openDatabase()
+	if err != nil {
+		return err
+	}
+
+	defer sqlDb.Close()
+
+	applied, err := sqlDb.MigrateUp()
+	for _, m := range applied {
+		fmt.Printf("applied %d %s\n", m.Version, m.Name)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if len(applied) == 0 {
+		fmt.Println("schema is up to date")
+	}
+
+	return nil
+}
+
+func (c *cli) migrateDown(cmd *cobra.Command, args []string) error {
+	sqlDb, err := c.openDatabase()
+	if err != nil {
+		return err
+	}
+
+	defer sqlDb.Close()
+
+	reverted, err := sqlDb.MigrateDown()
+	if err != nil {
+		return err
+	}
+
+	if reverted == nil {
+		fmt.Println("no migrations applied")
+	} else {
+		fmt.Printf("reverted %d %s\n", reverted.Version, reverted.Name)
+	}
+
+	return nil
+}
+
+func (c *cli) migrateStatus(cmd *cobra.Command, args []string) error {
+	sqlDb, err := c.openDatabase()
+	if err != nil {
+		return err
+	}
+
+	defer sqlDb.Close()
+
+	states, err := sqlDb.MigrationStatus()
+	if err != nil {
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "VERSION\tNAME\tAPPLIED")
+	for _, state := range states {
+		name := state.Name
+		if name == "" {
+			name = "(unknown)"
+		}
+
+		appliedAt := "pending"
+		if state.Applied {
+			appliedAt = state.AppliedAt
+		}
+
+		fmt.Fprintf(w, "%d\t%s\t%s\n", state.Version, name, appliedAt)
+	}
+
+	return w.Flush()
+}
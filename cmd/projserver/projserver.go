@@ -15,38 +15,47 @@
 package main
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"io"
 	"net"
+	"net/url"
 	"os"
+	"os/signal"
 	"strconv"
+	"strings"
+	"syscall"
+	"time"
 
 	"github.com/go-kit/kit/log"
 	"github.com/go-kit/kit/log/level"
+	"github.com/go-sql-driver/mysql"
 
 	"github.com/gaterace/mproject/pkg/projauth"
+	"github.com/gaterace/mproject/pkg/projhook"
+	"github.com/gaterace/mproject/pkg/projnotify"
 	"github.com/gaterace/mproject/pkg/projservice"
+	"github.com/gaterace/mproject/pkg/projstore"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
-
-	"database/sql"
-
-	_ "github.com/go-sql-driver/mysql"
 )
 
 func main() {
 	cli := &cli{}
 
 	cmd := &cobra.Command{
-		Use:     "projserver",
-		PreRunE: cli.setupConfig,
-		RunE:    cli.run,
+		Use:               "projserver",
+		PersistentPreRunE: cli.setupConfig,
+		RunE:              cli.run,
 	}
 
+	setupMigrateCommands(cmd, cli)
+
 	if err := setupFlags(cmd); err != nil {
 		fmt.Println(err)
 		os.Exit(1)
@@ -71,27 +80,105 @@ type cfg struct {
 	KeyFile     string
 	Tls         bool
 	Port        int
+	DbDriver    string
 	DbUser      string
 	DbPwd       string
 	DbTransport string
+	DbFile      string
+	Storage     string
 	JwtPubFile  string
+	JwtKeyDir   string
+	JwksFile    string
+
+	MigrateOnStart bool
+
+	DbName            string
+	DbParams          string
+	DbTlsCa           string
+	DbMaxOpenConns    int
+	DbMaxIdleConns    int
+	DbConnMaxLifetime int
+	DbStmtCache       int
+	DbConnectTimeout  int
+
+	DbReplicas      []string
+	DbReplicaCheck  int
+	DbReplicaSticky int
+
+	JwtAlgorithms []string
+	JwtIssuer     string
+	JwtAudience   string
+	JwtLeeway     int
+
+	WebhookMaxAttempts int
+	WebhookAllowLocal  bool
+
+	SmtpHost          string
+	SmtpPort          int
+	SmtpUser          string
+	SmtpPwd           string
+	SmtpFrom          string
+	NotifyDueDays     int
+	NotifyTemplateDir string
+
+	GrpcStatus bool
+
+	RateLimit        float64
+	RateBurst        int
+	MethodRateLimits map[string]float64
+	MaxConcurrent    int
 }
 
 func setupFlags(cmd *cobra.Command) error {
 
-	cmd.Flags().String("conf", "conf.yaml", "Path to inventory config file.")
-	cmd.Flags().String("log_file", "", "Path to log file.")
-	cmd.Flags().String("cert_file", "", "Path to certificate file.")
-	cmd.Flags().String("key_file", "", "Path to certificate key file.")
-	cmd.Flags().Bool("tls", false, "Use tls for connection.")
-	cmd.Flags().Int("port", 50054, "Port for RPC connections")
-
-	cmd.Flags().String("db_user", "", "Database user name.")
-	cmd.Flags().String("db_pwd", "", "Database user password.")
-	cmd.Flags().String("db_transport", "", "Database transport string.")
-	cmd.Flags().String("jwt_pub_file", "", "Path to JWT public certificate.")
-
-	return viper.BindPFlags(cmd.Flags())
+	cmd.PersistentFlags().String("conf", "conf.yaml", "Path to inventory config file.")
+	cmd.PersistentFlags().String("log_file", "", "Path to log file.")
+	cmd.PersistentFlags().String("cert_file", "", "Path to certificate file.")
+	cmd.PersistentFlags().String("key_file", "", "Path to certificate key file.")
+	cmd.PersistentFlags().Bool("tls", false, "Use tls for connection.")
+	cmd.PersistentFlags().Int("port", 50054, "Port for RPC connections")
+
+	cmd.PersistentFlags().String("db_driver", "mysql", "Database driver (mysql, postgres, sqlite).")
+	cmd.PersistentFlags().String("db_user", "", "Database user name.")
+	cmd.PersistentFlags().String("db_pwd", "", "Database user password.")
+	cmd.PersistentFlags().String("db_transport", "", "Database transport string.")
+	cmd.PersistentFlags().String("db_file", "mproject.db", "Path to sqlite database file.")
+	cmd.PersistentFlags().String("db_name", "mproject", "Database name.")
+	cmd.PersistentFlags().String("db_params", "", "Database DSN parameters, such as parseTime=true&timeout=5s.")
+	cmd.PersistentFlags().String("db_tls_ca", "", "Path to CA certificate for TLS connections to mysql.")
+	cmd.PersistentFlags().Int("db_max_open_conns", 0, "Maximum open database connections, 0 for no limit.")
+	cmd.PersistentFlags().Int("db_max_idle_conns", 2, "Maximum idle database connections.")
+	cmd.PersistentFlags().Int("db_conn_max_lifetime", 0, "Seconds a database connection may be reused, 0 for no limit.")
+	cmd.PersistentFlags().Int("db_stmt_cache", 100, "Prepared statements kept for reuse, 0 to disable.")
+	cmd.PersistentFlags().Int("db_connect_timeout", 60, "Seconds to retry connecting to the database on start.")
+	cmd.PersistentFlags().StringSlice("db_replicas", nil, "Read replica DSNs for read-only requests.")
+	cmd.PersistentFlags().Int("db_replica_check", 5, "Seconds between read replica health checks, 0 to disable.")
+	cmd.PersistentFlags().Int("db_replica_sticky", 5, "Seconds the reads of a caller stay on the primary after it writes.")
+	cmd.PersistentFlags().Bool("migrate_on_start", false, "Apply pending schema migrations on start.")
+	cmd.PersistentFlags().String("storage", "sql", "Storage for the service data (sql, memory).")
+	cmd.PersistentFlags().String("jwt_pub_file", "", "Path to JWT public certificate.")
+	cmd.PersistentFlags().String("jwt_key_dir", "", "Path to directory of JWT public keys named <kid>.pem.")
+	cmd.PersistentFlags().String("jwks_file", "", "Path to JSON Web Key Set file of JWT public keys.")
+	cmd.PersistentFlags().StringSlice("jwt_algorithms", []string{"PS256"}, "Allowed JWT signing algorithms (PS256, RS256, ES256, EdDSA).")
+	cmd.PersistentFlags().String("jwt_issuer", "", "Required JWT issuer (iss claim).")
+	cmd.PersistentFlags().String("jwt_audience", "", "Required JWT audience (aud claim).")
+	cmd.PersistentFlags().Int("jwt_leeway", 0, "Allowed clock skew in seconds when checking JWT times.")
+	cmd.PersistentFlags().Int("webhook_max_attempts", 5, "Delivery attempts per webhook event.")
+	cmd.PersistentFlags().Bool("webhook_allow_local", false, "Allow webhooks to loopback, link-local and private addresses.")
+
+	cmd.PersistentFlags().String("smtp_host", "", "SMTP relay host, email notifications are disabled if empty.")
+	cmd.PersistentFlags().Int("smtp_port", 25, "SMTP relay port.")
+	cmd.PersistentFlags().String("smtp_user", "", "SMTP relay user name.")
+	cmd.PersistentFlags().String("smtp_pwd", "", "SMTP relay user password.")
+	cmd.PersistentFlags().String("smtp_from", "", "Sender address for email notifications.")
+	cmd.PersistentFlags().Int("notify_due_days", 2, "Days before a task end date to send a reminder, 0 to disable.")
+	cmd.PersistentFlags().String("notify_template_dir", "", "Path to directory of notification template overrides.")
+	cmd.PersistentFlags().Bool("grpc_status", false, "Also return gRPC status codes for errors.")
+	cmd.PersistentFlags().Float64("rate_limit", 0, "Requests per second allowed for each account, 0 for no limit.")
+	cmd.PersistentFlags().Int("rate_burst", 20, "Requests allowed above rate_limit in a burst.")
+	cmd.PersistentFlags().Int("max_concurrent", 0, "Concurrent requests allowed for each account, 0 for no limit.")
+
+	return viper.BindPFlags(cmd.PersistentFlags())
 }
 
 func (c *cli) setupConfig(cmd *cobra.Command, args []string) error {
@@ -118,10 +205,55 @@ func (c *cli) setupConfig(cmd *cobra.Command, args []string) error {
 	c.cfg.Tls = viper.GetBool("tls")
 	c.cfg.Port = viper.GetInt("port")
 
+	c.cfg.DbDriver = viper.GetString("db_driver")
 	c.cfg.DbUser = viper.GetString("db_user")
 	c.cfg.DbPwd = viper.GetString("db_pwd")
 	c.cfg.DbTransport = viper.GetString("db_transport")
+	c.cfg.DbFile = viper.GetString("db_file")
+	c.cfg.DbName = viper.GetString("db_name")
+	c.cfg.DbParams = viper.GetString("db_params")
+	c.cfg.DbTlsCa = viper.GetString("db_tls_ca")
+	c.cfg.DbMaxOpenConns = viper.GetInt("db_max_open_conns")
+	c.cfg.DbMaxIdleConns = viper.GetInt("db_max_idle_conns")
+	c.cfg.DbConnMaxLifetime = viper.GetInt("db_conn_max_lifetime")
+	c.cfg.DbStmtCache = viper.GetInt("db_stmt_cache")
+	c.cfg.DbConnectTimeout = viper.GetInt("db_connect_timeout")
+	c.cfg.DbReplicas = viper.GetStringSlice("db_replicas")
+	c.cfg.DbReplicaCheck = viper.GetInt("db_replica_check")
+	c.cfg.DbReplicaSticky = viper.GetInt("db_replica_sticky")
+	c.cfg.MigrateOnStart = viper.GetBool("migrate_on_start")
+	c.cfg.Storage = viper.GetString("storage")
 	c.cfg.JwtPubFile = viper.GetString("jwt_pub_file")
+	c.cfg.JwtKeyDir = viper.GetString("jwt_key_dir")
+	c.cfg.JwksFile = viper.GetString("jwks_file")
+	c.cfg.JwtAlgorithms = viper.GetStringSlice("jwt_algorithms")
+	c.cfg.JwtIssuer = viper.GetString("jwt_issuer")
+	c.cfg.JwtAudience = viper.GetString("jwt_audience")
+	c.cfg.JwtLeeway = viper.GetInt("jwt_leeway")
+	c.cfg.WebhookMaxAttempts = viper.GetInt("webhook_max_attempts")
+	c.cfg.WebhookAllowLocal = viper.GetBool("webhook_allow_local")
+
+	c.cfg.SmtpHost = viper.GetString("smtp_host")
+	c.cfg.SmtpPort = viper.GetInt("smtp_port")
+	c.cfg.SmtpUser = viper.GetString("smtp_user")
+	c.cfg.SmtpPwd = viper.GetString("smtp_pwd")
+	c.cfg.SmtpFrom = viper.GetString("smtp_from")
+	c.cfg.NotifyDueDays = viper.GetInt("notify_due_days")
+	c.cfg.NotifyTemplateDir = viper.GetString("notify_template_dir")
+	c.cfg.GrpcStatus = viper.GetBool("grpc_status")
+	c.cfg.RateLimit = viper.GetFloat64("rate_limit")
+	c.cfg.RateBurst = viper.GetInt("rate_burst")
+	c.cfg.MaxConcurrent = viper.GetInt("max_concurrent")
+
+	c.cfg.MethodRateLimits = make(map[string]float64)
+	for method, limit := range viper.GetStringMapString("method_rate_limits") {
+		methodRate, err := strconv.ParseFloat(limit, 64)
+		if err != nil {
+			return fmt.Errorf("method_rate_limits %s: %v", method, err)
+		}
+
+		c.cfg.MethodRateLimits[method] = methodRate
+	}
 
 	return nil
 }
@@ -134,10 +266,44 @@ func (c *cli) run(cmd *cobra.Command, args []string) error {
 	key_file := c.cfg.KeyFile
 	tls := c.cfg.Tls
 	port := c.cfg.Port
+	db_driver := c.cfg.DbDriver
 	db_user := c.cfg.DbUser
-	db_pwd := c.cfg.DbPwd
 	db_transport := c.cfg.DbTransport
+	db_file := c.cfg.DbFile
+	db_name := c.cfg.DbName
+	db_params := c.cfg.DbParams
+	db_tls_ca := c.cfg.DbTlsCa
+	db_max_open_conns := c.cfg.DbMaxOpenConns
+	db_max_idle_conns := c.cfg.DbMaxIdleConns
+	db_conn_max_lifetime := c.cfg.DbConnMaxLifetime
+	db_stmt_cache := c.cfg.DbStmtCache
+	db_connect_timeout := c.cfg.DbConnectTimeout
+	db_replicas := c.cfg.DbReplicas
+	db_replica_check := c.cfg.DbReplicaCheck
+	db_replica_sticky := c.cfg.DbReplicaSticky
+	migrate_on_start := c.cfg.MigrateOnStart
+	storage := c.cfg.Storage
 	jwt_pub_file := c.cfg.JwtPubFile
+	jwt_key_dir := c.cfg.JwtKeyDir
+	jwks_file := c.cfg.JwksFile
+	jwt_algorithms := c.cfg.JwtAlgorithms
+	jwt_issuer := c.cfg.JwtIssuer
+	jwt_audience := c.cfg.JwtAudience
+	jwt_leeway := c.cfg.JwtLeeway
+	webhook_max_attempts := c.cfg.WebhookMaxAttempts
+	webhook_allow_local := c.cfg.WebhookAllowLocal
+	smtp_host := c.cfg.SmtpHost
+	smtp_port := c.cfg.SmtpPort
+	smtp_user := c.cfg.SmtpUser
+	smtp_pwd := c.cfg.SmtpPwd
+	smtp_from := c.cfg.SmtpFrom
+	notify_due_days := c.cfg.NotifyDueDays
+	notify_template_dir := c.cfg.NotifyTemplateDir
+	grpc_status := c.cfg.GrpcStatus
+	rate_limit := c.cfg.RateLimit
+	rate_burst := c.cfg.RateBurst
+	method_rate_limits := c.cfg.MethodRateLimits
+	max_concurrent := c.cfg.MaxConcurrent
 
 	var logWriter io.Writer
 
@@ -156,9 +322,46 @@ func (c *cli) run(cmd *cobra.Command, args []string) error {
 	level.Info(logger).Log("key_file", key_file)
 	level.Info(logger).Log("tls", tls)
 	level.Info(logger).Log("port", port)
+	level.Info(logger).Log("db_driver", db_driver)
 	level.Info(logger).Log("db_user", db_user)
 	level.Info(logger).Log("db_transport", db_transport)
+	level.Info(logger).Log("db_file", db_file)
+	level.Info(logger).Log("db_name", db_name)
+	level.Info(logger).Log("db_params", db_params)
+	level.Info(logger).Log("db_tls_ca", db_tls_ca)
+	level.Info(logger).Log("db_max_open_conns", db_max_open_conns)
+	level.Info(logger).Log("db_max_idle_conns", db_max_idle_conns)
+	level.Info(logger).Log("db_conn_max_lifetime", db_conn_max_lifetime)
+	level.Info(logger).Log("db_stmt_cache", db_stmt_cache)
+	level.Info(logger).Log("db_connect_timeout", db_connect_timeout)
+	// replica DSNs may hold passwords
+	level.Info(logger).Log("db_replicas", len(db_replicas))
+	level.Info(logger).Log("db_replica_check", db_replica_check)
+	level.Info(logger).Log("db_replica_sticky", db_replica_sticky)
+	level.Info(logger).Log("migrate_on_start", migrate_on_start)
+	level.Info(logger).Log("storage", storage)
 	level.Info(logger).Log("jwt_pub_file", jwt_pub_file)
+	level.Info(logger).Log("jwt_key_dir", jwt_key_dir)
+	level.Info(logger).Log("jwks_file", jwks_file)
+	level.Info(logger).Log("jwt_algorithms", strings.Join(jwt_algorithms, ","))
+	level.Info(logger).Log("jwt_issuer", jwt_issuer)
+	level.Info(logger).Log("jwt_audience", jwt_audience)
+	level.Info(logger).Log("jwt_leeway", jwt_leeway)
+	level.Info(logger).Log("webhook_max_attempts", webhook_max_attempts)
+	level.Info(logger).Log("webhook_allow_local", webhook_allow_local)
+	level.Info(logger).Log("smtp_host", smtp_host)
+	level.Info(logger).Log("smtp_port", smtp_port)
+	level.Info(logger).Log("smtp_user", smtp_user)
+	level.Info(logger).Log("smtp_from", smtp_from)
+	level.Info(logger).Log("notify_due_days", notify_due_days)
+	level.Info(logger).Log("notify_template_dir", notify_template_dir)
+	level.Info(logger).Log("grpc_status", grpc_status)
+	level.Info(logger).Log("rate_limit", rate_limit)
+	level.Info(logger).Log("rate_burst", rate_burst)
+	for method, methodRate := range method_rate_limits {
+		level.Info(logger).Log("method_rate_limit", method, "rate", methodRate)
+	}
+	level.Info(logger).Log("max_concurrent", max_concurrent)
 
 	listen_port := ":" + strconv.Itoa(int(port))
 	// fmt.Println(listen_port)
@@ -179,27 +382,130 @@ func (c *cli) run(cmd *cobra.Command, args []string) error {
 		opts = []grpc.ServerOption{grpc.Creds(creds)}
 	}
 
-	s := grpc.NewServer(opts...)
-
 	projService := projservice.NewProjectService()
 
-	sqlDb, err := SetupDatabaseConnections(db_user, db_pwd, db_transport)
+	// the memory store keeps everything in the process, with no database
+	var sqlDb *projstore.DB
+	if storage != "memory" {
+		sqlDb, err = SetupDatabaseConnections(&c.cfg, logger)
+		if err != nil {
+			level.Error(logger).Log("what", "SetupDatabaseConnections", "error", err)
+			os.Exit(1)
+		}
+
+		err = SetupReplicaConnections(sqlDb, &c.cfg, logger)
+		if err != nil {
+			level.Error(logger).Log("what", "SetupReplicaConnections", "error", err)
+			os.Exit(1)
+		}
+
+		// a sqlite database file is always brought up to date, so a new one is ready to use
+		if migrate_on_start || (db_driver == "sqlite") {
+			applied, err := sqlDb.MigrateUp()
+			for _, m := range applied {
+				level.Info(logger).Log("msg", "applied migration", "version", m.Version, "name", m.Name)
+			}
+
+			if err != nil {
+				level.Error(logger).Log("what", "MigrateUp", "error", err)
+				os.Exit(1)
+			}
+		}
+	}
+
+	store, err := projstore.NewStore(storage, sqlDb)
 	if err != nil {
-		level.Error(logger).Log("what", "SetupDatabaseConnections", "error", err)
+		level.Error(logger).Log("what", "NewStore", "error", err)
 		os.Exit(1)
 	}
 
 	projService.SetLogger(logger)
-	projService.SetDatabaseConnection(sqlDb)
+	projService.SetStore(store)
+	projService.SetStatusMode(grpc_status)
+
+	// deliver project change events to registered webhooks
+
+	dispatcher := projhook.NewDispatcher()
+	dispatcher.SetLogger(logger)
+	dispatcher.SetStore(store)
+	dispatcher.SetMaxAttempts(webhook_max_attempts)
+	dispatcher.SetAllowLocal(webhook_allow_local)
+	dispatcher.Start()
+
+	projService.SetWebhookDispatcher(dispatcher)
+	projService.AddEventListener(dispatcher.HandleEvent)
+
+	// email team members about their tasks
+
+	if smtp_host != "" {
+		notifier := projnotify.NewNotifier()
+		notifier.SetLogger(logger)
+		notifier.SetStore(store)
+		notifier.SetSmtpRelay(smtp_host, smtp_port, smtp_user, smtp_pwd, smtp_from)
+		notifier.SetDueDays(notify_due_days)
+		if notify_template_dir != "" {
+			err = notifier.LoadTemplates(notify_template_dir)
+			if err != nil {
+				level.Error(logger).Log("what", "LoadTemplates", "error", err)
+				os.Exit(1)
+			}
+		}
 
-	// wire up the authorization middleware
+		notifier.Start()
+		projService.AddEventListener(notifier.HandleEvent)
+	}
+
+	// wire up the authorization interceptors
 
 	projAuth := projauth.NewProjectAuth(projService)
 
 	projAuth.SetLogger(logger)
 
-	projAuth.SetPublicKey(jwt_pub_file)
-	projAuth.SetDatabaseConnection(sqlDb)
+	if jwt_pub_file != "" {
+		projAuth.SetPublicKey(jwt_pub_file)
+	}
+
+	if jwt_key_dir != "" {
+		projAuth.SetKeyDirectory(jwt_key_dir)
+	}
+
+	if jwks_file != "" {
+		projAuth.SetJwksFile(jwks_file)
+	}
+
+	err = projAuth.SetAlgorithms(jwt_algorithms)
+	if err != nil {
+		level.Error(logger).Log("what", "SetAlgorithms", "error", err)
+		os.Exit(1)
+	}
+
+	projAuth.SetIssuer(jwt_issuer)
+	projAuth.SetAudience(jwt_audience)
+	projAuth.SetLeeway(time.Duration(jwt_leeway) * time.Second)
+
+	err = projAuth.WatchKeys()
+	if err != nil {
+		level.Error(logger).Log("what", "WatchKeys", "error", err)
+	}
+
+	// reload the JWT keys on SIGHUP
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	go func() {
+		for range hup {
+			level.Info(logger).Log("msg", "reloading jwt keys")
+			projAuth.ReloadKeys()
+		}
+	}()
+	projAuth.SetStore(store)
+	projAuth.SetStatusMode(grpc_status)
+	projAuth.SetRateLimits(rate_limit, rate_burst, method_rate_limits)
+	projAuth.SetMaxConcurrent(max_concurrent)
+	projAuth.SetReplicaStickiness(time.Duration(db_replica_sticky) * time.Second)
+
+	opts = append(opts, projAuth.ServerOptions()...)
+	s := grpc.NewServer(opts...)
+
 	err = projAuth.NewApiServer(s)
 	if err != nil {
 		level.Error(logger).Log("what", "NewApiServer", "error", err)
@@ -219,19 +525,125 @@ func (c *cli) run(cmd *cobra.Command, args []string) error {
 
 }
 
-// Helper to set up the database connection.
-func SetupDatabaseConnections(db_user string, db_pwd string, db_transport string) (*sql.DB, error) {
-	var sqlDb *sql.DB
-	endpoint := db_user + ":" + db_pwd + "@" + db_transport + "/mproject"
-	var err error
-	sqlDb, err = sql.Open("mysql", endpoint)
-	if err == nil {
+// Helper to set up the database connection. The transport is a go-sql-driver/mysql transport such as
+// unix(/var/lib/mysql/mysql.sock) for mysql, or a host:port for postgres. A sqlite database is kept in
+// db_file. Connecting is retried until the database answers or db_connect_timeout seconds have passed.
+func SetupDatabaseConnections(config *cfg, logger log.Logger) (*projstore.DB, error) {
+	var endpoint string
+	switch config.DbDriver {
+	case "postgres":
+		u := url.URL{Scheme: "postgres", User: url.UserPassword(config.DbUser, config.DbPwd), Host: config.DbTransport,
+			Path: "/" + config.DbName, RawQuery: config.DbParams}
+		endpoint = u.String()
+	case "sqlite":
+		endpoint = projstore.SqliteDsn(config.DbFile)
+	default:
+		params := config.DbParams
+		if config.DbTlsCa != "" {
+			err := registerMysqlTls(config.DbTlsCa)
+			if err != nil {
+				return nil, err
+			}
+
+			params = joinParams(params, "tls="+mysqlTlsName)
+		}
+
+		endpoint = config.DbUser + ":" + config.DbPwd + "@" + config.DbTransport + "/" + config.DbName
+		if params != "" {
+			endpoint += "?" + params
+		}
+	}
+
+	sqlDb, err := projstore.OpenDB(config.DbDriver, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	sqlDb.SetMaxOpenConns(config.DbMaxOpenConns)
+	sqlDb.SetMaxIdleConns(config.DbMaxIdleConns)
+	sqlDb.SetConnMaxLifetime(time.Duration(config.DbConnMaxLifetime) * time.Second)
+	sqlDb.SetStmtCacheSize(config.DbStmtCache)
+
+	deadline := time.Now().Add(time.Duration(config.DbConnectTimeout) * time.Second)
+	delay := time.Second
+	for {
 		err = sqlDb.Ping()
+		if (err == nil) || time.Now().Add(delay).After(deadline) {
+			break
+		}
+
+		level.Warn(logger).Log("what", "Ping", "error", err, "retry_in", delay)
+		time.Sleep(delay)
+
+		// back off up to 10 seconds between attempts
+		delay *= 2
+		if delay > 10*time.Second {
+			delay = 10 * time.Second
+		}
+	}
+
+	if err != nil {
+		sqlDb.Close()
+		return nil, err
+	}
+
+	return sqlDb, nil
+}
+
+// Helper to add the read replicas to the database connection, with the same pool settings, and start their
+// health checks. A replica that cannot be reached yet is used once a health check succeeds.
+func SetupReplicaConnections(sqlDb *projstore.DB, config *cfg, logger log.Logger) error {
+	for i, dsn := range config.DbReplicas {
+		replicaDb, err := projstore.OpenDB(config.DbDriver, dsn)
+		if err != nil {
+			return fmt.Errorf("db_replicas %d: %v", i, err)
+		}
+
+		replicaDb.SetMaxOpenConns(config.DbMaxOpenConns)
+		replicaDb.SetMaxIdleConns(config.DbMaxIdleConns)
+		replicaDb.SetConnMaxLifetime(time.Duration(config.DbConnMaxLifetime) * time.Second)
+		replicaDb.SetStmtCacheSize(config.DbStmtCache)
+
+		err = sqlDb.AddReplica(replicaDb)
 		if err != nil {
-			sqlDb = nil
+			level.Warn(logger).Log("what", "replica unhealthy", "replica", i, "error", err)
 		}
+	}
+
+	sqlDb.StartReplicaChecks(time.Duration(config.DbReplicaCheck)*time.Second, func(index int, err error) {
+		if err != nil {
+			level.Warn(logger).Log("what", "replica unhealthy", "replica", index, "error", err)
+		} else {
+			level.Info(logger).Log("msg", "replica healthy", "replica", index)
+		}
+	})
+
+	return nil
+}
+
+// Name of the TLS configuration registered with go-sql-driver/mysql for db_tls_ca.
+const mysqlTlsName = "mproject"
+
+// Helper to register a TLS configuration for mysql connections that trusts the CA certificates in caFile.
+func registerMysqlTls(caFile string) error {
+	pem, err := os.ReadFile(caFile)
+	if err != nil {
+		return err
+	}
+
+	rootCAs := x509.NewCertPool()
+	if !rootCAs.AppendCertsFromPEM(pem) {
+		return fmt.Errorf("no certificates in db_tls_ca %s", caFile)
+	}
+
+	return mysql.RegisterTLSConfig(mysqlTlsName, &tls.Config{RootCAs: rootCAs})
+}
 
+// Helper to add a parameter to a DSN query string.
+func joinParams(params string, param string) string {
+	if params == "" {
+		return param
 	}
 
-	return sqlDb, err
+	return params + "&" + param
 }
@@ -88,6 +88,82 @@ type MServiceProjectClient interface {
 	GetProjectRoleTypes(ctx context.Context, in *GetProjectRoleTypesRequest, opts ...grpc.CallOption) (*GetProjectRoleTypesResponse, error)
 	// get current server version and uptime - health check
 	GetServerVersion(ctx context.Context, in *GetServerVersionRequest, opts ...grpc.CallOption) (*GetServerVersionResponse, error)
+	// watch a project for changes to tasks, team members and assignments
+	WatchProject(ctx context.Context, in *WatchProjectRequest, opts ...grpc.CallOption) (MServiceProject_WatchProjectClient, error)
+	// register a new webhook for this mservice id
+	CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error)
+	// update an existing webhook
+	UpdateWebhook(ctx context.Context, in *UpdateWebhookRequest, opts ...grpc.CallOption) (*UpdateWebhookResponse, error)
+	// delete an existing webhook
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error)
+	// get all webhooks for this mservice id
+	GetWebhooks(ctx context.Context, in *GetWebhooksRequest, opts ...grpc.CallOption) (*GetWebhooksResponse, error)
+	// get the most recent deliveries for a webhook
+	GetWebhookDeliveries(ctx context.Context, in *GetWebhookDeliveriesRequest, opts ...grpc.CallOption) (*GetWebhookDeliveriesResponse, error)
+	// send a logged webhook delivery again
+	RedeliverWebhook(ctx context.Context, in *RedeliverWebhookRequest, opts ...grpc.CallOption) (*RedeliverWebhookResponse, error)
+	// set whether a team member receives email notifications
+	SetMemberNotify(ctx context.Context, in *SetMemberNotifyRequest, opts ...grpc.CallOption) (*SetMemberNotifyResponse, error)
+	// get whether a team member receives email notifications
+	GetMemberNotify(ctx context.Context, in *GetMemberNotifyRequest, opts ...grpc.CallOption) (*GetMemberNotifyResponse, error)
+	// set the working capacity of a team member
+	SetMemberCapacity(ctx context.Context, in *SetMemberCapacityRequest, opts ...grpc.CallOption) (*SetMemberCapacityResponse, error)
+	// get the working capacity and days off of a team member
+	GetMemberCapacity(ctx context.Context, in *GetMemberCapacityRequest, opts ...grpc.CallOption) (*GetMemberCapacityResponse, error)
+	// add a day when a team member is not available
+	AddMemberDayOff(ctx context.Context, in *AddMemberDayOffRequest, opts ...grpc.CallOption) (*AddMemberDayOffResponse, error)
+	// remove a day when a team member is not available
+	RemoveMemberDayOff(ctx context.Context, in *RemoveMemberDayOffRequest, opts ...grpc.CallOption) (*RemoveMemberDayOffResponse, error)
+	// set the estimated hours for a team member on a task
+	SetAssignmentEstimate(ctx context.Context, in *SetAssignmentEstimateRequest, opts ...grpc.CallOption) (*SetAssignmentEstimateResponse, error)
+	// compare weekly assigned hours of team members against their capacity
+	GetCapacityReport(ctx context.Context, in *GetCapacityReportRequest, opts ...grpc.CallOption) (*GetCapacityReportResponse, error)
+	// list days where task assignments of a team member exceed capacity
+	GetOverallocations(ctx context.Context, in *GetOverallocationsRequest, opts ...grpc.CallOption) (*GetOverallocationsResponse, error)
+	// propose task date shifts within available float to resolve over-allocations
+	SuggestLeveling(ctx context.Context, in *SuggestLevelingRequest, opts ...grpc.CallOption) (*SuggestLevelingResponse, error)
+	// create a new person in the account directory
+	CreatePerson(ctx context.Context, in *CreatePersonRequest, opts ...grpc.CallOption) (*CreatePersonResponse, error)
+	// update an existing person
+	UpdatePerson(ctx context.Context, in *UpdatePersonRequest, opts ...grpc.CallOption) (*UpdatePersonResponse, error)
+	// delete an existing person
+	DeletePerson(ctx context.Context, in *DeletePersonRequest, opts ...grpc.CallOption) (*DeletePersonResponse, error)
+	// get person by id
+	GetPersonById(ctx context.Context, in *GetPersonByIdRequest, opts ...grpc.CallOption) (*GetPersonByIdResponse, error)
+	// get person by email
+	GetPersonByEmail(ctx context.Context, in *GetPersonByEmailRequest, opts ...grpc.CallOption) (*GetPersonByEmailResponse, error)
+	// get all persons in the account directory
+	GetPersons(ctx context.Context, in *GetPersonsRequest, opts ...grpc.CallOption) (*GetPersonsResponse, error)
+	// get the project memberships of a person
+	GetPersonProjects(ctx context.Context, in *GetPersonProjectsRequest, opts ...grpc.CallOption) (*GetPersonProjectsResponse, error)
+	// get the task assignments of a person across projects
+	GetPersonTasks(ctx context.Context, in *GetPersonTasksRequest, opts ...grpc.CallOption) (*GetPersonTasksResponse, error)
+	// bind a person to an MService user id
+	SetPersonUser(ctx context.Context, in *SetPersonUserRequest, opts ...grpc.CallOption) (*SetPersonUserResponse, error)
+	// get the task assignments of the calling user across projects
+	GetMyTasks(ctx context.Context, in *GetMyTasksRequest, opts ...grpc.CallOption) (*GetMyTasksResponse, error)
+	// grant a user or project role access to a project
+	CreateProjectGrant(ctx context.Context, in *CreateProjectGrantRequest, opts ...grpc.CallOption) (*CreateProjectGrantResponse, error)
+	// update the access of an existing project grant
+	UpdateProjectGrant(ctx context.Context, in *UpdateProjectGrantRequest, opts ...grpc.CallOption) (*UpdateProjectGrantResponse, error)
+	// delete an existing project grant
+	DeleteProjectGrant(ctx context.Context, in *DeleteProjectGrantRequest, opts ...grpc.CallOption) (*DeleteProjectGrantResponse, error)
+	// get the grants of a project
+	GetProjectGrants(ctx context.Context, in *GetProjectGrantsRequest, opts ...grpc.CallOption) (*GetProjectGrantsResponse, error)
+	// replace the task hours for task and member
+	SetTaskHours(ctx context.Context, in *SetTaskHoursRequest, opts ...grpc.CallOption) (*SetTaskHoursResponse, error)
+	// create an api key for automation
+	CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...grpc.CallOption) (*CreateApiKeyResponse, error)
+	// revoke an existing api key
+	RevokeApiKey(ctx context.Context, in *RevokeApiKeyRequest, opts ...grpc.CallOption) (*RevokeApiKeyResponse, error)
+	// get the api keys of the account
+	GetApiKeys(ctx context.Context, in *GetApiKeysRequest, opts ...grpc.CallOption) (*GetApiKeysResponse, error)
+	// create the tasks of a project together, or none of them
+	BatchCreateTasks(ctx context.Context, in *BatchCreateTasksRequest, opts ...grpc.CallOption) (*BatchCreateTasksResponse, error)
+	// update the tasks of a project together, or none of them
+	BatchUpdateTasks(ctx context.Context, in *BatchUpdateTasksRequest, opts ...grpc.CallOption) (*BatchUpdateTasksResponse, error)
+	// assign team members to the tasks of a project together, or none of them
+	BatchAssignMembers(ctx context.Context, in *BatchAssignMembersRequest, opts ...grpc.CallOption) (*BatchAssignMembersResponse, error)
 }
 
 type mServiceProjectClient struct {
@@ -413,6 +489,371 @@ func (c *mServiceProjectClient) GetServerVersion(ctx context.Context, in *GetSer
 	return out, nil
 }
 
+func (c *mServiceProjectClient) WatchProject(ctx context.Context, in *WatchProjectRequest, opts ...grpc.CallOption) (MServiceProject_WatchProjectClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MServiceProject_ServiceDesc.Streams[0], "/org.gaterace.mservice.project.MServiceProject/watch_project", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mServiceProjectWatchProjectClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MServiceProject_WatchProjectClient interface {
+	Recv() (*WatchProjectResponse, error)
+	grpc.ClientStream
+}
+
+type mServiceProjectWatchProjectClient struct {
+	grpc.ClientStream
+}
+
+func (x *mServiceProjectWatchProjectClient) Recv() (*WatchProjectResponse, error) {
+	m := new(WatchProjectResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *mServiceProjectClient) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error) {
+	out := new(CreateWebhookResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.project.MServiceProject/create_webhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceProjectClient) UpdateWebhook(ctx context.Context, in *UpdateWebhookRequest, opts ...grpc.CallOption) (*UpdateWebhookResponse, error) {
+	out := new(UpdateWebhookResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.project.MServiceProject/update_webhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceProjectClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error) {
+	out := new(DeleteWebhookResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.project.MServiceProject/delete_webhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceProjectClient) GetWebhooks(ctx context.Context, in *GetWebhooksRequest, opts ...grpc.CallOption) (*GetWebhooksResponse, error) {
+	out := new(GetWebhooksResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.project.MServiceProject/get_webhooks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceProjectClient) GetWebhookDeliveries(ctx context.Context, in *GetWebhookDeliveriesRequest, opts ...grpc.CallOption) (*GetWebhookDeliveriesResponse, error) {
+	out := new(GetWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.project.MServiceProject/get_webhook_deliveries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceProjectClient) RedeliverWebhook(ctx context.Context, in *RedeliverWebhookRequest, opts ...grpc.CallOption) (*RedeliverWebhookResponse, error) {
+	out := new(RedeliverWebhookResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.project.MServiceProject/redeliver_webhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceProjectClient) SetMemberNotify(ctx context.Context, in *SetMemberNotifyRequest, opts ...grpc.CallOption) (*SetMemberNotifyResponse, error) {
+	out := new(SetMemberNotifyResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.project.MServiceProject/set_member_notify", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceProjectClient) GetMemberNotify(ctx context.Context, in *GetMemberNotifyRequest, opts ...grpc.CallOption) (*GetMemberNotifyResponse, error) {
+	out := new(GetMemberNotifyResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.project.MServiceProject/get_member_notify", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceProjectClient) SetMemberCapacity(ctx context.Context, in *SetMemberCapacityRequest, opts ...grpc.CallOption) (*SetMemberCapacityResponse, error) {
+	out := new(SetMemberCapacityResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.project.MServiceProject/set_member_capacity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceProjectClient) GetMemberCapacity(ctx context.Context, in *GetMemberCapacityRequest, opts ...grpc.CallOption) (*GetMemberCapacityResponse, error) {
+	out := new(GetMemberCapacityResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.project.MServiceProject/get_member_capacity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceProjectClient) AddMemberDayOff(ctx context.Context, in *AddMemberDayOffRequest, opts ...grpc.CallOption) (*AddMemberDayOffResponse, error) {
+	out := new(AddMemberDayOffResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.project.MServiceProject/add_member_day_off", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceProjectClient) RemoveMemberDayOff(ctx context.Context, in *RemoveMemberDayOffRequest, opts ...grpc.CallOption) (*RemoveMemberDayOffResponse, error) {
+	out := new(RemoveMemberDayOffResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.project.MServiceProject/remove_member_day_off", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceProjectClient) SetAssignmentEstimate(ctx context.Context, in *SetAssignmentEstimateRequest, opts ...grpc.CallOption) (*SetAssignmentEstimateResponse, error) {
+	out := new(SetAssignmentEstimateResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.project.MServiceProject/set_assignment_estimate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceProjectClient) GetCapacityReport(ctx context.Context, in *GetCapacityReportRequest, opts ...grpc.CallOption) (*GetCapacityReportResponse, error) {
+	out := new(GetCapacityReportResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.project.MServiceProject/get_capacity_report", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceProjectClient) GetOverallocations(ctx context.Context, in *GetOverallocationsRequest, opts ...grpc.CallOption) (*GetOverallocationsResponse, error) {
+	out := new(GetOverallocationsResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.project.MServiceProject/get_overallocations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceProjectClient) SuggestLeveling(ctx context.Context, in *SuggestLevelingRequest, opts ...grpc.CallOption) (*SuggestLevelingResponse, error) {
+	out := new(SuggestLevelingResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.project.MServiceProject/suggest_leveling", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceProjectClient) CreatePerson(ctx context.Context, in *CreatePersonRequest, opts ...grpc.CallOption) (*CreatePersonResponse, error) {
+	out := new(CreatePersonResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.project.MServiceProject/create_person", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceProjectClient) UpdatePerson(ctx context.Context, in *UpdatePersonRequest, opts ...grpc.CallOption) (*UpdatePersonResponse, error) {
+	out := new(UpdatePersonResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.project.MServiceProject/update_person", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceProjectClient) DeletePerson(ctx context.Context, in *DeletePersonRequest, opts ...grpc.CallOption) (*DeletePersonResponse, error) {
+	out := new(DeletePersonResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.project.MServiceProject/delete_person", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceProjectClient) GetPersonById(ctx context.Context, in *GetPersonByIdRequest, opts ...grpc.CallOption) (*GetPersonByIdResponse, error) {
+	out := new(GetPersonByIdResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.project.MServiceProject/get_person_by_id", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceProjectClient) GetPersonByEmail(ctx context.Context, in *GetPersonByEmailRequest, opts ...grpc.CallOption) (*GetPersonByEmailResponse, error) {
+	out := new(GetPersonByEmailResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.project.MServiceProject/get_person_by_email", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceProjectClient) GetPersons(ctx context.Context, in *GetPersonsRequest, opts ...grpc.CallOption) (*GetPersonsResponse, error) {
+	out := new(GetPersonsResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.project.MServiceProject/get_persons", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceProjectClient) GetPersonProjects(ctx context.Context, in *GetPersonProjectsRequest, opts ...grpc.CallOption) (*GetPersonProjectsResponse, error) {
+	out := new(GetPersonProjectsResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.project.MServiceProject/get_person_projects", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceProjectClient) GetPersonTasks(ctx context.Context, in *GetPersonTasksRequest, opts ...grpc.CallOption) (*GetPersonTasksResponse, error) {
+	out := new(GetPersonTasksResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.project.MServiceProject/get_person_tasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceProjectClient) SetPersonUser(ctx context.Context, in *SetPersonUserRequest, opts ...grpc.CallOption) (*SetPersonUserResponse, error) {
+	out := new(SetPersonUserResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.project.MServiceProject/set_person_user", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceProjectClient) GetMyTasks(ctx context.Context, in *GetMyTasksRequest, opts ...grpc.CallOption) (*GetMyTasksResponse, error) {
+	out := new(GetMyTasksResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.project.MServiceProject/get_my_tasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceProjectClient) CreateProjectGrant(ctx context.Context, in *CreateProjectGrantRequest, opts ...grpc.CallOption) (*CreateProjectGrantResponse, error) {
+	out := new(CreateProjectGrantResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.project.MServiceProject/create_project_grant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceProjectClient) UpdateProjectGrant(ctx context.Context, in *UpdateProjectGrantRequest, opts ...grpc.CallOption) (*UpdateProjectGrantResponse, error) {
+	out := new(UpdateProjectGrantResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.project.MServiceProject/update_project_grant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceProjectClient) DeleteProjectGrant(ctx context.Context, in *DeleteProjectGrantRequest, opts ...grpc.CallOption) (*DeleteProjectGrantResponse, error) {
+	out := new(DeleteProjectGrantResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.project.MServiceProject/delete_project_grant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceProjectClient) GetProjectGrants(ctx context.Context, in *GetProjectGrantsRequest, opts ...grpc.CallOption) (*GetProjectGrantsResponse, error) {
+	out := new(GetProjectGrantsResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.project.MServiceProject/get_project_grants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceProjectClient) SetTaskHours(ctx context.Context, in *SetTaskHoursRequest, opts ...grpc.CallOption) (*SetTaskHoursResponse, error) {
+	out := new(SetTaskHoursResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.project.MServiceProject/set_task_hours", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceProjectClient) CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...grpc.CallOption) (*CreateApiKeyResponse, error) {
+	out := new(CreateApiKeyResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.project.MServiceProject/create_api_key", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceProjectClient) RevokeApiKey(ctx context.Context, in *RevokeApiKeyRequest, opts ...grpc.CallOption) (*RevokeApiKeyResponse, error) {
+	out := new(RevokeApiKeyResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.project.MServiceProject/revoke_api_key", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceProjectClient) GetApiKeys(ctx context.Context, in *GetApiKeysRequest, opts ...grpc.CallOption) (*GetApiKeysResponse, error) {
+	out := new(GetApiKeysResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.project.MServiceProject/get_api_keys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceProjectClient) BatchCreateTasks(ctx context.Context, in *BatchCreateTasksRequest, opts ...grpc.CallOption) (*BatchCreateTasksResponse, error) {
+	out := new(BatchCreateTasksResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.project.MServiceProject/batch_create_tasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceProjectClient) BatchUpdateTasks(ctx context.Context, in *BatchUpdateTasksRequest, opts ...grpc.CallOption) (*BatchUpdateTasksResponse, error) {
+	out := new(BatchUpdateTasksResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.project.MServiceProject/batch_update_tasks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mServiceProjectClient) BatchAssignMembers(ctx context.Context, in *BatchAssignMembersRequest, opts ...grpc.CallOption) (*BatchAssignMembersResponse, error) {
+	out := new(BatchAssignMembersResponse)
+	err := c.cc.Invoke(ctx, "/org.gaterace.mservice.project.MServiceProject/batch_assign_members", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MServiceProjectServer is the server API for MServiceProject service.
 // All implementations must embed UnimplementedMServiceProjectServer
 // for forward compatibility
@@ -487,6 +928,82 @@ type MServiceProjectServer interface {
 	GetProjectRoleTypes(context.Context, *GetProjectRoleTypesRequest) (*GetProjectRoleTypesResponse, error)
 	// get current server version and uptime - health check
 	GetServerVersion(context.Context, *GetServerVersionRequest) (*GetServerVersionResponse, error)
+	// watch a project for changes to tasks, team members and assignments
+	WatchProject(*WatchProjectRequest, MServiceProject_WatchProjectServer) error
+	// register a new webhook for this mservice id
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error)
+	// update an existing webhook
+	UpdateWebhook(context.Context, *UpdateWebhookRequest) (*UpdateWebhookResponse, error)
+	// delete an existing webhook
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error)
+	// get all webhooks for this mservice id
+	GetWebhooks(context.Context, *GetWebhooksRequest) (*GetWebhooksResponse, error)
+	// get the most recent deliveries for a webhook
+	GetWebhookDeliveries(context.Context, *GetWebhookDeliveriesRequest) (*GetWebhookDeliveriesResponse, error)
+	// send a logged webhook delivery again
+	RedeliverWebhook(context.Context, *RedeliverWebhookRequest) (*RedeliverWebhookResponse, error)
+	// set whether a team member receives email notifications
+	SetMemberNotify(context.Context, *SetMemberNotifyRequest) (*SetMemberNotifyResponse, error)
+	// get whether a team member receives email notifications
+	GetMemberNotify(context.Context, *GetMemberNotifyRequest) (*GetMemberNotifyResponse, error)
+	// set the working capacity of a team member
+	SetMemberCapacity(context.Context, *SetMemberCapacityRequest) (*SetMemberCapacityResponse, error)
+	// get the working capacity and days off of a team member
+	GetMemberCapacity(context.Context, *GetMemberCapacityRequest) (*GetMemberCapacityResponse, error)
+	// add a day when a team member is not available
+	AddMemberDayOff(context.Context, *AddMemberDayOffRequest) (*AddMemberDayOffResponse, error)
+	// remove a day when a team member is not available
+	RemoveMemberDayOff(context.Context, *RemoveMemberDayOffRequest) (*RemoveMemberDayOffResponse, error)
+	// set the estimated hours for a team member on a task
+	SetAssignmentEstimate(context.Context, *SetAssignmentEstimateRequest) (*SetAssignmentEstimateResponse, error)
+	// compare weekly assigned hours of team members against their capacity
+	GetCapacityReport(context.Context, *GetCapacityReportRequest) (*GetCapacityReportResponse, error)
+	// list days where task assignments of a team member exceed capacity
+	GetOverallocations(context.Context, *GetOverallocationsRequest) (*GetOverallocationsResponse, error)
+	// propose task date shifts within available float to resolve over-allocations
+	SuggestLeveling(context.Context, *SuggestLevelingRequest) (*SuggestLevelingResponse, error)
+	// create a new person in the account directory
+	CreatePerson(context.Context, *CreatePersonRequest) (*CreatePersonResponse, error)
+	// update an existing person
+	UpdatePerson(context.Context, *UpdatePersonRequest) (*UpdatePersonResponse, error)
+	// delete an existing person
+	DeletePerson(context.Context, *DeletePersonRequest) (*DeletePersonResponse, error)
+	// get person by id
+	GetPersonById(context.Context, *GetPersonByIdRequest) (*GetPersonByIdResponse, error)
+	// get person by email
+	GetPersonByEmail(context.Context, *GetPersonByEmailRequest) (*GetPersonByEmailResponse, error)
+	// get all persons in the account directory
+	GetPersons(context.Context, *GetPersonsRequest) (*GetPersonsResponse, error)
+	// get the project memberships of a person
+	GetPersonProjects(context.Context, *GetPersonProjectsRequest) (*GetPersonProjectsResponse, error)
+	// get the task assignments of a person across projects
+	GetPersonTasks(context.Context, *GetPersonTasksRequest) (*GetPersonTasksResponse, error)
+	// bind a person to an MService user id
+	SetPersonUser(context.Context, *SetPersonUserRequest) (*SetPersonUserResponse, error)
+	// get the task assignments of the calling user across projects
+	GetMyTasks(context.Context, *GetMyTasksRequest) (*GetMyTasksResponse, error)
+	// grant a user or project role access to a project
+	CreateProjectGrant(context.Context, *CreateProjectGrantRequest) (*CreateProjectGrantResponse, error)
+	// update the access of an existing project grant
+	UpdateProjectGrant(context.Context, *UpdateProjectGrantRequest) (*UpdateProjectGrantResponse, error)
+	// delete an existing project grant
+	DeleteProjectGrant(context.Context, *DeleteProjectGrantRequest) (*DeleteProjectGrantResponse, error)
+	// get the grants of a project
+	GetProjectGrants(context.Context, *GetProjectGrantsRequest) (*GetProjectGrantsResponse, error)
+	// replace the task hours for task and member
+	SetTaskHours(context.Context, *SetTaskHoursRequest) (*SetTaskHoursResponse, error)
+	// create an api key for automation
+	CreateApiKey(context.Context, *CreateApiKeyRequest) (*CreateApiKeyResponse, error)
+	// revoke an existing api key
+	RevokeApiKey(context.Context, *RevokeApiKeyRequest) (*RevokeApiKeyResponse, error)
+	// get the api keys of the account
+	GetApiKeys(context.Context, *GetApiKeysRequest) (*GetApiKeysResponse, error)
+	// create the tasks of a project together, or none of them
+	BatchCreateTasks(context.Context, *BatchCreateTasksRequest) (*BatchCreateTasksResponse, error)
+	// update the tasks of a project together, or none of them
+	BatchUpdateTasks(context.Context, *BatchUpdateTasksRequest) (*BatchUpdateTasksResponse, error)
+	// assign team members to the tasks of a project together, or none of them
+	BatchAssignMembers(context.Context, *BatchAssignMembersRequest) (*BatchAssignMembersResponse, error)
 	mustEmbedUnimplementedMServiceProjectServer()
 }
 
@@ -599,645 +1116,1446 @@ func (UnimplementedMServiceProjectServer) GetProjectRoleTypes(context.Context, *
 func (UnimplementedMServiceProjectServer) GetServerVersion(context.Context, *GetServerVersionRequest) (*GetServerVersionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetServerVersion not implemented")
 }
-func (UnimplementedMServiceProjectServer) mustEmbedUnimplementedMServiceProjectServer() {}
-
-// UnsafeMServiceProjectServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to MServiceProjectServer will
-// result in compilation errors.
-type UnsafeMServiceProjectServer interface {
-	mustEmbedUnimplementedMServiceProjectServer()
+func (UnimplementedMServiceProjectServer) WatchProject(*WatchProjectRequest, MServiceProject_WatchProjectServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchProject not implemented")
 }
-
-func RegisterMServiceProjectServer(s grpc.ServiceRegistrar, srv MServiceProjectServer) {
-	s.RegisterService(&MServiceProject_ServiceDesc, srv)
+func (UnimplementedMServiceProjectServer) CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedMServiceProjectServer) UpdateWebhook(context.Context, *UpdateWebhookRequest) (*UpdateWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateWebhook not implemented")
+}
+func (UnimplementedMServiceProjectServer) DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedMServiceProjectServer) GetWebhooks(context.Context, *GetWebhooksRequest) (*GetWebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhooks not implemented")
+}
+func (UnimplementedMServiceProjectServer) GetWebhookDeliveries(context.Context, *GetWebhookDeliveriesRequest) (*GetWebhookDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhookDeliveries not implemented")
+}
+func (UnimplementedMServiceProjectServer) RedeliverWebhook(context.Context, *RedeliverWebhookRequest) (*RedeliverWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeliverWebhook not implemented")
+}
+func (UnimplementedMServiceProjectServer) SetMemberNotify(context.Context, *SetMemberNotifyRequest) (*SetMemberNotifyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMemberNotify not implemented")
+}
+func (UnimplementedMServiceProjectServer) GetMemberNotify(context.Context, *GetMemberNotifyRequest) (*GetMemberNotifyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMemberNotify not implemented")
+}
+func (UnimplementedMServiceProjectServer) SetMemberCapacity(context.Context, *SetMemberCapacityRequest) (*SetMemberCapacityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMemberCapacity not implemented")
+}
+func (UnimplementedMServiceProjectServer) GetMemberCapacity(context.Context, *GetMemberCapacityRequest) (*GetMemberCapacityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMemberCapacity not implemented")
+}
+func (UnimplementedMServiceProjectServer) AddMemberDayOff(context.Context, *AddMemberDayOffRequest) (*AddMemberDayOffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddMemberDayOff not implemented")
+}
+func (UnimplementedMServiceProjectServer) RemoveMemberDayOff(context.Context, *RemoveMemberDayOffRequest) (*RemoveMemberDayOffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveMemberDayOff not implemented")
+}
+func (UnimplementedMServiceProjectServer) SetAssignmentEstimate(context.Context, *SetAssignmentEstimateRequest) (*SetAssignmentEstimateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAssignmentEstimate not implemented")
+}
+func (UnimplementedMServiceProjectServer) GetCapacityReport(context.Context, *GetCapacityReportRequest) (*GetCapacityReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCapacityReport not implemented")
+}
+func (UnimplementedMServiceProjectServer) GetOverallocations(context.Context, *GetOverallocationsRequest) (*GetOverallocationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOverallocations not implemented")
+}
+func (UnimplementedMServiceProjectServer) SuggestLeveling(context.Context, *SuggestLevelingRequest) (*SuggestLevelingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuggestLeveling not implemented")
+}
+func (UnimplementedMServiceProjectServer) CreatePerson(context.Context, *CreatePersonRequest) (*CreatePersonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePerson not implemented")
+}
+func (UnimplementedMServiceProjectServer) UpdatePerson(context.Context, *UpdatePersonRequest) (*UpdatePersonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePerson not implemented")
+}
+func (UnimplementedMServiceProjectServer) DeletePerson(context.Context, *DeletePersonRequest) (*DeletePersonResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePerson not implemented")
+}
+func (UnimplementedMServiceProjectServer) GetPersonById(context.Context, *GetPersonByIdRequest) (*GetPersonByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPersonById not implemented")
+}
+func (UnimplementedMServiceProjectServer) GetPersonByEmail(context.Context, *GetPersonByEmailRequest) (*GetPersonByEmailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPersonByEmail not implemented")
+}
+func (UnimplementedMServiceProjectServer) GetPersons(context.Context, *GetPersonsRequest) (*GetPersonsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPersons not implemented")
+}
+func (UnimplementedMServiceProjectServer) GetPersonProjects(context.Context, *GetPersonProjectsRequest) (*GetPersonProjectsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPersonProjects not implemented")
+}
+func (UnimplementedMServiceProjectServer) GetPersonTasks(context.Context, *GetPersonTasksRequest) (*GetPersonTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPersonTasks not implemented")
+}
+func (UnimplementedMServiceProjectServer) SetPersonUser(context.Context, *SetPersonUserRequest) (*SetPersonUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPersonUser not implemented")
+}
+func (UnimplementedMServiceProjectServer) GetMyTasks(context.Context, *GetMyTasksRequest) (*GetMyTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMyTasks not implemented")
+}
+func (UnimplementedMServiceProjectServer) CreateProjectGrant(context.Context, *CreateProjectGrantRequest) (*CreateProjectGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProjectGrant not implemented")
+}
+func (UnimplementedMServiceProjectServer) UpdateProjectGrant(context.Context, *UpdateProjectGrantRequest) (*UpdateProjectGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProjectGrant not implemented")
+}
+func (UnimplementedMServiceProjectServer) DeleteProjectGrant(context.Context, *DeleteProjectGrantRequest) (*DeleteProjectGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProjectGrant not implemented")
+}
+func (UnimplementedMServiceProjectServer) GetProjectGrants(context.Context, *GetProjectGrantsRequest) (*GetProjectGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProjectGrants not implemented")
+}
+func (UnimplementedMServiceProjectServer) SetTaskHours(context.Context, *SetTaskHoursRequest) (*SetTaskHoursResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTaskHours not implemented")
+}
+func (UnimplementedMServiceProjectServer) CreateApiKey(context.Context, *CreateApiKeyRequest) (*CreateApiKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateApiKey not implemented")
+}
+func (UnimplementedMServiceProjectServer) RevokeApiKey(context.Context, *RevokeApiKeyRequest) (*RevokeApiKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeApiKey not implemented")
+}
+func (UnimplementedMServiceProjectServer) GetApiKeys(context.Context, *GetApiKeysRequest) (*GetApiKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApiKeys not implemented")
+}
+func (UnimplementedMServiceProjectServer) BatchCreateTasks(context.Context, *BatchCreateTasksRequest) (*BatchCreateTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateTasks not implemented")
+}
+func (UnimplementedMServiceProjectServer) BatchUpdateTasks(context.Context, *BatchUpdateTasksRequest) (*BatchUpdateTasksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchUpdateTasks not implemented")
+}
+func (UnimplementedMServiceProjectServer) BatchAssignMembers(context.Context, *BatchAssignMembersRequest) (*BatchAssignMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchAssignMembers not implemented")
+}
+func (UnimplementedMServiceProjectServer) mustEmbedUnimplementedMServiceProjectServer() {}
+
+// UnsafeMServiceProjectServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MServiceProjectServer will
+// result in compilation errors.
+type UnsafeMServiceProjectServer interface {
+	mustEmbedUnimplementedMServiceProjectServer()
+}
+
+func RegisterMServiceProjectServer(s grpc.ServiceRegistrar, srv MServiceProjectServer) {
+	s.RegisterService(&MServiceProject_ServiceDesc, srv)
+}
+
+func _MServiceProject_CreateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceProjectServer).CreateProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/create_project",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceProjectServer).CreateProject(ctx, req.(*CreateProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceProject_UpdateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceProjectServer).UpdateProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/update_project",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceProjectServer).UpdateProject(ctx, req.(*UpdateProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceProject_DeleteProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceProjectServer).DeleteProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/delete_project",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceProjectServer).DeleteProject(ctx, req.(*DeleteProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceProject_GetProjectNames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectNamesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceProjectServer).GetProjectNames(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/get_project_names",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceProjectServer).GetProjectNames(ctx, req.(*GetProjectNamesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceProject_GetProjectByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceProjectServer).GetProjectByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/get_project_by_name",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceProjectServer).GetProjectByName(ctx, req.(*GetProjectByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceProject_GetProjectById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceProjectServer).GetProjectById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/get_project_by_id",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceProjectServer).GetProjectById(ctx, req.(*GetProjectByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceProject_GetProjectWrapperByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectWrapperByNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceProjectServer).GetProjectWrapperByName(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/get_project_wrapper_by_name",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceProjectServer).GetProjectWrapperByName(ctx, req.(*GetProjectWrapperByNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceProject_GetProjectWrapperById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectWrapperByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceProjectServer).GetProjectWrapperById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/get_project_wrapper_by_id",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceProjectServer).GetProjectWrapperById(ctx, req.(*GetProjectWrapperByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceProject_CreateStatusType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateStatusTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceProjectServer).CreateStatusType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/create_status_type",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceProjectServer).CreateStatusType(ctx, req.(*CreateStatusTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceProject_UpdateStatusType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateStatusTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceProjectServer).UpdateStatusType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/update_status_type",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceProjectServer).UpdateStatusType(ctx, req.(*UpdateStatusTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceProject_DeleteStatusType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteStatusTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceProjectServer).DeleteStatusType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/delete_status_type",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceProjectServer).DeleteStatusType(ctx, req.(*DeleteStatusTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceProject_GetStatusType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatusTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceProjectServer).GetStatusType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/get_status_type",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceProjectServer).GetStatusType(ctx, req.(*GetStatusTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceProject_GetStatusTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStatusTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceProjectServer).GetStatusTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/get_status_types",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceProjectServer).GetStatusTypes(ctx, req.(*GetStatusTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceProject_CreateTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceProjectServer).CreateTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/create_task",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceProjectServer).CreateTask(ctx, req.(*CreateTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceProject_UpdateTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceProjectServer).UpdateTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/update_task",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceProjectServer).UpdateTask(ctx, req.(*UpdateTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceProject_DeleteTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceProjectServer).DeleteTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/delete_task",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceProjectServer).DeleteTask(ctx, req.(*DeleteTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceProject_GetTaskById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceProjectServer).GetTaskById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/get_task_by_id",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceProjectServer).GetTaskById(ctx, req.(*GetTaskByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceProject_GetTaskWrapperById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskWrapperByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceProjectServer).GetTaskWrapperById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/get_task_wrapper_by_id",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceProjectServer).GetTaskWrapperById(ctx, req.(*GetTaskWrapperByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceProject_ReorderChildTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReorderChildTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceProjectServer).ReorderChildTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/reorder_child_tasks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceProjectServer).ReorderChildTasks(ctx, req.(*ReorderChildTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceProject_GetTasksByProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTasksByProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceProjectServer).GetTasksByProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/get_tasks_by_project",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceProjectServer).GetTasksByProject(ctx, req.(*GetTasksByProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceProject_CreateTeamMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTeamMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceProjectServer).CreateTeamMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/create_team_member",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceProjectServer).CreateTeamMember(ctx, req.(*CreateTeamMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceProject_UpdateTeamMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTeamMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceProjectServer).UpdateTeamMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/update_team_member",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceProjectServer).UpdateTeamMember(ctx, req.(*UpdateTeamMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceProject_DeleteTeamMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTeamMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceProjectServer).DeleteTeamMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/delete_team_member",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceProjectServer).DeleteTeamMember(ctx, req.(*DeleteTeamMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceProject_GetTeamMemberById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamMemberByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceProjectServer).GetTeamMemberById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/get_team_member_by_id",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceProjectServer).GetTeamMemberById(ctx, req.(*GetTeamMemberByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceProject_GetTeamMemberByProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamMemberByProjectRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceProjectServer).GetTeamMemberByProject(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/get_team_member_by_project",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceProjectServer).GetTeamMemberByProject(ctx, req.(*GetTeamMemberByProjectRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceProject_GetTeamMemberByTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamMemberByTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceProjectServer).GetTeamMemberByTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/get_team_member_by_task",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceProjectServer).GetTeamMemberByTask(ctx, req.(*GetTeamMemberByTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceProject_AddTeamMemberToTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTeamMemberToTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceProjectServer).AddTeamMemberToTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/add_team_member_to_task",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceProjectServer).AddTeamMemberToTask(ctx, req.(*AddTeamMemberToTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceProject_RemoveTeamMemberFromTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveTeamMemberFromTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceProjectServer).RemoveTeamMemberFromTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/remove_team_member_from_task",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceProjectServer).RemoveTeamMemberFromTask(ctx, req.(*RemoveTeamMemberFromTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceProject_AddTaskHours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTaskHoursRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceProjectServer).AddTaskHours(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/add_task_hours",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceProjectServer).AddTaskHours(ctx, req.(*AddTaskHoursRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceProject_CreateProjectRoleType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProjectRoleTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceProjectServer).CreateProjectRoleType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/create_project_role_type",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceProjectServer).CreateProjectRoleType(ctx, req.(*CreateProjectRoleTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceProject_UpdateProjectRoleType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProjectRoleTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceProjectServer).UpdateProjectRoleType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/update_project_role_type",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceProjectServer).UpdateProjectRoleType(ctx, req.(*UpdateProjectRoleTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceProject_DeleteProjectRoleType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProjectRoleTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceProjectServer).DeleteProjectRoleType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/delete_project_role_type",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceProjectServer).DeleteProjectRoleType(ctx, req.(*DeleteProjectRoleTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceProject_GetProjectRoleType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectRoleTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceProjectServer).GetProjectRoleType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/get_project_role_type",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceProjectServer).GetProjectRoleType(ctx, req.(*GetProjectRoleTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceProject_GetProjectRoleTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectRoleTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceProjectServer).GetProjectRoleTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/get_project_role_types",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceProjectServer).GetProjectRoleTypes(ctx, req.(*GetProjectRoleTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceProject_GetServerVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetServerVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceProjectServer).GetServerVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/get_server_version",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceProjectServer).GetServerVersion(ctx, req.(*GetServerVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceProject_WatchProject_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchProjectRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MServiceProjectServer).WatchProject(m, &mServiceProjectWatchProjectServer{stream})
+}
+
+type MServiceProject_WatchProjectServer interface {
+	Send(*WatchProjectResponse) error
+	grpc.ServerStream
+}
+
+type mServiceProjectWatchProjectServer struct {
+	grpc.ServerStream
+}
+
+func (x *mServiceProjectWatchProjectServer) Send(m *WatchProjectResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MServiceProject_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceProjectServer).CreateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/create_webhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceProjectServer).CreateWebhook(ctx, req.(*CreateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MServiceProject_UpdateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MServiceProjectServer).UpdateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/update_webhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MServiceProjectServer).UpdateWebhook(ctx, req.(*UpdateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceProject_CreateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateProjectRequest)
+func _MServiceProject_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceProjectServer).CreateProject(ctx, in)
+		return srv.(MServiceProjectServer).DeleteWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.project.MServiceProject/create_project",
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/delete_webhook",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceProjectServer).CreateProject(ctx, req.(*CreateProjectRequest))
+		return srv.(MServiceProjectServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceProject_UpdateProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateProjectRequest)
+func _MServiceProject_GetWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWebhooksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceProjectServer).UpdateProject(ctx, in)
+		return srv.(MServiceProjectServer).GetWebhooks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.project.MServiceProject/update_project",
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/get_webhooks",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceProjectServer).UpdateProject(ctx, req.(*UpdateProjectRequest))
+		return srv.(MServiceProjectServer).GetWebhooks(ctx, req.(*GetWebhooksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceProject_DeleteProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteProjectRequest)
+func _MServiceProject_GetWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWebhookDeliveriesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceProjectServer).DeleteProject(ctx, in)
+		return srv.(MServiceProjectServer).GetWebhookDeliveries(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.project.MServiceProject/delete_project",
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/get_webhook_deliveries",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceProjectServer).DeleteProject(ctx, req.(*DeleteProjectRequest))
+		return srv.(MServiceProjectServer).GetWebhookDeliveries(ctx, req.(*GetWebhookDeliveriesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceProject_GetProjectNames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProjectNamesRequest)
+func _MServiceProject_RedeliverWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeliverWebhookRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceProjectServer).GetProjectNames(ctx, in)
+		return srv.(MServiceProjectServer).RedeliverWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.project.MServiceProject/get_project_names",
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/redeliver_webhook",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceProjectServer).GetProjectNames(ctx, req.(*GetProjectNamesRequest))
+		return srv.(MServiceProjectServer).RedeliverWebhook(ctx, req.(*RedeliverWebhookRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceProject_GetProjectByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProjectByNameRequest)
+func _MServiceProject_SetMemberNotify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMemberNotifyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceProjectServer).GetProjectByName(ctx, in)
+		return srv.(MServiceProjectServer).SetMemberNotify(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.project.MServiceProject/get_project_by_name",
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/set_member_notify",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceProjectServer).GetProjectByName(ctx, req.(*GetProjectByNameRequest))
+		return srv.(MServiceProjectServer).SetMemberNotify(ctx, req.(*SetMemberNotifyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceProject_GetProjectById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProjectByIdRequest)
+func _MServiceProject_GetMemberNotify_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemberNotifyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceProjectServer).GetProjectById(ctx, in)
+		return srv.(MServiceProjectServer).GetMemberNotify(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.project.MServiceProject/get_project_by_id",
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/get_member_notify",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceProjectServer).GetProjectById(ctx, req.(*GetProjectByIdRequest))
+		return srv.(MServiceProjectServer).GetMemberNotify(ctx, req.(*GetMemberNotifyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceProject_GetProjectWrapperByName_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProjectWrapperByNameRequest)
+func _MServiceProject_SetMemberCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMemberCapacityRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceProjectServer).GetProjectWrapperByName(ctx, in)
+		return srv.(MServiceProjectServer).SetMemberCapacity(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.project.MServiceProject/get_project_wrapper_by_name",
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/set_member_capacity",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceProjectServer).GetProjectWrapperByName(ctx, req.(*GetProjectWrapperByNameRequest))
+		return srv.(MServiceProjectServer).SetMemberCapacity(ctx, req.(*SetMemberCapacityRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceProject_GetProjectWrapperById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProjectWrapperByIdRequest)
+func _MServiceProject_GetMemberCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemberCapacityRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceProjectServer).GetProjectWrapperById(ctx, in)
+		return srv.(MServiceProjectServer).GetMemberCapacity(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.project.MServiceProject/get_project_wrapper_by_id",
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/get_member_capacity",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceProjectServer).GetProjectWrapperById(ctx, req.(*GetProjectWrapperByIdRequest))
+		return srv.(MServiceProjectServer).GetMemberCapacity(ctx, req.(*GetMemberCapacityRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceProject_CreateStatusType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateStatusTypeRequest)
+func _MServiceProject_AddMemberDayOff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddMemberDayOffRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceProjectServer).CreateStatusType(ctx, in)
+		return srv.(MServiceProjectServer).AddMemberDayOff(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.project.MServiceProject/create_status_type",
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/add_member_day_off",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceProjectServer).CreateStatusType(ctx, req.(*CreateStatusTypeRequest))
+		return srv.(MServiceProjectServer).AddMemberDayOff(ctx, req.(*AddMemberDayOffRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceProject_UpdateStatusType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateStatusTypeRequest)
+func _MServiceProject_RemoveMemberDayOff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveMemberDayOffRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceProjectServer).UpdateStatusType(ctx, in)
+		return srv.(MServiceProjectServer).RemoveMemberDayOff(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.project.MServiceProject/update_status_type",
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/remove_member_day_off",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceProjectServer).UpdateStatusType(ctx, req.(*UpdateStatusTypeRequest))
+		return srv.(MServiceProjectServer).RemoveMemberDayOff(ctx, req.(*RemoveMemberDayOffRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceProject_DeleteStatusType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteStatusTypeRequest)
+func _MServiceProject_SetAssignmentEstimate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAssignmentEstimateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceProjectServer).DeleteStatusType(ctx, in)
+		return srv.(MServiceProjectServer).SetAssignmentEstimate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.project.MServiceProject/delete_status_type",
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/set_assignment_estimate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceProjectServer).DeleteStatusType(ctx, req.(*DeleteStatusTypeRequest))
+		return srv.(MServiceProjectServer).SetAssignmentEstimate(ctx, req.(*SetAssignmentEstimateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceProject_GetStatusType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetStatusTypeRequest)
+func _MServiceProject_GetCapacityReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCapacityReportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceProjectServer).GetStatusType(ctx, in)
+		return srv.(MServiceProjectServer).GetCapacityReport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.project.MServiceProject/get_status_type",
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/get_capacity_report",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceProjectServer).GetStatusType(ctx, req.(*GetStatusTypeRequest))
+		return srv.(MServiceProjectServer).GetCapacityReport(ctx, req.(*GetCapacityReportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceProject_GetStatusTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetStatusTypesRequest)
+func _MServiceProject_GetOverallocations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOverallocationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceProjectServer).GetStatusTypes(ctx, in)
+		return srv.(MServiceProjectServer).GetOverallocations(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.project.MServiceProject/get_status_types",
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/get_overallocations",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceProjectServer).GetStatusTypes(ctx, req.(*GetStatusTypesRequest))
+		return srv.(MServiceProjectServer).GetOverallocations(ctx, req.(*GetOverallocationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceProject_CreateTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTaskRequest)
+func _MServiceProject_SuggestLeveling_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuggestLevelingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceProjectServer).CreateTask(ctx, in)
+		return srv.(MServiceProjectServer).SuggestLeveling(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.project.MServiceProject/create_task",
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/suggest_leveling",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceProjectServer).CreateTask(ctx, req.(*CreateTaskRequest))
+		return srv.(MServiceProjectServer).SuggestLeveling(ctx, req.(*SuggestLevelingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceProject_UpdateTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateTaskRequest)
+func _MServiceProject_CreatePerson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePersonRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceProjectServer).UpdateTask(ctx, in)
+		return srv.(MServiceProjectServer).CreatePerson(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.project.MServiceProject/update_task",
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/create_person",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceProjectServer).UpdateTask(ctx, req.(*UpdateTaskRequest))
+		return srv.(MServiceProjectServer).CreatePerson(ctx, req.(*CreatePersonRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceProject_DeleteTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTaskRequest)
+func _MServiceProject_UpdatePerson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePersonRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceProjectServer).DeleteTask(ctx, in)
+		return srv.(MServiceProjectServer).UpdatePerson(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.project.MServiceProject/delete_task",
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/update_person",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceProjectServer).DeleteTask(ctx, req.(*DeleteTaskRequest))
+		return srv.(MServiceProjectServer).UpdatePerson(ctx, req.(*UpdatePersonRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceProject_GetTaskById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTaskByIdRequest)
+func _MServiceProject_DeletePerson_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePersonRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceProjectServer).GetTaskById(ctx, in)
+		return srv.(MServiceProjectServer).DeletePerson(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.project.MServiceProject/get_task_by_id",
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/delete_person",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceProjectServer).GetTaskById(ctx, req.(*GetTaskByIdRequest))
+		return srv.(MServiceProjectServer).DeletePerson(ctx, req.(*DeletePersonRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceProject_GetTaskWrapperById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTaskWrapperByIdRequest)
+func _MServiceProject_GetPersonById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPersonByIdRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceProjectServer).GetTaskWrapperById(ctx, in)
+		return srv.(MServiceProjectServer).GetPersonById(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.project.MServiceProject/get_task_wrapper_by_id",
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/get_person_by_id",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceProjectServer).GetTaskWrapperById(ctx, req.(*GetTaskWrapperByIdRequest))
+		return srv.(MServiceProjectServer).GetPersonById(ctx, req.(*GetPersonByIdRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceProject_ReorderChildTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReorderChildTasksRequest)
+func _MServiceProject_GetPersonByEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPersonByEmailRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceProjectServer).ReorderChildTasks(ctx, in)
+		return srv.(MServiceProjectServer).GetPersonByEmail(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.project.MServiceProject/reorder_child_tasks",
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/get_person_by_email",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceProjectServer).ReorderChildTasks(ctx, req.(*ReorderChildTasksRequest))
+		return srv.(MServiceProjectServer).GetPersonByEmail(ctx, req.(*GetPersonByEmailRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceProject_GetTasksByProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTasksByProjectRequest)
+func _MServiceProject_GetPersons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPersonsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceProjectServer).GetTasksByProject(ctx, in)
+		return srv.(MServiceProjectServer).GetPersons(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.project.MServiceProject/get_tasks_by_project",
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/get_persons",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceProjectServer).GetTasksByProject(ctx, req.(*GetTasksByProjectRequest))
+		return srv.(MServiceProjectServer).GetPersons(ctx, req.(*GetPersonsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceProject_CreateTeamMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTeamMemberRequest)
+func _MServiceProject_GetPersonProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPersonProjectsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceProjectServer).CreateTeamMember(ctx, in)
+		return srv.(MServiceProjectServer).GetPersonProjects(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.project.MServiceProject/create_team_member",
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/get_person_projects",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceProjectServer).CreateTeamMember(ctx, req.(*CreateTeamMemberRequest))
+		return srv.(MServiceProjectServer).GetPersonProjects(ctx, req.(*GetPersonProjectsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceProject_UpdateTeamMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateTeamMemberRequest)
+func _MServiceProject_GetPersonTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPersonTasksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceProjectServer).UpdateTeamMember(ctx, in)
+		return srv.(MServiceProjectServer).GetPersonTasks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.project.MServiceProject/update_team_member",
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/get_person_tasks",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceProjectServer).UpdateTeamMember(ctx, req.(*UpdateTeamMemberRequest))
+		return srv.(MServiceProjectServer).GetPersonTasks(ctx, req.(*GetPersonTasksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceProject_DeleteTeamMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTeamMemberRequest)
+func _MServiceProject_SetPersonUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPersonUserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceProjectServer).DeleteTeamMember(ctx, in)
+		return srv.(MServiceProjectServer).SetPersonUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.project.MServiceProject/delete_team_member",
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/set_person_user",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceProjectServer).DeleteTeamMember(ctx, req.(*DeleteTeamMemberRequest))
+		return srv.(MServiceProjectServer).SetPersonUser(ctx, req.(*SetPersonUserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceProject_GetTeamMemberById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTeamMemberByIdRequest)
+func _MServiceProject_GetMyTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMyTasksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceProjectServer).GetTeamMemberById(ctx, in)
+		return srv.(MServiceProjectServer).GetMyTasks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.project.MServiceProject/get_team_member_by_id",
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/get_my_tasks",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceProjectServer).GetTeamMemberById(ctx, req.(*GetTeamMemberByIdRequest))
+		return srv.(MServiceProjectServer).GetMyTasks(ctx, req.(*GetMyTasksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceProject_GetTeamMemberByProject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTeamMemberByProjectRequest)
+func _MServiceProject_CreateProjectGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProjectGrantRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceProjectServer).GetTeamMemberByProject(ctx, in)
+		return srv.(MServiceProjectServer).CreateProjectGrant(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.project.MServiceProject/get_team_member_by_project",
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/create_project_grant",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceProjectServer).GetTeamMemberByProject(ctx, req.(*GetTeamMemberByProjectRequest))
+		return srv.(MServiceProjectServer).CreateProjectGrant(ctx, req.(*CreateProjectGrantRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceProject_GetTeamMemberByTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTeamMemberByTaskRequest)
+func _MServiceProject_UpdateProjectGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateProjectGrantRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceProjectServer).GetTeamMemberByTask(ctx, in)
+		return srv.(MServiceProjectServer).UpdateProjectGrant(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.project.MServiceProject/get_team_member_by_task",
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/update_project_grant",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceProjectServer).GetTeamMemberByTask(ctx, req.(*GetTeamMemberByTaskRequest))
+		return srv.(MServiceProjectServer).UpdateProjectGrant(ctx, req.(*UpdateProjectGrantRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceProject_AddTeamMemberToTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddTeamMemberToTaskRequest)
+func _MServiceProject_DeleteProjectGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProjectGrantRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceProjectServer).AddTeamMemberToTask(ctx, in)
+		return srv.(MServiceProjectServer).DeleteProjectGrant(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.project.MServiceProject/add_team_member_to_task",
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/delete_project_grant",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceProjectServer).AddTeamMemberToTask(ctx, req.(*AddTeamMemberToTaskRequest))
+		return srv.(MServiceProjectServer).DeleteProjectGrant(ctx, req.(*DeleteProjectGrantRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceProject_RemoveTeamMemberFromTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveTeamMemberFromTaskRequest)
+func _MServiceProject_GetProjectGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectGrantsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceProjectServer).RemoveTeamMemberFromTask(ctx, in)
+		return srv.(MServiceProjectServer).GetProjectGrants(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.project.MServiceProject/remove_team_member_from_task",
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/get_project_grants",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceProjectServer).RemoveTeamMemberFromTask(ctx, req.(*RemoveTeamMemberFromTaskRequest))
+		return srv.(MServiceProjectServer).GetProjectGrants(ctx, req.(*GetProjectGrantsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceProject_AddTaskHours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddTaskHoursRequest)
+func _MServiceProject_SetTaskHours_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTaskHoursRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceProjectServer).AddTaskHours(ctx, in)
+		return srv.(MServiceProjectServer).SetTaskHours(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.project.MServiceProject/add_task_hours",
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/set_task_hours",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceProjectServer).AddTaskHours(ctx, req.(*AddTaskHoursRequest))
+		return srv.(MServiceProjectServer).SetTaskHours(ctx, req.(*SetTaskHoursRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceProject_CreateProjectRoleType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateProjectRoleTypeRequest)
+func _MServiceProject_CreateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApiKeyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceProjectServer).CreateProjectRoleType(ctx, in)
+		return srv.(MServiceProjectServer).CreateApiKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.project.MServiceProject/create_project_role_type",
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/create_api_key",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceProjectServer).CreateProjectRoleType(ctx, req.(*CreateProjectRoleTypeRequest))
+		return srv.(MServiceProjectServer).CreateApiKey(ctx, req.(*CreateApiKeyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceProject_UpdateProjectRoleType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateProjectRoleTypeRequest)
+func _MServiceProject_RevokeApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeApiKeyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceProjectServer).UpdateProjectRoleType(ctx, in)
+		return srv.(MServiceProjectServer).RevokeApiKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.project.MServiceProject/update_project_role_type",
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/revoke_api_key",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceProjectServer).UpdateProjectRoleType(ctx, req.(*UpdateProjectRoleTypeRequest))
+		return srv.(MServiceProjectServer).RevokeApiKey(ctx, req.(*RevokeApiKeyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceProject_DeleteProjectRoleType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteProjectRoleTypeRequest)
+func _MServiceProject_GetApiKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApiKeysRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceProjectServer).DeleteProjectRoleType(ctx, in)
+		return srv.(MServiceProjectServer).GetApiKeys(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.project.MServiceProject/delete_project_role_type",
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/get_api_keys",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceProjectServer).DeleteProjectRoleType(ctx, req.(*DeleteProjectRoleTypeRequest))
+		return srv.(MServiceProjectServer).GetApiKeys(ctx, req.(*GetApiKeysRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceProject_GetProjectRoleType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProjectRoleTypeRequest)
+func _MServiceProject_BatchCreateTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchCreateTasksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceProjectServer).GetProjectRoleType(ctx, in)
+		return srv.(MServiceProjectServer).BatchCreateTasks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.project.MServiceProject/get_project_role_type",
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/batch_create_tasks",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceProjectServer).GetProjectRoleType(ctx, req.(*GetProjectRoleTypeRequest))
+		return srv.(MServiceProjectServer).BatchCreateTasks(ctx, req.(*BatchCreateTasksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceProject_GetProjectRoleTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetProjectRoleTypesRequest)
+func _MServiceProject_BatchUpdateTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateTasksRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceProjectServer).GetProjectRoleTypes(ctx, in)
+		return srv.(MServiceProjectServer).BatchUpdateTasks(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.project.MServiceProject/get_project_role_types",
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/batch_update_tasks",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceProjectServer).GetProjectRoleTypes(ctx, req.(*GetProjectRoleTypesRequest))
+		return srv.(MServiceProjectServer).BatchUpdateTasks(ctx, req.(*BatchUpdateTasksRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MServiceProject_GetServerVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetServerVersionRequest)
+func _MServiceProject_BatchAssignMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchAssignMembersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MServiceProjectServer).GetServerVersion(ctx, in)
+		return srv.(MServiceProjectServer).BatchAssignMembers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/org.gaterace.mservice.project.MServiceProject/get_server_version",
+		FullMethod: "/org.gaterace.mservice.project.MServiceProject/batch_assign_members",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MServiceProjectServer).GetServerVersion(ctx, req.(*GetServerVersionRequest))
+		return srv.(MServiceProjectServer).BatchAssignMembers(ctx, req.(*BatchAssignMembersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1389,7 +2707,161 @@ var MServiceProject_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "get_server_version",
 			Handler:    _MServiceProject_GetServerVersion_Handler,
 		},
+		{
+			MethodName: "create_webhook",
+			Handler:    _MServiceProject_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "update_webhook",
+			Handler:    _MServiceProject_UpdateWebhook_Handler,
+		},
+		{
+			MethodName: "delete_webhook",
+			Handler:    _MServiceProject_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "get_webhooks",
+			Handler:    _MServiceProject_GetWebhooks_Handler,
+		},
+		{
+			MethodName: "get_webhook_deliveries",
+			Handler:    _MServiceProject_GetWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "redeliver_webhook",
+			Handler:    _MServiceProject_RedeliverWebhook_Handler,
+		},
+		{
+			MethodName: "set_member_notify",
+			Handler:    _MServiceProject_SetMemberNotify_Handler,
+		},
+		{
+			MethodName: "get_member_notify",
+			Handler:    _MServiceProject_GetMemberNotify_Handler,
+		},
+		{
+			MethodName: "set_member_capacity",
+			Handler:    _MServiceProject_SetMemberCapacity_Handler,
+		},
+		{
+			MethodName: "get_member_capacity",
+			Handler:    _MServiceProject_GetMemberCapacity_Handler,
+		},
+		{
+			MethodName: "add_member_day_off",
+			Handler:    _MServiceProject_AddMemberDayOff_Handler,
+		},
+		{
+			MethodName: "remove_member_day_off",
+			Handler:    _MServiceProject_RemoveMemberDayOff_Handler,
+		},
+		{
+			MethodName: "set_assignment_estimate",
+			Handler:    _MServiceProject_SetAssignmentEstimate_Handler,
+		},
+		{
+			MethodName: "get_capacity_report",
+			Handler:    _MServiceProject_GetCapacityReport_Handler,
+		},
+		{
+			MethodName: "get_overallocations",
+			Handler:    _MServiceProject_GetOverallocations_Handler,
+		},
+		{
+			MethodName: "suggest_leveling",
+			Handler:    _MServiceProject_SuggestLeveling_Handler,
+		},
+		{
+			MethodName: "create_person",
+			Handler:    _MServiceProject_CreatePerson_Handler,
+		},
+		{
+			MethodName: "update_person",
+			Handler:    _MServiceProject_UpdatePerson_Handler,
+		},
+		{
+			MethodName: "delete_person",
+			Handler:    _MServiceProject_DeletePerson_Handler,
+		},
+		{
+			MethodName: "get_person_by_id",
+			Handler:    _MServiceProject_GetPersonById_Handler,
+		},
+		{
+			MethodName: "get_person_by_email",
+			Handler:    _MServiceProject_GetPersonByEmail_Handler,
+		},
+		{
+			MethodName: "get_persons",
+			Handler:    _MServiceProject_GetPersons_Handler,
+		},
+		{
+			MethodName: "get_person_projects",
+			Handler:    _MServiceProject_GetPersonProjects_Handler,
+		},
+		{
+			MethodName: "get_person_tasks",
+			Handler:    _MServiceProject_GetPersonTasks_Handler,
+		},
+		{
+			MethodName: "set_person_user",
+			Handler:    _MServiceProject_SetPersonUser_Handler,
+		},
+		{
+			MethodName: "get_my_tasks",
+			Handler:    _MServiceProject_GetMyTasks_Handler,
+		},
+		{
+			MethodName: "create_project_grant",
+			Handler:    _MServiceProject_CreateProjectGrant_Handler,
+		},
+		{
+			MethodName: "update_project_grant",
+			Handler:    _MServiceProject_UpdateProjectGrant_Handler,
+		},
+		{
+			MethodName: "delete_project_grant",
+			Handler:    _MServiceProject_DeleteProjectGrant_Handler,
+		},
+		{
+			MethodName: "get_project_grants",
+			Handler:    _MServiceProject_GetProjectGrants_Handler,
+		},
+		{
+			MethodName: "set_task_hours",
+			Handler:    _MServiceProject_SetTaskHours_Handler,
+		},
+		{
+			MethodName: "create_api_key",
+			Handler:    _MServiceProject_CreateApiKey_Handler,
+		},
+		{
+			MethodName: "revoke_api_key",
+			Handler:    _MServiceProject_RevokeApiKey_Handler,
+		},
+		{
+			MethodName: "get_api_keys",
+			Handler:    _MServiceProject_GetApiKeys_Handler,
+		},
+		{
+			MethodName: "batch_create_tasks",
+			Handler:    _MServiceProject_BatchCreateTasks_Handler,
+		},
+		{
+			MethodName: "batch_update_tasks",
+			Handler:    _MServiceProject_BatchUpdateTasks_Handler,
+		},
+		{
+			MethodName: "batch_assign_members",
+			Handler:    _MServiceProject_BatchAssignMembers_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "watch_project",
+			Handler:       _MServiceProject_WatchProject_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "MServiceProject.proto",
 }
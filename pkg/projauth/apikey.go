@@ -0,0 +1,207 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/go-kit/kit/log/level"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/gaterace/mproject/pkg/projstore"
+)
+
+const (
+	// metadata header carrying an api key instead of a JWT
+	ApiKeyHeader = "apikey"
+
+	apiKeyPrefix      = "mpk_"
+	apiKeyPrefixLen   = 12
+	apiKeyExpiredText = "api key is expired"
+	// verified api keys are remembered this long, so that the requests of a throttled key do not query the
+	// database; a key revoked or expiring on another server is still accepted here until then
+	apiKeyCacheTime = 10 * time.Second
+)
+
+var errApiKeyExpired = errors.New(apiKeyExpiredText)
+
+// Api key verified from the store, with its claims or the error for an expired key.
+type cachedApiKey struct {
+	claims  map[string]interface{}
+	err     error
+	expires time.Time
+}
+
+// Generate a new random api key, returning the key, its prefix for display and its hash for storage.
+func NewApiKey() (string, string, string, error) {
+	b := make([]byte, 32)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", "", "", err
+	}
+
+	apiKey := apiKeyPrefix + base64.RawURLEncoding.EncodeToString(b)
+	return apiKey, apiKey[:apiKeyPrefixLen], HashApiKey(apiKey), nil
+}
+
+// Get the hash of an api key, as stored in tb_ApiKey.chvKeyHash.
+func HashApiKey(apiKey string) string {
+	sum := sha256.Sum256([]byte(apiKey))
+	return hex.EncodeToString(sum[:])
+}
+
+// Get the claims of the caller from the api key header if present, otherwise from the JWT.
+func (s *ProjAuth) getClaimsFromContext(ctx context.Context) (*map[string]interface{}, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if ok {
+		keys := md[ApiKeyHeader]
+		if len(keys) > 0 {
+			return s.getApiKeyClaims(keys[0])
+		}
+	}
+
+	return s.GetJwtFromContext(ctx)
+}
+
+// Get the claims of an api key, from the keys verified in the last apiKeyCacheTime if possible.
+func (s *ProjAuth) getApiKeyClaims(apiKey string) (*map[string]interface{}, error) {
+	if !strings.HasPrefix(apiKey, apiKeyPrefix) || (s.store == nil) {
+		return nil, fmt.Errorf("invalid api key")
+	}
+
+	keyHash := HashApiKey(apiKey)
+	now := time.Now()
+
+	s.apiKeyMutex.Lock()
+	cached, ok := s.apiKeys[keyHash]
+	s.apiKeyMutex.Unlock()
+
+	if !ok || now.After(cached.expires) {
+		claims, err := s.verifyApiKey(keyHash)
+		if (err != nil) && (err != errApiKeyExpired) {
+			return nil, err
+		}
+
+		cached = &cachedApiKey{claims: claims, err: err, expires: now.Add(apiKeyCacheTime)}
+
+		s.apiKeyMutex.Lock()
+		// forget expired keys, as the map doubles
+		if len(s.apiKeys) >= s.apiKeySweepSize {
+			for hash, key := range s.apiKeys {
+				if now.After(key.expires) {
+					delete(s.apiKeys, hash)
+				}
+			}
+
+			s.apiKeySweepSize = max(1024, 2*len(s.apiKeys))
+		}
+
+		if s.apiKeys == nil {
+			s.apiKeys = make(map[string]*cachedApiKey)
+		}
+
+		s.apiKeys[keyHash] = cached
+		s.apiKeyMutex.Unlock()
+	}
+
+	if cached.err != nil {
+		return nil, cached.err
+	}
+
+	// each caller gets its own copy of the claims
+	claims := make(map[string]interface{}, len(cached.claims))
+	for name, value := range cached.claims {
+		claims[name] = value
+	}
+
+	return &claims, nil
+}
+
+// Forget the verified api keys, so that a key revoked on this server is refused at once.
+func (s *ProjAuth) forgetApiKeys() {
+	s.apiKeyMutex.Lock()
+	defer s.apiKeyMutex.Unlock()
+
+	s.apiKeys = nil
+}
+
+// Look up an api key by its hash and map it to the claims a JWT would carry: aid, uid and projsvc,
+// along with the key id and its scopes.
+func (s *ProjAuth) verifyApiKey(keyHash string) (map[string]interface{}, error) {
+	ctx := context.Background()
+
+	key, current, err := s.store.ApiKeys().GetApiKeyByHash(ctx, keyHash)
+	if err != nil {
+		if err != projstore.ErrNotFound {
+			level.Error(s.logger).Log("what", "GetApiKeyByHash", "error", err)
+		}
+		return nil, fmt.Errorf("invalid api key")
+	}
+
+	if !current {
+		return nil, errApiKeyExpired
+	}
+
+	apiKeyId := key.GetApiKeyId()
+
+	err = s.store.ApiKeys().TouchApiKey(ctx, apiKeyId)
+	if err != nil {
+		level.Error(s.logger).Log("what", "TouchApiKey", "error", err)
+	}
+
+	level.Info(s.logger).Log("what", "api key verified", "apikeyid", apiKeyId)
+
+	// numbers are float64, as in a JWT decoded from JSON
+	claims := map[string]interface{}{
+		"aid":     float64(key.GetMserviceId()),
+		"uid":     float64(key.GetUserId()),
+		"projsvc": key.GetRole(),
+		"apikey":  float64(apiKeyId),
+	}
+
+	if len(key.GetScopes()) > 0 {
+		claims["scopes"] = key.GetScopes()
+	}
+
+	return claims, nil
+}
+
+// Check that the scopes of an api key allow a method. JWT claims have no scopes.
+func apiKeyAllows(claims *map[string]interface{}, method string) bool {
+	if claims == nil {
+		return true
+	}
+
+	scopes, ok := (*claims)["scopes"].([]string)
+	if !ok {
+		return true
+	}
+
+	for _, scope := range scopes {
+		if matched, _ := path.Match(scope, method); matched {
+			return true
+		}
+	}
+
+	return false
+}
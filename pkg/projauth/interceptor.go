@@ -0,0 +1,358 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projauth
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/go-kit/kit/log/level"
+	"github.com/golang-jwt/jwt/v5"
+
+	pb "github.com/gaterace/mproject/pkg/mserviceproject"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// Get the gRPC server options that install the ProjAuth interceptors.
+func (s *ProjAuth) ServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(s.UnaryInterceptor),
+		grpc.ChainStreamInterceptor(s.StreamInterceptor),
+	}
+}
+
+// Authorize each unary rpc against its policy, route its reads, then log it.
+func (s *ProjAuth) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	start := time.Now().UnixNano()
+	method := methodName(info.FullMethod)
+
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil, status.Error(codes.Internal, "request is not a protobuf message")
+	}
+
+	var resp interface{}
+	var err error
+
+	// limits are checked before authorization, so that a throttled account cannot reach the database; api keys are
+	// verified from a short lived cache, so a throttled key only queries it once every apiKeyCacheTime
+	errCode, errMessage, code, claims := s.authenticate(ctx, method)
+	if errCode == 0 {
+		var release func()
+		release, errCode, errMessage, code = s.acquireLimits(claims, method, true)
+		defer release()
+	}
+
+	if errCode == 0 {
+		errCode, errMessage, code = s.authorize(method, msg, claims)
+	}
+
+	if errCode == 0 {
+		resp, err = handler(s.routeReads(ctx, claims, method), req)
+		s.recordWrite(claims, method)
+		if method == "revoke_api_key" {
+			s.forgetApiKeys()
+		}
+		policy := methodPolicies[method]
+		if (err == nil) && (policy.filter != nil) {
+			if r, ok := resp.(proto.Message); ok {
+				policy.filter(s, claims, r)
+			}
+		}
+	} else {
+		resp, err = errorResponse(method, errCode, errMessage)
+	}
+
+	r, _ := resp.(proto.Message)
+	s.logRequest(method, msg, r, start)
+
+	if (err == nil) && s.statusMode {
+		if errCode != 0 {
+			return nil, statusError(errCode, errMessage, code)
+		}
+		if serr := responseStatusError(r); serr != nil {
+			return nil, serr
+		}
+	}
+
+	return resp, err
+}
+
+// Authorize each server streaming rpc against its policy, using the single request message, then log it.
+func (s *ProjAuth) StreamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	start := time.Now().UnixNano()
+	method := methodName(info.FullMethod)
+
+	md := methodDescriptor(method)
+	if (md == nil) || info.IsClientStream {
+		level.Error(s.logger).Log("what", "unsupported stream", "endpoint", method)
+		return status.Error(codes.Unimplemented, "method not supported")
+	}
+
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(md.Input().FullName())
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	req := mt.New().Interface()
+	err = ss.RecvMsg(req)
+	if err != nil {
+		return err
+	}
+
+	var resp proto.Message
+
+	errCode, errMessage, code, claims := s.authenticate(ss.Context(), method)
+	if errCode == 0 {
+		var release func()
+		release, errCode, errMessage, code = s.acquireLimits(claims, method, false)
+		defer release()
+	}
+
+	if errCode == 0 {
+		errCode, errMessage, code = s.authorize(method, req, claims)
+	}
+
+	if errCode == 0 {
+		var stream grpc.ServerStream = &receivedStream{ServerStream: ss, req: req}
+		if s.statusMode {
+			stream = &statusStream{ServerStream: stream}
+		}
+		err = handler(srv, stream)
+	} else if s.statusMode {
+		err = statusError(errCode, errMessage, code)
+	} else {
+		var r interface{}
+		r, err = errorResponse(method, errCode, errMessage)
+		if err == nil {
+			resp = r.(proto.Message)
+			err = ss.SendMsg(resp)
+		}
+	}
+
+	s.logRequest(method, req, resp, start)
+
+	return err
+}
+
+// Get the claims of the caller for a method, nil for a public method. Returns the error code, message and gRPC
+// status code to respond with, or 0 if authenticated.
+func (s *ProjAuth) authenticate(ctx context.Context, method string) (int32, string, codes.Code,
+	*map[string]interface{}) {
+	policy, ok := methodPolicies[method]
+	if !ok {
+		level.Error(s.logger).Log("what", "no policy for method", "endpoint", method)
+		return 401, "not authorized", codes.PermissionDenied, nil
+	}
+
+	if policy.scope == scopePublic {
+		return 0, "", codes.OK, nil
+	}
+
+	claims, err := s.getClaimsFromContext(ctx)
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return 498, tokenExpiredMessage, codes.Unauthenticated, nil
+		}
+
+		if err == errApiKeyExpired {
+			return 498, apiKeyExpiredText, codes.Unauthenticated, nil
+		}
+
+		return 401, "not authorized", codes.Unauthenticated, nil
+	}
+
+	return 0, "", codes.OK, claims
+}
+
+// Check the request against the policy for the method, setting the tenant fields from the claims.
+// Returns the error code, message and gRPC status code to respond with, or 0 if authorized.
+func (s *ProjAuth) authorize(method string, req proto.Message, claims *map[string]interface{}) (int32, string,
+	codes.Code) {
+	policy := methodPolicies[method]
+	if policy.scope == scopePublic {
+		return 0, "", codes.OK
+	}
+
+	if !apiKeyAllows(claims, method) {
+		return 401, "not authorized", codes.PermissionDenied
+	}
+
+	m := req.ProtoReflect()
+	projsvc := GetStringFromClaims(claims, "projsvc")
+
+	allowed := false
+	switch policy.scope {
+	case scopeAccount:
+		if policy.need == accessNone {
+			allowed = isProjectClaim(projsvc)
+		} else {
+			allowed = claimAccess(projsvc) >= policy.need
+		}
+	case scopeProject:
+		allowed = s.hasProjectAccess(claims, int64Field(m, "project_id"), policy.need)
+	case scopeProjectName:
+		allowed = s.hasProjectNameAccess(claims, stringField(m, "name"), policy.need)
+	case scopeTask:
+		allowed = s.hasTaskAccess(claims, int64Field(m, "task_id"), policy.need)
+	case scopeMember:
+		allowed = s.hasMemberAccess(claims, int64Field(m, "member_id"), policy.need)
+	case scopeGrant:
+		allowed = s.hasGrantAccess(claims, int64Field(m, "grant_id"), policy.need)
+	case scopeHours:
+		var self bool
+		allowed, self = s.hasHoursAccess(claims, int64Field(m, "task_id"), int64Field(m, "member_id"))
+		// team members record their own hours, but only managers can correct them down
+		if r, ok := req.(*pb.AddTaskHoursRequest); allowed && self && ok && !positiveHours(r.GetTaskHours()) {
+			return 510, "task_hours missing or not positive", codes.InvalidArgument
+		}
+	}
+
+	if !allowed {
+		return 401, "not authorized", codes.PermissionDenied
+	}
+
+	setInt64Field(m, "mservice_id", GetInt64FromClaims(claims, "aid"))
+	for field, claim := range policy.claims {
+		setInt64Field(m, field, GetInt64FromClaims(claims, claim))
+	}
+
+	return 0, "", codes.OK
+}
+
+// Log the method, the identifier fields of the request, the error code and the duration.
+func (s *ProjAuth) logRequest(method string, req proto.Message, resp proto.Message, start int64) {
+	keyvals := []interface{}{"endpoint", method}
+
+	req.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		name := string(fd.Name())
+		if strings.HasSuffix(name, "_id") && !fd.IsList() && (fd.Kind() != protoreflect.StringKind) {
+			keyvals = append(keyvals, strings.ReplaceAll(name, "_", ""), v.Interface())
+		}
+		return true
+	})
+
+	var errCode int32
+	if resp != nil {
+		errCode = int32(int64Field(resp.ProtoReflect(), "error_code"))
+	}
+
+	duration := time.Now().UnixNano() - start
+	keyvals = append(keyvals, "errcode", errCode, "duration", duration)
+	level.Info(s.logger).Log(keyvals...)
+}
+
+// Stream that returns the request message already received by the interceptor.
+type receivedStream struct {
+	grpc.ServerStream
+	req      proto.Message
+	received bool
+}
+
+func (r *receivedStream) RecvMsg(m interface{}) error {
+	if r.received {
+		return r.ServerStream.RecvMsg(m)
+	}
+
+	r.received = true
+	proto.Merge(m.(proto.Message), r.req)
+	return nil
+}
+
+// Get the method name from the full gRPC method, eg create_project.
+func methodName(fullMethod string) string {
+	return fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+}
+
+// Get the descriptor of an MServiceProject method.
+func methodDescriptor(method string) protoreflect.MethodDescriptor {
+	sd := pb.File_MServiceProject_proto.Services().ByName("MServiceProject")
+	if sd == nil {
+		return nil
+	}
+
+	return sd.Methods().ByName(protoreflect.Name(method))
+}
+
+// Build the response message for a method carrying only an error code and message.
+func errorResponse(method string, errCode int32, errMessage string) (interface{}, error) {
+	md := methodDescriptor(method)
+	if md == nil {
+		return nil, status.Error(codes.PermissionDenied, errMessage)
+	}
+
+	mt, err := protoregistry.GlobalTypes.FindMessageByName(md.Output().FullName())
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, errMessage)
+	}
+
+	resp := mt.New()
+	setInt64Field(resp, "error_code", int64(errCode))
+	setStringField(resp, "error_message", errMessage)
+
+	return resp.Interface(), nil
+}
+
+func int64Field(m protoreflect.Message, name string) int64 {
+	fd := m.Descriptor().Fields().ByName(protoreflect.Name(name))
+	if fd == nil {
+		return 0
+	}
+
+	switch fd.Kind() {
+	case protoreflect.Int64Kind, protoreflect.Int32Kind, protoreflect.Sint64Kind, protoreflect.Sint32Kind:
+		return m.Get(fd).Int()
+	}
+
+	return 0
+}
+
+func stringField(m protoreflect.Message, name string) string {
+	fd := m.Descriptor().Fields().ByName(protoreflect.Name(name))
+	if (fd == nil) || (fd.Kind() != protoreflect.StringKind) {
+		return ""
+	}
+
+	return m.Get(fd).String()
+}
+
+func setInt64Field(m protoreflect.Message, name string, val int64) {
+	fd := m.Descriptor().Fields().ByName(protoreflect.Name(name))
+	if fd == nil {
+		return
+	}
+
+	switch fd.Kind() {
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind:
+		m.Set(fd, protoreflect.ValueOfInt64(val))
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind:
+		m.Set(fd, protoreflect.ValueOfInt32(int32(val)))
+	}
+}
+
+func setStringField(m protoreflect.Message, name string, val string) {
+	fd := m.Descriptor().Fields().ByName(protoreflect.Name(name))
+	if (fd != nil) && (fd.Kind() == protoreflect.StringKind) {
+		m.Set(fd, protoreflect.ValueOfString(val))
+	}
+}
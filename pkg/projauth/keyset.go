@@ -0,0 +1,392 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projauth
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/go-kit/kit/log/level"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Time to wait after a key file changes before reloading, so that a file being
+// written or several files being replaced are picked up together.
+const keyReloadDelay = 500 * time.Millisecond
+
+// Public key used to verify JWT signatures, with the key id (kid) that selects it.
+type verifyKey struct {
+	kid    string
+	source string
+	key    interface{}
+}
+
+// Set the public key file for the ProjAuth instance, used to validate JWT. The key id
+// is the file name without extension.
+func (s *ProjAuth) SetPublicKey(publicKeyFile string) error {
+	s.keyMutex.Lock()
+	s.pubKeyFile = publicKeyFile
+	s.keyMutex.Unlock()
+
+	return s.ReloadKeys()
+}
+
+// Set a directory of PEM public keys, each named <kid>.pem, used to validate JWT.
+func (s *ProjAuth) SetKeyDirectory(keyDir string) error {
+	s.keyMutex.Lock()
+	s.keyDir = keyDir
+	s.keyMutex.Unlock()
+
+	return s.ReloadKeys()
+}
+
+// Set a local JSON Web Key Set file of public keys used to validate JWT.
+func (s *ProjAuth) SetJwksFile(jwksFile string) error {
+	s.keyMutex.Lock()
+	s.jwksFile = jwksFile
+	s.keyMutex.Unlock()
+
+	return s.ReloadKeys()
+}
+
+// Read the public keys again from the key file, key directory and JWKS file. If any of them
+// cannot be read, the keys already loaded are kept.
+func (s *ProjAuth) ReloadKeys() error {
+	s.keyMutex.RLock()
+	pubKeyFile := s.pubKeyFile
+	keyDir := s.keyDir
+	jwksFile := s.jwksFile
+	s.keyMutex.RUnlock()
+
+	keys := make(map[string]*verifyKey)
+
+	if pubKeyFile != "" {
+		key, err := readPemKey(pubKeyFile)
+		if err != nil {
+			level.Error(s.logger).Log("what", "reading publicKeyFile", "file", pubKeyFile, "error", err)
+			return err
+		}
+
+		keys[key.kid] = key
+	}
+
+	if keyDir != "" {
+		files, err := filepath.Glob(filepath.Join(keyDir, "*.pem"))
+		if err != nil {
+			level.Error(s.logger).Log("what", "reading keyDir", "dir", keyDir, "error", err)
+			return err
+		}
+
+		for _, file := range files {
+			key, err := readPemKey(file)
+			if err != nil {
+				level.Error(s.logger).Log("what", "reading key file", "file", file, "error", err)
+				return err
+			}
+
+			keys[key.kid] = key
+		}
+	}
+
+	if jwksFile != "" {
+		jwksKeys, err := readJwks(jwksFile)
+		if err != nil {
+			level.Error(s.logger).Log("what", "reading jwksFile", "file", jwksFile, "error", err)
+			return err
+		}
+
+		for _, key := range jwksKeys {
+			keys[key.kid] = key
+		}
+	}
+
+	kids := make([]string, 0, len(keys))
+	for kid := range keys {
+		kids = append(kids, kid)
+	}
+
+	sort.Strings(kids)
+
+	s.keyMutex.Lock()
+	s.keys = keys
+	s.keyMutex.Unlock()
+
+	level.Info(s.logger).Log("what", "loaded jwt keys", "kids", strings.Join(kids, ","))
+	return nil
+}
+
+// Reload the public keys whenever the key file, key directory or JWKS file changes.
+func (s *ProjAuth) WatchKeys() error {
+	s.keyMutex.RLock()
+	paths := make(map[string]bool)
+	if s.pubKeyFile != "" {
+		paths[filepath.Dir(s.pubKeyFile)] = true
+	}
+	if s.keyDir != "" {
+		paths[filepath.Clean(s.keyDir)] = true
+	}
+	if s.jwksFile != "" {
+		paths[filepath.Dir(s.jwksFile)] = true
+	}
+	s.keyMutex.RUnlock()
+
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		level.Error(s.logger).Log("what", "NewWatcher", "error", err)
+		return err
+	}
+
+	// files are replaced rather than written in place by most tools, so watch the directories
+	for path := range paths {
+		err = watcher.Add(path)
+		if err != nil {
+			level.Error(s.logger).Log("what", "watching key path", "path", path, "error", err)
+			watcher.Close()
+			return err
+		}
+	}
+
+	go s.watchKeys(watcher)
+	return nil
+}
+
+func (s *ProjAuth) watchKeys(watcher *fsnotify.Watcher) {
+	defer watcher.Close()
+
+	var reload <-chan time.Time
+	for {
+		select {
+		case event, ok := <-watcher.Events:
+			if !ok {
+				return
+			}
+
+			if s.isKeyFile(event.Name) {
+				reload = time.After(keyReloadDelay)
+			}
+		case err, ok := <-watcher.Errors:
+			if !ok {
+				return
+			}
+
+			level.Error(s.logger).Log("what", "watching keys", "error", err)
+		case <-reload:
+			reload = nil
+			s.ReloadKeys()
+		}
+	}
+}
+
+// Is a changed file one that the keys are read from?
+func (s *ProjAuth) isKeyFile(name string) bool {
+	s.keyMutex.RLock()
+	defer s.keyMutex.RUnlock()
+
+	name = filepath.Clean(name)
+	if (s.pubKeyFile != "") && (name == filepath.Clean(s.pubKeyFile)) {
+		return true
+	}
+
+	if (s.jwksFile != "") && (name == filepath.Clean(s.jwksFile)) {
+		return true
+	}
+
+	return (s.keyDir != "") && (filepath.Dir(name) == filepath.Clean(s.keyDir)) && (filepath.Ext(name) == ".pem")
+}
+
+// Get the keys that may verify a token: the key with the token's kid, or every key
+// when the token has no kid.
+func (s *ProjAuth) keysForToken(token *jwt.Token) []*verifyKey {
+	s.keyMutex.RLock()
+	defer s.keyMutex.RUnlock()
+
+	if kid, ok := token.Header["kid"].(string); ok {
+		if key, ok := s.keys[kid]; ok {
+			return []*verifyKey{key}
+		}
+
+		return nil
+	}
+
+	keys := make([]*verifyKey, 0, len(s.keys))
+	for _, key := range s.keys {
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool { return keys[i].kid < keys[j].kid })
+	return keys
+}
+
+// Read a PEM RSA, ECDSA or Ed25519 public key, with the file name without extension as the key id.
+func readPemKey(file string) (*verifyKey, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var parsedKey interface{}
+	parsedKey, err = jwt.ParseRSAPublicKeyFromPEM(data)
+	if err != nil {
+		parsedKey, err = jwt.ParseECPublicKeyFromPEM(data)
+	}
+	if err != nil {
+		parsedKey, err = jwt.ParseEdPublicKeyFromPEM(data)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("not an RSA, ECDSA or Ed25519 public key")
+	}
+
+	base := filepath.Base(file)
+	kid := strings.TrimSuffix(base, filepath.Ext(base))
+
+	return &verifyKey{kid: kid, source: file, key: parsedKey}, nil
+}
+
+// JSON Web Key, as in RFC 7517, limited to the fields of public keys.
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Crv string `json:"crv"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// Read the public keys of a JSON Web Key Set. Keys that are not for signatures are skipped.
+func readJwks(file string) ([]*verifyKey, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+
+	err = json.Unmarshal(data, &jwks)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]*verifyKey, 0, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		if (jwk.Use != "") && (jwk.Use != "sig") {
+			continue
+		}
+
+		if jwk.Kid == "" {
+			return nil, fmt.Errorf("jwks key without kid")
+		}
+
+		var key interface{}
+		switch jwk.Kty {
+		case "RSA":
+			key, err = rsaFromJwk(jwk)
+		case "EC":
+			key, err = ecdsaFromJwk(jwk)
+		case "OKP":
+			key, err = ed25519FromJwk(jwk)
+		default:
+			err = fmt.Errorf("unsupported kty %s", jwk.Kty)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("jwks key %s: %v", jwk.Kid, err)
+		}
+
+		keys = append(keys, &verifyKey{kid: jwk.Kid, source: file, key: key})
+	}
+
+	return keys, nil
+}
+
+func rsaFromJwk(jwk jsonWebKey) (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+	if err != nil {
+		return nil, err
+	}
+
+	e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+	if err != nil {
+		return nil, err
+	}
+
+	if (len(n) == 0) || (len(e) == 0) || (len(e) > 4) {
+		return nil, fmt.Errorf("invalid rsa key")
+	}
+
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+}
+
+func ecdsaFromJwk(jwk jsonWebKey) (*ecdsa.PublicKey, error) {
+	var curve elliptic.Curve
+	switch jwk.Crv {
+	case "P-256":
+		curve = elliptic.P256()
+	case "P-384":
+		curve = elliptic.P384()
+	case "P-521":
+		curve = elliptic.P521()
+	default:
+		return nil, fmt.Errorf("unsupported crv %s", jwk.Crv)
+	}
+
+	x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+	if err != nil {
+		return nil, err
+	}
+
+	y, err := base64.RawURLEncoding.DecodeString(jwk.Y)
+	if err != nil {
+		return nil, err
+	}
+
+	key := &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+	if !curve.IsOnCurve(key.X, key.Y) {
+		return nil, fmt.Errorf("invalid ecdsa key")
+	}
+
+	return key, nil
+}
+
+func ed25519FromJwk(jwk jsonWebKey) (ed25519.PublicKey, error) {
+	if jwk.Crv != "Ed25519" {
+		return nil, fmt.Errorf("unsupported crv %s", jwk.Crv)
+	}
+
+	x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(x) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("invalid ed25519 key")
+	}
+
+	return ed25519.PublicKey(x), nil
+}
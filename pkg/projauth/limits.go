@@ -0,0 +1,155 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projauth
+
+import (
+	"math"
+	"time"
+
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc/codes"
+)
+
+// Per account request limits: a token bucket for all methods, a token bucket for each
+// method with its own rate, and a cap on concurrent unary requests.
+type accountLimits struct {
+	limiter  *rate.Limiter
+	methods  map[string]*rate.Limiter
+	active   int
+	lastUsed time.Time
+}
+
+// Limits of an account idle this long are forgotten, starting it again with full token buckets.
+const limitIdleTime = 10 * time.Minute
+
+// Set the rate of requests per second allowed for each account, with the burst above that rate.
+// Methods in methodRates are also limited to their own rate per account. A rate of 0 is unlimited.
+func (s *ProjAuth) SetRateLimits(requestRate float64, burst int, methodRates map[string]float64) {
+	s.limitMutex.Lock()
+	defer s.limitMutex.Unlock()
+
+	s.requestRate = requestRate
+	s.requestBurst = burst
+	s.methodRates = methodRates
+	s.accountLimits = make(map[int64]*accountLimits)
+}
+
+// Set the number of unary requests an account may have in progress at once, 0 for no limit.
+func (s *ProjAuth) SetMaxConcurrent(maxConcurrent int) {
+	s.limitMutex.Lock()
+	defer s.limitMutex.Unlock()
+
+	s.maxConcurrent = maxConcurrent
+}
+
+// Check the limits of the caller's account for a method. Returns a function to call when the request
+// is done, or the error code, message and gRPC status code to respond with.
+func (s *ProjAuth) acquireLimits(claims *map[string]interface{}, method string, unary bool) (func(), int32, string,
+	codes.Code) {
+	release := func() {}
+
+	mserviceId := GetInt64FromClaims(claims, "aid")
+	if mserviceId == 0 {
+		return release, 0, "", codes.OK
+	}
+
+	now := time.Now()
+
+	s.limitMutex.Lock()
+	defer s.limitMutex.Unlock()
+
+	// forget idle accounts with no request in progress, as the map doubles
+	if len(s.accountLimits) >= s.limitSweepSize {
+		for id, limits := range s.accountLimits {
+			if (limits.active == 0) && (now.Sub(limits.lastUsed) >= limitIdleTime) {
+				delete(s.accountLimits, id)
+			}
+		}
+
+		s.limitSweepSize = max(1024, 2*len(s.accountLimits))
+	}
+
+	limits, ok := s.accountLimits[mserviceId]
+	if !ok {
+		limits = &accountLimits{methods: make(map[string]*rate.Limiter)}
+		if s.requestRate > 0 {
+			limits.limiter = rate.NewLimiter(rate.Limit(s.requestRate), s.requestBurst)
+		}
+
+		if s.accountLimits == nil {
+			s.accountLimits = make(map[int64]*accountLimits)
+		}
+
+		s.accountLimits[mserviceId] = limits
+	}
+
+	limits.lastUsed = now
+
+	if unary && (s.maxConcurrent > 0) && (limits.active >= s.maxConcurrent) {
+		return release, 429, "too many concurrent requests", codes.ResourceExhausted
+	}
+
+	methodLimiter, ok := limits.methods[method]
+	if !ok {
+		if methodRate := s.methodRates[method]; methodRate > 0 {
+			methodLimiter = rate.NewLimiter(rate.Limit(methodRate), int(math.Max(1, math.Ceil(methodRate))))
+		}
+
+		limits.methods[method] = methodLimiter
+	}
+
+	// a token taken from one bucket is given back if the other refuses the request
+	methodToken, ok := takeToken(methodLimiter, now)
+	if !ok {
+		return release, 429, "rate limit exceeded for " + method, codes.ResourceExhausted
+	}
+
+	if _, ok := takeToken(limits.limiter, now); !ok {
+		if methodToken != nil {
+			methodToken.CancelAt(now)
+		}
+		return release, 429, "rate limit exceeded", codes.ResourceExhausted
+	}
+
+	if unary {
+		limits.active++
+		release = func() {
+			s.limitMutex.Lock()
+			limits.active--
+			s.limitMutex.Unlock()
+		}
+	}
+
+	return release, 0, "", codes.OK
+}
+
+// Helper to take a token from a limiter if one is available now, returning the reservation that gives it back
+// when cancelled. A nil limiter allows every request.
+func takeToken(limiter *rate.Limiter, now time.Time) (*rate.Reservation, bool) {
+	if limiter == nil {
+		return nil, true
+	}
+
+	token := limiter.ReserveN(now, 1)
+	if !token.OK() {
+		return nil, false
+	}
+
+	if token.DelayFrom(now) > 0 {
+		token.CancelAt(now)
+		return nil, false
+	}
+
+	return token, true
+}
@@ -0,0 +1,106 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projauth
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAccountLimits(t *testing.T) {
+	s := NewProjectAuth(nil)
+	s.SetRateLimits(1, 1, nil)
+	s.SetMaxConcurrent(1)
+
+	busy := &map[string]interface{}{"aid": float64(7)}
+	idle := &map[string]interface{}{"aid": float64(8)}
+
+	release, errCode, _, _ := s.acquireLimits(busy, "get_project_by_id", true)
+	if errCode != 0 {
+		t.Fatalf("first request: got %d, want 0", errCode)
+	}
+
+	_, errCode, _, _ = s.acquireLimits(busy, "get_project_by_id", true)
+	if errCode != 429 {
+		t.Errorf("concurrent request: got %d, want 429", errCode)
+	}
+
+	idleRelease, errCode, _, _ := s.acquireLimits(idle, "get_project_by_id", true)
+	if errCode != 0 {
+		t.Fatalf("other account: got %d, want 0", errCode)
+	}
+	idleRelease()
+
+	// both accounts go quiet, but only the one with no request in progress is forgotten
+	s.limitMutex.Lock()
+	for _, limits := range s.accountLimits {
+		limits.lastUsed = time.Now().Add(-2 * limitIdleTime)
+	}
+	s.limitSweepSize = 0
+	s.limitMutex.Unlock()
+
+	_, errCode, _, _ = s.acquireLimits(idle, "get_project_by_id", true)
+	if errCode != 0 {
+		t.Errorf("forgotten account: got %d, want 0 with a full bucket", errCode)
+	}
+
+	s.limitMutex.Lock()
+	_, ok := s.accountLimits[7]
+	s.limitMutex.Unlock()
+	if !ok {
+		t.Error("account with a request in progress was forgotten")
+	}
+
+	release()
+}
+
+// A request refused by one bucket does not spend a token from the other.
+func TestLimitTokensGivenBack(t *testing.T) {
+	s := NewProjectAuth(nil)
+	s.SetRateLimits(0.001, 2, map[string]float64{"get_project_by_id": 0.001})
+
+	limited := "get_project_by_id"
+	unlimited := "get_tasks_by_project"
+
+	tests := []struct {
+		aid     float64
+		method  string
+		errCode int32
+	}{
+		// the method bucket refuses the second request, leaving the account a token for another method
+		{7, limited, 0},
+		{7, limited, 429},
+		{7, unlimited, 0},
+		{7, unlimited, 429},
+		// the account bucket refuses the third request, leaving the method its token
+		{8, unlimited, 0},
+		{8, unlimited, 0},
+		{8, limited, 429},
+	}
+
+	for i, tt := range tests {
+		claims := &map[string]interface{}{"aid": tt.aid}
+		_, errCode, _, _ := s.acquireLimits(claims, tt.method, false)
+		if errCode != tt.errCode {
+			t.Errorf("request %d of account %v to %s: got %d, want %d", i, tt.aid, tt.method, errCode, tt.errCode)
+		}
+	}
+
+	s.limitMutex.Lock()
+	tokens := s.accountLimits[8].methods[limited].Tokens()
+	s.limitMutex.Unlock()
+	if tokens < 1 {
+		t.Errorf("method tokens after the account refused: got %v, want 1", tokens)
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projauth
+
+import (
+	pb "github.com/gaterace/mproject/pkg/mserviceproject"
+	"google.golang.org/protobuf/proto"
+)
+
+// How the project of a request is found, to check the caller's access to it.
+const (
+	// no token required
+	scopePublic = iota
+	// account wide, only the projsvc claim is checked
+	scopeAccount
+	// project_id field
+	scopeProject
+	// name field is the project name
+	scopeProjectName
+	// project of the task_id field
+	scopeTask
+	// project of the member_id field
+	scopeMember
+	// project of the grant_id field
+	scopeGrant
+	// project of the task_id field, or the member_id field bound to a projmember caller
+	scopeHours
+)
+
+// Authorization policy for a single rpc.
+type methodPolicy struct {
+	// how the project of the request is found
+	scope int
+	// minimum access level, accessNone with scopeAccount allows any projsvc claim value
+	need int
+	// request fields set from claims, in addition to mservice_id from aid
+	claims map[string]string
+	// adjust a successful response for the caller
+	filter func(s *ProjAuth, claims *map[string]interface{}, resp proto.Message)
+}
+
+// Policy for each rpc of MServiceProject, by method name. Methods not listed are refused.
+var methodPolicies = map[string]methodPolicy{
+	"create_project":               {scope: scopeAccount, need: accessOwner},
+	"update_project":               {scope: scopeProject, need: accessEditor},
+	"delete_project":               {scope: scopeProject, need: accessOwner},
+	"get_project_names":            {scope: scopeAccount, need: accessNone, filter: filterProjectNamesResponse},
+	"get_project_by_name":          {scope: scopeProjectName, need: accessViewer},
+	"get_project_by_id":            {scope: scopeProject, need: accessViewer},
+	"get_project_wrapper_by_name":  {scope: scopeProjectName, need: accessViewer},
+	"get_project_wrapper_by_id":    {scope: scopeProject, need: accessViewer},
+	"create_status_type":           {scope: scopeAccount, need: accessOwner},
+	"update_status_type":           {scope: scopeAccount, need: accessOwner},
+	"delete_status_type":           {scope: scopeAccount, need: accessOwner},
+	"get_status_type":              {scope: scopeAccount, need: accessViewer},
+	"get_status_types":             {scope: scopeAccount, need: accessViewer},
+	"create_task":                  {scope: scopeProject, need: accessEditor},
+	"update_task":                  {scope: scopeTask, need: accessEditor},
+	"delete_task":                  {scope: scopeTask, need: accessEditor},
+	"get_task_by_id":               {scope: scopeTask, need: accessViewer},
+	"get_task_wrapper_by_id":       {scope: scopeTask, need: accessViewer},
+	"reorder_child_tasks":          {scope: scopeTask, need: accessEditor},
+	"get_tasks_by_project":         {scope: scopeProject, need: accessViewer},
+	"create_team_member":           {scope: scopeProject, need: accessEditor},
+	"update_team_member":           {scope: scopeMember, need: accessEditor},
+	"delete_team_member":           {scope: scopeMember, need: accessEditor},
+	"get_team_member_by_id":        {scope: scopeMember, need: accessViewer},
+	"get_team_member_by_project":   {scope: scopeProject, need: accessViewer},
+	"get_team_member_by_task":      {scope: scopeTask, need: accessViewer},
+	"add_team_member_to_task":      {scope: scopeTask, need: accessEditor},
+	"remove_team_member_from_task": {scope: scopeTask, need: accessEditor},
+	"add_task_hours":               {scope: scopeHours, need: accessEditor},
+	"create_project_role_type":     {scope: scopeAccount, need: accessOwner},
+	"update_project_role_type":     {scope: scopeAccount, need: accessOwner},
+	"delete_project_role_type":     {scope: scopeAccount, need: accessOwner},
+	"get_project_role_type":        {scope: scopeAccount, need: accessOwner},
+	"get_project_role_types":       {scope: scopeAccount, need: accessOwner},
+	"get_server_version":           {scope: scopePublic, need: accessNone},
+	"watch_project":                {scope: scopeProject, need: accessViewer},
+	"create_webhook":               {scope: scopeAccount, need: accessOwner},
+	"update_webhook":               {scope: scopeAccount, need: accessOwner},
+	"delete_webhook":               {scope: scopeAccount, need: accessOwner},
+	"get_webhooks":                 {scope: scopeAccount, need: accessOwner},
+	"get_webhook_deliveries":       {scope: scopeAccount, need: accessOwner},
+	"redeliver_webhook":            {scope: scopeAccount, need: accessOwner},
+	"set_member_notify":            {scope: scopeMember, need: accessEditor},
+	"get_member_notify":            {scope: scopeMember, need: accessViewer},
+	"set_member_capacity":          {scope: scopeMember, need: accessEditor},
+	"get_member_capacity":          {scope: scopeMember, need: accessViewer},
+	"add_member_day_off":           {scope: scopeMember, need: accessEditor},
+	"remove_member_day_off":        {scope: scopeMember, need: accessEditor},
+	"set_assignment_estimate":      {scope: scopeTask, need: accessEditor},
+	"get_capacity_report":          {scope: scopeProject, need: accessViewer},
+	"get_overallocations":          {scope: scopeProject, need: accessViewer},
+	"suggest_leveling":             {scope: scopeProject, need: accessViewer},
+	"create_person":                {scope: scopeAccount, need: accessEditor},
+	"update_person":                {scope: scopeAccount, need: accessEditor},
+	"delete_person":                {scope: scopeAccount, need: accessEditor},
+	"get_person_by_id":             {scope: scopeAccount, need: accessViewer},
+	"get_person_by_email":          {scope: scopeAccount, need: accessViewer},
+	"get_persons":                  {scope: scopeAccount, need: accessViewer},
+	"get_person_projects":          {scope: scopeAccount, need: accessViewer},
+	"get_person_tasks":             {scope: scopeAccount, need: accessViewer},
+	"set_person_user":              {scope: scopeAccount, need: accessOwner},
+	"get_my_tasks":                 {scope: scopeAccount, need: accessNone, claims: map[string]string{"user_id": "uid"}},
+	"create_project_grant":         {scope: scopeProject, need: accessOwner},
+	"update_project_grant":         {scope: scopeGrant, need: accessOwner},
+	"delete_project_grant":         {scope: scopeGrant, need: accessOwner},
+	"get_project_grants":           {scope: scopeProject, need: accessOwner},
+	"set_task_hours":               {scope: scopeHours, need: accessEditor},
+	"create_api_key":               {scope: scopeAccount, need: accessOwner},
+	"revoke_api_key":               {scope: scopeAccount, need: accessOwner},
+	"get_api_keys":                 {scope: scopeAccount, need: accessOwner},
+	"batch_create_tasks":           {scope: scopeProject, need: accessEditor},
+	"batch_update_tasks":           {scope: scopeProject, need: accessEditor},
+	"batch_assign_members":         {scope: scopeProject, need: accessEditor},
+}
+
+// Callers without account wide read access only see the project names they were granted.
+func filterProjectNamesResponse(s *ProjAuth, claims *map[string]interface{}, resp proto.Message) {
+	if r, ok := resp.(*pb.GetProjectNamesResponse); ok && (r.GetErrorCode() == 0) {
+		r.Names = s.filterProjectNames(claims, r.GetNames())
+	}
+}
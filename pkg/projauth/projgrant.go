@@ -0,0 +1,215 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projauth
+
+import (
+	"context"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+	"github.com/go-kit/kit/log/level"
+
+	"github.com/gaterace/mproject/pkg/projstore"
+)
+
+// Access levels on a project, from the projsvc claim or from project grants (tb_ProjectGrant.intAccessLevel).
+const (
+	accessNone   = 0
+	accessViewer = 1
+	accessEditor = 2
+	accessOwner  = 3
+)
+
+// Get the account wide access level for a projsvc claim value. The projuser claim value has no
+// account wide access, only that given by project grants. The projmember claim value reads like
+// projro, and can also record hours for the team members bound to the caller.
+func claimAccess(projsvc string) int {
+	switch projsvc {
+	case "projadmin":
+		return accessOwner
+	case "projrw":
+		return accessEditor
+	case "projro", "projmember":
+		return accessViewer
+	}
+
+	return accessNone
+}
+
+// Is the projsvc claim one of the values recognized by mproject?
+func isProjectClaim(projsvc string) bool {
+	return (claimAccess(projsvc) > accessNone) || (projsvc == "projuser")
+}
+
+// Get the access level of the caller to a project, the higher of the projsvc claim and
+// any grant to the caller's user id or to their role as a team member of the project.
+func (s *ProjAuth) projectAccess(claims *map[string]interface{}, projectId int64) int {
+	access := claimAccess(GetStringFromClaims(claims, "projsvc"))
+	userId := GetInt64FromClaims(claims, "uid")
+	if (access == accessOwner) || (userId == 0) || (s.store == nil) {
+		return access
+	}
+
+	granted, err := s.store.Grants().GetGrantedAccess(context.Background(), projectId,
+		GetInt64FromClaims(claims, "aid"), userId)
+	if err != nil {
+		level.Error(s.logger).Log("what", "GetGrantedAccess", "error", err)
+		return access
+	}
+
+	if int(granted) > access {
+		access = int(granted)
+	}
+
+	return access
+}
+
+// Look up the project id for an entity, 0 if not found.
+func (s *ProjAuth) lookupProjectId(lookup func(ctx context.Context, store projstore.Store) (int64, error)) int64 {
+	if s.store == nil {
+		return 0
+	}
+
+	projectId, err := lookup(context.Background(), s.store)
+	if err != nil {
+		if err != projstore.ErrNotFound {
+			level.Error(s.logger).Log("what", "lookupProjectId", "error", err)
+		}
+		return 0
+	}
+
+	return projectId
+}
+
+// Check that the caller has at least the needed access to a project.
+func (s *ProjAuth) hasProjectAccess(claims *map[string]interface{}, projectId int64, need int) bool {
+	if claimAccess(GetStringFromClaims(claims, "projsvc")) >= need {
+		return true
+	}
+
+	if !isProjectClaim(GetStringFromClaims(claims, "projsvc")) || (projectId == 0) {
+		return false
+	}
+
+	return s.projectAccess(claims, projectId) >= need
+}
+
+// Check that the caller has at least the needed access to the project with a name.
+func (s *ProjAuth) hasProjectNameAccess(claims *map[string]interface{}, name string, need int) bool {
+	if claimAccess(GetStringFromClaims(claims, "projsvc")) >= need {
+		return true
+	}
+
+	projectId := s.lookupProjectId(func(ctx context.Context, store projstore.Store) (int64, error) {
+		project, err := store.Projects().GetProjectByName(ctx, name, GetInt64FromClaims(claims, "aid"))
+		return project.GetProjectId(), err
+	})
+	return s.hasProjectAccess(claims, projectId, need)
+}
+
+// Check that the caller has at least the needed access to the project of a task.
+func (s *ProjAuth) hasTaskAccess(claims *map[string]interface{}, taskId int64, need int) bool {
+	if claimAccess(GetStringFromClaims(claims, "projsvc")) >= need {
+		return true
+	}
+
+	projectId := s.lookupProjectId(func(ctx context.Context, store projstore.Store) (int64, error) {
+		projectId, _, err := store.Tasks().GetTaskProject(ctx, taskId, GetInt64FromClaims(claims, "aid"))
+		return projectId, err
+	})
+	return s.hasProjectAccess(claims, projectId, need)
+}
+
+// Check that the caller has at least the needed access to the project of a team member.
+func (s *ProjAuth) hasMemberAccess(claims *map[string]interface{}, memberId int64, need int) bool {
+	if claimAccess(GetStringFromClaims(claims, "projsvc")) >= need {
+		return true
+	}
+
+	projectId := s.lookupProjectId(func(ctx context.Context, store projstore.Store) (int64, error) {
+		projectId, _, err := store.Members().GetMemberProject(ctx, memberId, GetInt64FromClaims(claims, "aid"))
+		return projectId, err
+	})
+	return s.hasProjectAccess(claims, projectId, need)
+}
+
+// Check that the caller has at least the needed access to the project of a grant.
+func (s *ProjAuth) hasGrantAccess(claims *map[string]interface{}, grantId int64, need int) bool {
+	if claimAccess(GetStringFromClaims(claims, "projsvc")) >= need {
+		return true
+	}
+
+	projectId := s.lookupProjectId(func(ctx context.Context, store projstore.Store) (int64, error) {
+		return store.Grants().GetGrantProject(ctx, grantId, GetInt64FromClaims(claims, "aid"))
+	})
+	return s.hasProjectAccess(claims, projectId, need)
+}
+
+// Remove the names of projects the caller cannot view.
+func (s *ProjAuth) filterProjectNames(claims *map[string]interface{}, names []string) []string {
+	if claimAccess(GetStringFromClaims(claims, "projsvc")) >= accessViewer {
+		return names
+	}
+
+	visible := make([]string, 0)
+	for _, name := range names {
+		if s.hasProjectNameAccess(claims, name, accessViewer) {
+			visible = append(visible, name)
+		}
+	}
+
+	return visible
+}
+
+// Check that the caller may record hours for a team member on a task: either editor access to the
+// project of the task, or the projmember claim with the team member bound to the caller's user id.
+// Also returns whether the access is only that of the team member recording their own hours.
+func (s *ProjAuth) hasHoursAccess(claims *map[string]interface{}, taskId int64, memberId int64) (bool, bool) {
+	if s.hasTaskAccess(claims, taskId, accessEditor) {
+		return true, false
+	}
+
+	userId := GetInt64FromClaims(claims, "uid")
+	if (GetStringFromClaims(claims, "projsvc") != "projmember") || (userId == 0) || (s.store == nil) {
+		return false, false
+	}
+
+	ctx := context.Background()
+	mserviceId := GetInt64FromClaims(claims, "aid")
+
+	_, personId, err := s.store.Members().GetMemberProject(ctx, memberId, mserviceId)
+	if (err != nil) || (personId == 0) {
+		return false, false
+	}
+
+	person, err := s.store.Persons().GetPersonByUser(ctx, userId, mserviceId)
+	if err != nil {
+		if err != projstore.ErrNotFound {
+			level.Error(s.logger).Log("what", "GetPersonByUser", "error", err)
+		}
+		return false, false
+	}
+
+	allowed := person.GetPersonId() == personId
+	return allowed, allowed
+}
+
+// Check that hours are given and above zero.
+func positiveHours(hours *dml.Decimal) bool {
+	if hours == nil {
+		return false
+	}
+
+	d, err := hours.ConvertDecimal()
+	return (err == nil) && d.IsPositive()
+}
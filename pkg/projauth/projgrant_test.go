@@ -0,0 +1,249 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projauth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+	"github.com/go-kit/kit/log"
+
+	pb "github.com/gaterace/mproject/pkg/mserviceproject"
+	"github.com/gaterace/mproject/pkg/projstore"
+)
+
+// Helper to get a ProjAuth on a memory store with a project 1 of account 7, where user 42 is a team member
+// with role 2, which is granted editor access.
+func newMemoryAuth(t *testing.T) (*ProjAuth, projstore.Store, int64) {
+	t.Helper()
+
+	ctx := context.Background()
+	store := projstore.NewMemoryStore()
+
+	personId, err := store.Persons().CreatePerson(ctx, &pb.Person{MserviceId: 7, Name: "ann",
+		Email: "ann@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = store.Persons().SetPersonUser(ctx, personId, 7, 1, 42)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	memberId, err := store.Members().CreateMember(ctx, &pb.TeamMember{MserviceId: 7, ProjectId: 1, Name: "ann",
+		ProjectRoleId: 2, Email: "ann@example.com", PersonId: personId})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = store.Grants().CreateGrant(ctx, &pb.ProjectGrant{MserviceId: 7, ProjectId: 1, ProjectRoleId: 2,
+		Access: "editor"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := NewProjectAuth(nil)
+	s.SetLogger(log.NewNopLogger())
+	s.SetStore(store)
+
+	return s, store, memberId
+}
+
+func TestProjectAccess(t *testing.T) {
+	s, _, memberId := newMemoryAuth(t)
+
+	tests := []struct {
+		name    string
+		projsvc string
+		userId  int64
+		want    int
+	}{
+		{"role grant", "projuser", 42, accessEditor},
+		{"claim above grant", "projadmin", 42, accessOwner},
+		{"claim below grant", "projro", 42, accessEditor},
+		{"other user", "projuser", 43, accessNone},
+		{"no user", "projro", 0, accessViewer},
+	}
+
+	for _, tt := range tests {
+		claims := map[string]interface{}{"aid": float64(7), "uid": float64(tt.userId), "projsvc": tt.projsvc}
+		if got := s.projectAccess(&claims, 1); got != tt.want {
+			t.Errorf("%s: got %d, want %d", tt.name, got, tt.want)
+		}
+	}
+
+	member := map[string]interface{}{"aid": float64(7), "uid": float64(42), "projsvc": "projmember"}
+	if allowed, self := s.hasHoursAccess(&member, 0, memberId); !allowed || !self {
+		t.Errorf("projmember hours for their own team member: got %v self %v, want true self true", allowed, self)
+	}
+
+	other := map[string]interface{}{"aid": float64(7), "uid": float64(43), "projsvc": "projmember"}
+	if allowed, _ := s.hasHoursAccess(&other, 0, memberId); allowed {
+		t.Error("projmember can record hours for another team member")
+	}
+}
+
+// Team members recording their own hours add positive hours only, managers can also correct them down.
+func TestAddTaskHoursAuthorization(t *testing.T) {
+	s, store, _ := newMemoryAuth(t)
+	ctx := context.Background()
+
+	// bob is a team member whose role has no grant, so bob can only record their own hours
+	personId, err := store.Persons().CreatePerson(ctx, &pb.Person{MserviceId: 7, Name: "bob",
+		Email: "bob@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = store.Persons().SetPersonUser(ctx, personId, 7, 1, 44)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	memberId, err := store.Members().CreateMember(ctx, &pb.TeamMember{MserviceId: 7, ProjectId: 1, Name: "bob",
+		ProjectRoleId: 1, Email: "bob@example.com", PersonId: personId})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	taskId, err := store.Tasks().CreateTask(ctx, &pb.Task{MserviceId: 7, ProjectId: 1, Name: "build",
+		Description: "test task", StatusId: 1, StartDate: dml.DateTimeFromString("2030-01-07"),
+		EndDate: dml.DateTimeFromString("2030-01-08")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		projsvc string
+		hours   string
+		want    int32
+	}{
+		{"member adds hours", "projmember", "2.50", 0},
+		{"member subtracts hours", "projmember", "-2.50", 510},
+		{"member adds no hours", "projmember", "0.00", 510},
+		{"member gives no hours", "projmember", "", 510},
+		{"manager subtracts hours", "projrw", "-2.50", 0},
+	}
+
+	for _, tt := range tests {
+		var hours *dml.Decimal
+		if tt.hours != "" {
+			// built directly, since DecimalFromString does not accept a sign
+			hours = &dml.Decimal{Plaintext: tt.hours}
+		}
+
+		claims := map[string]interface{}{"aid": float64(7), "uid": float64(44), "projsvc": tt.projsvc}
+		req := &pb.AddTaskHoursRequest{TaskId: taskId, MemberId: memberId, Version: 1, TaskHours: hours}
+		if errCode, _, _ := s.authorize("add_task_hours", req, &claims); errCode != tt.want {
+			t.Errorf("%s: got %d, want %d", tt.name, errCode, tt.want)
+		}
+	}
+}
+
+func TestApiKeyClaims(t *testing.T) {
+	s, store, _ := newMemoryAuth(t)
+	ctx := context.Background()
+
+	apiKey, prefix, keyHash, err := NewApiKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	apiKeyId, err := store.ApiKeys().CreateApiKey(ctx, &pb.ApiKey{MserviceId: 7, Name: "ci", KeyPrefix: prefix,
+		Role: "projrw", Scopes: []string{"get_*"}, UserId: 42,
+		Expires: dml.DateTimeFromTime(time.Now().Add(time.Hour))}, keyHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	claims, err := s.getApiKeyClaims(apiKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if (GetInt64FromClaims(claims, "aid") != 7) || (GetInt64FromClaims(claims, "uid") != 42) ||
+		(GetStringFromClaims(claims, "projsvc") != "projrw") || (GetInt64FromClaims(claims, "apikey") != apiKeyId) {
+		t.Errorf("claims: got %v", *claims)
+	}
+
+	if !apiKeyAllows(claims, "get_project_by_id") || apiKeyAllows(claims, "delete_project") {
+		t.Errorf("scopes: got %v", (*claims)["scopes"])
+	}
+
+	_, err = s.getApiKeyClaims(apiKey + "x")
+	if err == nil {
+		t.Error("unknown api key accepted")
+	}
+
+	err = store.ApiKeys().RevokeApiKey(ctx, apiKeyId, 7, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the key verified above is remembered until it is forgotten or its cache time passes
+	if _, err = s.getApiKeyClaims(apiKey); err != nil {
+		t.Errorf("cached api key: got %v, want accepted", err)
+	}
+
+	s.apiKeyMutex.Lock()
+	s.apiKeys[keyHash].expires = time.Now().Add(-time.Second)
+	s.apiKeyMutex.Unlock()
+
+	_, err = s.getApiKeyClaims(apiKey)
+	if err == nil {
+		t.Error("revoked api key accepted")
+	}
+}
+
+func TestApiKeyRevokeForgetsKeys(t *testing.T) {
+	s, store, _ := newMemoryAuth(t)
+	ctx := context.Background()
+
+	apiKey, prefix, keyHash, err := NewApiKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	apiKeyId, err := store.ApiKeys().CreateApiKey(ctx, &pb.ApiKey{MserviceId: 7, Name: "ci", KeyPrefix: prefix,
+		Role: "projrw", UserId: 42, Expires: dml.DateTimeFromTime(time.Now().Add(time.Hour))}, keyHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	claims, err := s.getApiKeyClaims(apiKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// callers get their own copy of the cached claims
+	(*claims)["aid"] = float64(8)
+	if claims, err = s.getApiKeyClaims(apiKey); (err != nil) || (GetInt64FromClaims(claims, "aid") != 7) {
+		t.Errorf("cached claims: got %v %v, want account 7", claims, err)
+	}
+
+	err = store.ApiKeys().RevokeApiKey(ctx, apiKeyId, 7, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s.forgetApiKeys()
+
+	if _, err = s.getApiKeyClaims(apiKey); err == nil {
+		t.Error("revoked api key accepted after the cache was cleared")
+	}
+}
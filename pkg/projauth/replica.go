@@ -0,0 +1,106 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projauth
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/gaterace/mproject/pkg/projstore"
+)
+
+// Caller of an rpc, whose reads stay on the primary database for a while after it writes.
+type callerKey struct {
+	mserviceId int64
+	userId     int64
+	apiKeyId   int64
+}
+
+// Set how long the reads of a caller stay on the primary database after it writes, so it reads its own
+// writes however far the read replicas lag. Other read-only rpcs may be served by a read replica.
+func (s *ProjAuth) SetReplicaStickiness(stickiness time.Duration) {
+	s.writeMutex.Lock()
+	defer s.writeMutex.Unlock()
+
+	s.stickiness = stickiness
+	s.lastWrites = make(map[callerKey]time.Time)
+}
+
+// Check if a method only reads, the get_ rpcs including the wrappers and reports, and suggest_leveling.
+func readOnlyMethod(method string) bool {
+	return strings.HasPrefix(method, "get_") || (method == "suggest_leveling")
+}
+
+// Helper to get the caller of an rpc from its claims.
+func getCallerKey(claims *map[string]interface{}) callerKey {
+	return callerKey{
+		mserviceId: GetInt64FromClaims(claims, "aid"),
+		userId:     GetInt64FromClaims(claims, "uid"),
+		apiKeyId:   GetInt64FromClaims(claims, "apikey"),
+	}
+}
+
+// Get the context to call the handler of a method with, allowing a read replica for a read-only method
+// unless the caller wrote recently.
+func (s *ProjAuth) routeReads(ctx context.Context, claims *map[string]interface{}, method string) context.Context {
+	if !readOnlyMethod(method) {
+		return ctx
+	}
+
+	if claims != nil {
+		s.writeMutex.Lock()
+		lastWrite, ok := s.lastWrites[getCallerKey(claims)]
+		s.writeMutex.Unlock()
+
+		if ok && (time.Since(lastWrite) < s.stickiness) {
+			return ctx
+		}
+	}
+
+	return projstore.WithReplica(ctx)
+}
+
+// Record that the caller of a method that writes has just written.
+func (s *ProjAuth) recordWrite(claims *map[string]interface{}, method string) {
+	if (claims == nil) || readOnlyMethod(method) {
+		return
+	}
+
+	now := time.Now()
+
+	s.writeMutex.Lock()
+	defer s.writeMutex.Unlock()
+
+	if s.stickiness <= 0 {
+		return
+	}
+
+	if s.lastWrites == nil {
+		s.lastWrites = make(map[callerKey]time.Time)
+	}
+
+	// forget callers whose reads no longer stick, as the map doubles
+	if len(s.lastWrites) >= s.sweepSize {
+		for caller, lastWrite := range s.lastWrites {
+			if now.Sub(lastWrite) >= s.stickiness {
+				delete(s.lastWrites, caller)
+			}
+		}
+
+		s.sweepSize = max(1024, 2*len(s.lastWrites))
+	}
+
+	s.lastWrites[getCallerKey(claims)] = now
+}
@@ -0,0 +1,124 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projauth
+
+import (
+	"strconv"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// Domain of the ErrorInfo detail attached to gRPC status errors.
+const ErrorDomain = "mproject"
+
+// gRPC status code and ErrorInfo reason for each in-band error_code.
+var statusCodes = map[int32]codes.Code{
+	401: codes.PermissionDenied,
+	404: codes.NotFound,
+	409: codes.AlreadyExists,
+	410: codes.FailedPrecondition,
+	412: codes.Aborted,
+	429: codes.ResourceExhausted,
+	498: codes.Unauthenticated,
+	500: codes.Internal,
+	501: codes.Internal,
+	510: codes.InvalidArgument,
+}
+
+var statusReasons = map[int32]string{
+	401: "NOT_AUTHORIZED",
+	404: "NOT_FOUND",
+	409: "ALREADY_EXISTS",
+	410: "GONE",
+	412: "VERSION_CONFLICT",
+	429: "RATE_LIMITED",
+	498: "TOKEN_EXPIRED",
+	500: "QUERY_FAILED",
+	501: "UPDATE_FAILED",
+	510: "INVALID_ARGUMENT",
+}
+
+// Set whether errors are also returned as gRPC status errors, in addition to the in-band error_code.
+func (s *ProjAuth) SetStatusMode(statusMode bool) {
+	s.statusMode = statusMode
+}
+
+// Build a gRPC status error for an in-band error code and message, with an ErrorInfo detail
+// carrying the error_code, followed by any further details.
+func statusError(errCode int32, errMessage string, code codes.Code, details ...protoadapt.MessageV1) error {
+	reason, ok := statusReasons[errCode]
+	if !ok {
+		reason = "ERROR"
+	}
+
+	st := status.New(code, errMessage)
+	info := &errdetails.ErrorInfo{
+		Reason:   reason,
+		Domain:   ErrorDomain,
+		Metadata: map[string]string{"error_code": strconv.Itoa(int(errCode))},
+	}
+
+	detailed, err := st.WithDetails(append([]protoadapt.MessageV1{info}, details...)...)
+	if err != nil {
+		return st.Err()
+	}
+
+	return detailed.Err()
+}
+
+// Get the gRPC status error for a response message with a non-zero error_code, nil otherwise. A response
+// with per item results, as from the batch rpcs, is also attached as a detail so the results are not lost.
+func responseStatusError(resp proto.Message) error {
+	if resp == nil {
+		return nil
+	}
+
+	m := resp.ProtoReflect()
+	errCode := int32(int64Field(m, "error_code"))
+	if errCode == 0 {
+		return nil
+	}
+
+	code, ok := statusCodes[errCode]
+	if !ok {
+		code = codes.Unknown
+	}
+
+	var details []protoadapt.MessageV1
+	if fd := m.Descriptor().Fields().ByName("results"); (fd != nil) && fd.IsList() && (m.Get(fd).List().Len() > 0) {
+		details = append(details, protoadapt.MessageV1Of(resp))
+	}
+
+	return statusError(errCode, stringField(m, "error_message"), code, details...)
+}
+
+// Stream that ends the rpc with a gRPC status error instead of sending a response with an error_code.
+type statusStream struct {
+	grpc.ServerStream
+}
+
+func (ss *statusStream) SendMsg(m interface{}) error {
+	if r, ok := m.(proto.Message); ok {
+		if err := responseStatusError(r); err != nil {
+			return err
+		}
+	}
+
+	return ss.ServerStream.SendMsg(m)
+}
@@ -0,0 +1,80 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projauth
+
+import (
+	"testing"
+
+	pb "github.com/gaterace/mproject/pkg/mserviceproject"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestResponseStatusError(t *testing.T) {
+	resp := &pb.BatchCreateTasksResponse{ErrorCode: 510, ErrorMessage: "invalid tasks in batch",
+		Results: []*pb.BatchResult{{TempId: 1}, {TempId: 2, ErrorCode: 510, ErrorMessage: "description missing"}}}
+
+	st, ok := status.FromError(responseStatusError(resp))
+	if !ok || (st.Code() != codes.InvalidArgument) {
+		t.Fatalf("status: got %v, want %v", st.Code(), codes.InvalidArgument)
+	}
+
+	var info *errdetails.ErrorInfo
+	var batch *pb.BatchCreateTasksResponse
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.ErrorInfo:
+			info = d
+		case *pb.BatchCreateTasksResponse:
+			batch = d
+		}
+	}
+
+	if (info == nil) || (info.GetMetadata()["error_code"] != "510") {
+		t.Errorf("error info: got %v, want error_code 510", info)
+	}
+
+	if (batch == nil) || (len(batch.GetResults()) != 2) || (batch.GetResults()[1].GetErrorMessage() != "description missing") {
+		t.Errorf("batch results: got %v, want the results of the response", batch)
+	}
+
+	// a response without results carries only the error info
+	st, _ = status.FromError(responseStatusError(&pb.CreateTaskResponse{ErrorCode: 404, ErrorMessage: "not found"}))
+	if (st.Code() != codes.NotFound) || (len(st.Details()) != 1) {
+		t.Errorf("single response: got %v with %d details, want %v with 1", st.Code(), len(st.Details()), codes.NotFound)
+	}
+
+	tests := []struct {
+		errCode int32
+		want    codes.Code
+	}{
+		{404, codes.NotFound},
+		{409, codes.AlreadyExists},
+		{412, codes.Aborted},
+		{501, codes.Internal},
+		{510, codes.InvalidArgument},
+	}
+
+	for _, tt := range tests {
+		st, _ = status.FromError(responseStatusError(&pb.UpdateTaskResponse{ErrorCode: tt.errCode}))
+		if st.Code() != tt.want {
+			t.Errorf("error code %d: got %v, want %v", tt.errCode, st.Code(), tt.want)
+		}
+	}
+
+	if responseStatusError(&pb.CreateTaskResponse{}) != nil {
+		t.Errorf("success response: got a status error, want nil")
+	}
+}
@@ -0,0 +1,492 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package projhook delivers MServiceProject change events to registered webhooks.
+// Each payload is POSTed as JSON, signed with the HMAC-SHA256 of the webhook secret over
+// the timestamp and body, and recorded in the delivery log. Failed attempts are retried
+// with exponential backoff from the delivery log, so retries survive a restart.
+package projhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+
+	pb "github.com/gaterace/mproject/pkg/mserviceproject"
+	"github.com/gaterace/mproject/pkg/projstore"
+)
+
+// Webhook event types.
+const (
+	EventTaskCreated       = "task_created"
+	EventTaskStatusChanged = "task_status_changed"
+	EventTaskHoursAdded    = "task_hours_added"
+	EventMemberAssigned    = "member_assigned"
+)
+
+// HTTP headers set on each webhook POST.
+const (
+	HeaderEvent     = "X-MProject-Event"
+	HeaderDelivery  = "X-MProject-Delivery"
+	HeaderSignature = "X-MProject-Signature"
+	HeaderTimestamp = "X-MProject-Timestamp"
+)
+
+const (
+	defaultMaxAttempts  = 5
+	defaultRetryDelay   = 2 * time.Second
+	defaultTimeout      = 10 * time.Second
+	defaultPollInterval = 5 * time.Second
+	queueSize           = 1000
+	deliveryWorkers     = 4
+	maxErrorLength      = 255
+	// seconds a delivery is held by the server attempting it, longer than an attempt can take
+	holdSeconds = 60
+)
+
+var ErrNotFound = errors.New("not found")
+
+// Error for a webhook url that is not http or https, or whose host is a loopback, link-local, private or unspecified
+// address.
+var ErrInvalidUrl = errors.New("invalid webhook url")
+
+var eventTypes = map[string]bool{
+	EventTaskCreated:       true,
+	EventTaskStatusChanged: true,
+	EventTaskHoursAdded:    true,
+	EventMemberAssigned:    true,
+}
+
+// JSON body POSTed to a webhook url.
+type Payload struct {
+	EventType        string `json:"event_type"`
+	Sequence         int64  `json:"sequence"`
+	Created          int64  `json:"created"`
+	MserviceId       int64  `json:"mservice_id"`
+	ProjectId        int64  `json:"project_id"`
+	TaskId           int64  `json:"task_id,omitempty"`
+	MemberId         int64  `json:"member_id,omitempty"`
+	Version          int32  `json:"version,omitempty"`
+	StatusId         int32  `json:"status_id,omitempty"`
+	PreviousStatusId int32  `json:"previous_status_id,omitempty"`
+	TaskHours        string `json:"task_hours,omitempty"`
+}
+
+// A delivery queued for an attempt.
+type deliveryRef struct {
+	mserviceId int64
+	deliveryId int64
+}
+
+type Dispatcher struct {
+	logger       log.Logger
+	store        projstore.Store
+	client       *http.Client
+	allowLocal   bool
+	maxAttempts  int
+	retryDelay   time.Duration
+	pollInterval time.Duration
+	events       chan *pb.ProjectEvent
+	deliveries   chan deliveryRef
+}
+
+// Get a new Dispatcher instance.
+func NewDispatcher() *Dispatcher {
+	d := Dispatcher{}
+	d.client = newClient(false)
+	d.maxAttempts = defaultMaxAttempts
+	d.retryDelay = defaultRetryDelay
+	d.pollInterval = defaultPollInterval
+	d.events = make(chan *pb.ProjectEvent, queueSize)
+	d.deliveries = make(chan deliveryRef, queueSize)
+	return &d
+}
+
+// Set the logger for the Dispatcher instance.
+func (d *Dispatcher) SetLogger(logger log.Logger) {
+	d.logger = logger
+}
+
+// Set the storage of the webhooks and delivery log for the Dispatcher instance.
+func (d *Dispatcher) SetStore(store projstore.Store) {
+	d.store = store
+}
+
+// Set the number of attempts made before a delivery is given up.
+func (d *Dispatcher) SetMaxAttempts(maxAttempts int) {
+	if maxAttempts > 0 {
+		d.maxAttempts = maxAttempts
+	}
+}
+
+// Set whether webhooks may be sent to loopback, link-local, private and unspecified addresses, such as a local test
+// server.
+func (d *Dispatcher) SetAllowLocal(allowLocal bool) {
+	d.allowLocal = allowLocal
+	d.client = newClient(allowLocal)
+}
+
+// Check a webhook url, with the local addresses allowed by the Dispatcher instance.
+func (d *Dispatcher) ValidateUrl(webhookUrl string) error {
+	return ValidateUrl(webhookUrl, d.allowLocal)
+}
+
+// Set the delay before the first retry, doubled for each further retry.
+func (d *Dispatcher) SetRetryDelay(retryDelay time.Duration) {
+	if retryDelay > 0 {
+		d.retryDelay = retryDelay
+	}
+}
+
+// Start the goroutines matching events to webhooks, delivering them and queueing the retries that are due.
+func (d *Dispatcher) Start() {
+	go d.matchEvents()
+	go d.pollDue()
+	for i := 0; i < deliveryWorkers; i++ {
+		go d.deliverQueued()
+	}
+}
+
+// Queue a project change event for webhook delivery, used as a projservice event listener.
+func (d *Dispatcher) HandleEvent(event *pb.ProjectEvent) {
+	if EventTypeFromEvent(event) == "" {
+		return
+	}
+
+	select {
+	case d.events <- event:
+	default:
+		level.Error(d.logger).Log("what", "webhook event queue full", "sequence", event.GetSequence())
+	}
+}
+
+// Check that webhooks can subscribe to the event type.
+func IsValidEventType(eventType string) bool {
+	return eventTypes[eventType]
+}
+
+// Get the webhook event type for a project change event, or empty if webhooks are not notified.
+func EventTypeFromEvent(event *pb.ProjectEvent) string {
+	switch event.GetEntityType() {
+	case "task":
+		if event.GetAction() == "create" {
+			return EventTaskCreated
+		}
+		if event.GetAction() == "update" && event.GetStatusId() != event.GetPreviousStatusId() {
+			return EventTaskStatusChanged
+		}
+	case "assignment":
+		if event.GetAction() == "create" {
+			return EventMemberAssigned
+		}
+		if event.GetAction() == "update" && event.GetTaskHours() != nil {
+			return EventTaskHoursAdded
+		}
+	}
+
+	return ""
+}
+
+// Get the hex encoded HMAC-SHA256 signature of a payload sent at a timestamp, computed over the timestamp, a period
+// and the payload, so that a captured delivery cannot be replayed with a new timestamp.
+func Sign(secret string, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Check that a webhook url is http or https, and unless allowLocal, that its host is not a loopback, link-local,
+// private or unspecified address. Host names are checked again as each delivery connects, once they are resolved.
+func ValidateUrl(webhookUrl string, allowLocal bool) error {
+	u, err := url.Parse(webhookUrl)
+	if (err != nil) || ((u.Scheme != "http") && (u.Scheme != "https")) || (u.Hostname() == "") {
+		return ErrInvalidUrl
+	}
+
+	if allowLocal {
+		return nil
+	}
+
+	host := strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+	if (host == "localhost") || strings.HasSuffix(host, ".localhost") {
+		return ErrInvalidUrl
+	}
+
+	ip := net.ParseIP(host)
+	if (ip != nil) && !allowedIP(ip) {
+		return ErrInvalidUrl
+	}
+
+	return nil
+}
+
+// Check that an address can be the target of a webhook.
+func allowedIP(ip net.IP) bool {
+	return !ip.IsLoopback() && !ip.IsLinkLocalUnicast() && !ip.IsLinkLocalMulticast() && !ip.IsPrivate() &&
+		!ip.IsUnspecified()
+}
+
+// Get an HTTP client that, unless allowLocal, refuses to connect to loopback, link-local, private and unspecified
+// addresses, including through redirects and host names resolving to them.
+func newClient(allowLocal bool) *http.Client {
+	dialer := &net.Dialer{Timeout: defaultTimeout}
+	if !allowLocal {
+		dialer.Control = func(network string, address string, c syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+
+			ip := net.ParseIP(host)
+			if (ip == nil) || !allowedIP(ip) {
+				return fmt.Errorf("%w: %s", ErrInvalidUrl, host)
+			}
+
+			return nil
+		}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{Timeout: defaultTimeout, Transport: transport}
+}
+
+// Generate a new random webhook signing secret.
+func NewSecret() (string, error) {
+	b := make([]byte, 32)
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+// Queue a logged delivery to be sent again and return its log entry.
+func (d *Dispatcher) Redeliver(mserviceId int64, deliveryId int64) (*pb.WebhookDelivery, error) {
+	ref := deliveryRef{mserviceId: mserviceId, deliveryId: deliveryId}
+
+	delivery, _, _, err := d.getDelivery(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	err = d.store.Webhooks().RetryDelivery(context.Background(), deliveryId, mserviceId, holdSeconds)
+	if err == projstore.ErrNotFound {
+		return nil, ErrNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	d.enqueue(ref)
+
+	return delivery, nil
+}
+
+// Match queued events against the account webhooks and log a delivery for each.
+func (d *Dispatcher) matchEvents() {
+	for event := range d.events {
+		eventType := EventTypeFromEvent(event)
+
+		payload := Payload{}
+		payload.EventType = eventType
+		payload.Sequence = event.GetSequence()
+		payload.Created = event.GetCreated().GetMilliseconds()
+		payload.MserviceId = event.GetMserviceId()
+		payload.ProjectId = event.GetProjectId()
+		payload.TaskId = event.GetTaskId()
+		payload.MemberId = event.GetMemberId()
+		payload.Version = event.GetVersion()
+		payload.StatusId = event.GetStatusId()
+		payload.PreviousStatusId = event.GetPreviousStatusId()
+		if event.GetTaskHours() != nil {
+			payload.TaskHours = event.GetTaskHours().StringFromDecimal()
+		}
+
+		body, err := json.Marshal(payload)
+		if err != nil {
+			level.Error(d.logger).Log("what", "json.Marshal", "error", err)
+			continue
+		}
+
+		webhookIds, err := d.getSubscribedWebhooks(event.GetMserviceId(), eventType)
+		if err != nil {
+			level.Error(d.logger).Log("what", "getSubscribedWebhooks", "error", err)
+			continue
+		}
+
+		// each delivery is held for its first attempt here, and is retried from the log if it is not made in time
+		for _, webhookId := range webhookIds {
+			deliveryId, err := d.logDelivery(event.GetMserviceId(), webhookId, eventType, event.GetSequence(), body)
+			if err != nil {
+				level.Error(d.logger).Log("what", "logDelivery", "error", err)
+				continue
+			}
+
+			d.enqueue(deliveryRef{mserviceId: event.GetMserviceId(), deliveryId: deliveryId})
+		}
+	}
+}
+
+// Attempt queued deliveries.
+func (d *Dispatcher) deliverQueued() {
+	for ref := range d.deliveries {
+		err := d.attempt(ref)
+		if err != nil {
+			level.Error(d.logger).Log("what", "webhook attempt", "deliveryid", ref.deliveryId, "error", err)
+		}
+	}
+}
+
+// Queue the logged deliveries whose next attempt is due, holding each so that no other server attempts it.
+func (d *Dispatcher) pollDue() {
+	ticker := time.NewTicker(d.pollInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		ctx := context.Background()
+		deliveries, err := d.store.Webhooks().GetDueDeliveries(ctx, queueSize)
+		if err != nil {
+			level.Error(d.logger).Log("what", "GetDueDeliveries", "error", err)
+			continue
+		}
+
+		for _, delivery := range deliveries {
+			err = d.store.Webhooks().ClaimDelivery(ctx, delivery.GetDeliveryId(), delivery.GetMserviceId(),
+				holdSeconds)
+			if err == nil {
+				d.enqueue(deliveryRef{mserviceId: delivery.GetMserviceId(), deliveryId: delivery.GetDeliveryId()})
+			} else if err != projstore.ErrNotFound {
+				level.Error(d.logger).Log("what", "ClaimDelivery", "error", err)
+			}
+		}
+	}
+}
+
+// Queue a delivery for an attempt.
+func (d *Dispatcher) enqueue(ref deliveryRef) {
+	select {
+	case d.deliveries <- ref:
+	default:
+		level.Error(d.logger).Log("what", "webhook delivery queue full", "deliveryid", ref.deliveryId)
+	}
+}
+
+// POST a logged delivery to its webhook url and record the outcome, with a retry after a backoff on failure.
+func (d *Dispatcher) attempt(ref deliveryRef) error {
+	delivery, url, secret, err := d.getDelivery(ref)
+	if err != nil {
+		return err
+	}
+
+	body := []byte(delivery.GetPayload())
+	var responseCode int
+	var lastError string
+
+	req, err := http.NewRequest("POST", url, bytes.NewReader(body))
+	if err == nil {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("User-Agent", "mproject-webhook")
+		req.Header.Set(HeaderEvent, delivery.GetEventType())
+		req.Header.Set(HeaderDelivery, strconv.FormatInt(delivery.GetDeliveryId(), 10))
+		req.Header.Set(HeaderTimestamp, timestamp)
+		req.Header.Set(HeaderSignature, "sha256="+Sign(secret, timestamp, body))
+
+		var resp *http.Response
+		resp, err = d.client.Do(req)
+		if err == nil {
+			resp.Body.Close()
+			responseCode = resp.StatusCode
+			if (responseCode < 200) || (responseCode > 299) {
+				lastError = fmt.Sprintf("unexpected status %d", responseCode)
+			}
+		}
+	}
+
+	if err != nil {
+		lastError = err.Error()
+	}
+
+	if len(lastError) > maxErrorLength {
+		lastError = lastError[:maxErrorLength]
+	}
+
+	level.Info(d.logger).Log("endpoint", "webhook", "deliveryid", delivery.GetDeliveryId(),
+		"event", delivery.GetEventType(), "status", responseCode, "error", lastError)
+
+	retrySeconds := 0
+	attempts := int(delivery.GetAttempts()) + 1
+	if lastError != "" {
+		if attempts < d.maxAttempts {
+			retrySeconds = d.retrySeconds(attempts)
+		} else {
+			level.Info(d.logger).Log("msg", "webhook delivery abandoned", "deliveryid", ref.deliveryId,
+				"attempts", attempts)
+		}
+	}
+
+	return d.store.Webhooks().RecordAttempt(context.Background(), ref.deliveryId, ref.mserviceId,
+		int32(responseCode), lastError, retrySeconds)
+}
+
+// Get the seconds to wait before the next attempt of a delivery, the retry delay doubled for each attempt after the
+// first, and at least a second.
+func (d *Dispatcher) retrySeconds(attempts int) int {
+	delay := d.retryDelay << uint(attempts-1)
+	return max(1, int((delay+time.Second-1)/time.Second))
+}
+
+func (d *Dispatcher) getSubscribedWebhooks(mserviceId int64, eventType string) ([]int64, error) {
+	return d.store.Webhooks().GetSubscribedWebhooks(context.Background(), mserviceId, eventType)
+}
+
+func (d *Dispatcher) logDelivery(mserviceId int64, webhookId int64, eventType string, sequence int64, body []byte) (int64, error) {
+	delivery := pb.WebhookDelivery{
+		MserviceId: mserviceId,
+		WebhookId:  webhookId,
+		EventType:  eventType,
+		Sequence:   sequence,
+		Payload:    string(body),
+	}
+
+	return d.store.Webhooks().CreateDelivery(context.Background(), &delivery, holdSeconds)
+}
+
+func (d *Dispatcher) getDelivery(ref deliveryRef) (*pb.WebhookDelivery, string, string, error) {
+	delivery, url, secret, err := d.store.Webhooks().GetDelivery(context.Background(), ref.deliveryId, ref.mserviceId)
+	if err == projstore.ErrNotFound {
+		return nil, "", "", ErrNotFound
+	}
+
+	return delivery, url, secret, err
+}
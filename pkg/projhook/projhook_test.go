@@ -0,0 +1,171 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+	"github.com/go-kit/kit/log"
+
+	pb "github.com/gaterace/mproject/pkg/mserviceproject"
+	"github.com/gaterace/mproject/pkg/projstore"
+)
+
+func TestValidateUrl(t *testing.T) {
+	tests := []struct {
+		url        string
+		allowLocal bool
+		valid      bool
+	}{
+		{"https://hooks.example.com/mproject", false, true},
+		{"http://203.0.113.7:8080/hook", false, true},
+		{"ftp://hooks.example.com/mproject", false, false},
+		{"https:///mproject", false, false},
+		{"http://localhost/hook", false, false},
+		{"http://api.localhost./hook", false, false},
+		{"http://127.0.0.1/hook", false, false},
+		{"http://[::1]:8080/hook", false, false},
+		{"http://169.254.169.254/latest/meta-data", false, false},
+		{"http://[fe80::1]/hook", false, false},
+		{"http://0.0.0.0/hook", false, false},
+		{"http://10.1.2.3/hook", false, false},
+		{"http://172.16.0.1/hook", false, false},
+		{"http://192.168.1.10/hook", false, false},
+		{"http://[fd00::1]/hook", false, false},
+		{"http://localhost:8080/hook", true, true},
+		{"http://127.0.0.1/hook", true, true},
+		{"http://192.168.1.10/hook", true, true},
+		{"ftp://localhost/hook", true, false},
+	}
+
+	for _, tt := range tests {
+		err := ValidateUrl(tt.url, tt.allowLocal)
+		if (err == nil) != tt.valid {
+			t.Errorf("%s allow local %v: got %v, want valid %v", tt.url, tt.allowLocal, err, tt.valid)
+		}
+	}
+}
+
+func TestSign(t *testing.T) {
+	body := []byte(`{"event_type":"task_created"}`)
+
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write([]byte("1700000000." + string(body)))
+	want := hex.EncodeToString(mac.Sum(nil))
+
+	if got := Sign("secret", "1700000000", body); got != want {
+		t.Errorf("signature: got %s, want %s", got, want)
+	}
+
+	if Sign("secret", "1700000001", body) == want {
+		t.Error("signature does not depend on the timestamp")
+	}
+}
+
+func TestClientRefusesLoopback(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+
+	_, err := newClient(false).Post(srv.URL, "application/json", nil)
+	if !errors.Is(err, ErrInvalidUrl) {
+		t.Errorf("post to %s: got %v, want ErrInvalidUrl", srv.URL, err)
+	}
+}
+
+// A failed delivery is retried from the delivery log, signed with the timestamp it is sent at. Local addresses are
+// allowed, so the delivery goes to the test server through the dispatcher's own client.
+func TestDeliveryRetry(t *testing.T) {
+	ctx := context.Background()
+	store := projstore.NewMemoryStore()
+
+	webhookId, err := store.Webhooks().CreateWebhook(ctx, &pb.Webhook{MserviceId: 7, Url: "http://placeholder",
+		EventTypes: []string{EventTaskCreated}, IsActive: true}, "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var mutex sync.Mutex
+	var statuses []int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		status := http.StatusOK
+		if len(statuses) == 0 {
+			status = http.StatusServiceUnavailable
+		}
+		if r.Header.Get(HeaderSignature) != "sha256="+Sign("secret", r.Header.Get(HeaderTimestamp), body) {
+			status = http.StatusUnauthorized
+		}
+
+		statuses = append(statuses, status)
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+
+	err = store.Webhooks().UpdateWebhook(ctx, &pb.Webhook{WebhookId: webhookId, Version: 1, MserviceId: 7,
+		Url: srv.URL, EventTypes: []string{EventTaskCreated}, IsActive: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := NewDispatcher()
+	d.SetLogger(log.NewNopLogger())
+	d.SetStore(store)
+	d.SetRetryDelay(time.Millisecond)
+	d.SetAllowLocal(true)
+	d.pollInterval = 10 * time.Millisecond
+	d.Start()
+
+	d.HandleEvent(&pb.ProjectEvent{MserviceId: 7, ProjectId: 1, EntityType: "task", Action: "create", TaskId: 3,
+		Sequence: 1, Created: dml.DateTimeFromTime(time.Now())})
+
+	var delivery *pb.WebhookDelivery
+	for i := 0; i < 300; i++ {
+		deliveries, err := store.Webhooks().GetDeliveries(ctx, webhookId, 7, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if (len(deliveries) == 1) && deliveries[0].GetIsDelivered() {
+			delivery = deliveries[0]
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	if delivery == nil {
+		t.Fatalf("delivery not made, responses %v", statuses)
+	}
+
+	if (delivery.GetAttempts() != 2) || (len(statuses) != 2) || (statuses[1] != http.StatusOK) {
+		t.Errorf("delivery: got %d attempts, responses %v, want 2 attempts ending in 200", delivery.GetAttempts(),
+			statuses)
+	}
+}
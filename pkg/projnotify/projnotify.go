@@ -0,0 +1,437 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package projnotify emails team members when they are assigned to a task, when the status of
+// one of their tasks changes and when one of their tasks is approaching its end date.
+package projnotify
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/go-kit/kit/log"
+	"github.com/go-kit/kit/log/level"
+
+	pb "github.com/gaterace/mproject/pkg/mserviceproject"
+	"github.com/gaterace/mproject/pkg/projstore"
+)
+
+// Notification kinds, also the template file names without the .tmpl extension.
+const (
+	KindTaskAssigned      = "task_assigned"
+	KindTaskStatusChanged = "task_status_changed"
+	KindTaskDue           = "task_due"
+)
+
+const (
+	defaultDueDays  = 2
+	dueScanInterval = time.Hour
+	queueSize       = 1000
+	maxErrorLength  = 255
+	maxSubjectLen   = 255
+	endDateFormat   = "2006-01-02"
+	// attempts to send a reminder, retried at the next scans with the interval doubled after each failure
+	maxReminderAttempts = 3
+	// seconds a reminder is held by the server sending it, longer than a send can take
+	reminderHoldSeconds = 300
+)
+
+// Templates start with a Subject: line, followed by a blank line and the message body.
+var defaultTemplates = map[string]string{
+	KindTaskAssigned: `Subject: [{{.ProjectName}}] You have been assigned to {{.TaskName}}
+
+Hello {{.MemberName}},
+
+You have been assigned to task "{{.TaskName}}" in project "{{.ProjectName}}".
+
+{{.TaskDescription}}
+
+Status: {{.StatusName}}
+End date: {{.EndDate}}
+`,
+	KindTaskStatusChanged: `Subject: [{{.ProjectName}}] {{.TaskName}} is now {{.StatusName}}
+
+Hello {{.MemberName}},
+
+The status of task "{{.TaskName}}" in project "{{.ProjectName}}" changed from {{.PreviousStatusName}} to {{.StatusName}}.
+
+End date: {{.EndDate}}
+`,
+	KindTaskDue: `Subject: [{{.ProjectName}}] {{.TaskName}} is due {{.EndDate}}
+
+Hello {{.MemberName}},
+
+Task "{{.TaskName}}" in project "{{.ProjectName}}" is due on {{.EndDate}}.
+
+Status: {{.StatusName}}
+`,
+}
+
+// Fields available to notification templates.
+type Message struct {
+	MserviceId         int64
+	ProjectId          int64
+	ProjectName        string
+	TaskId             int64
+	TaskName           string
+	TaskDescription    string
+	StatusName         string
+	PreviousStatusName string
+	EndDate            string
+	MemberId           int64
+	MemberName         string
+	Email              string
+}
+
+type Notifier struct {
+	logger       log.Logger
+	store        projstore.Store
+	smtpHost     string
+	smtpPort     int
+	smtpUser     string
+	smtpPassword string
+	from         string
+	dueDays      int
+	templates    map[string]*template.Template
+	events       chan *pb.ProjectEvent
+}
+
+// Get a new Notifier instance using the default templates.
+func NewNotifier() *Notifier {
+	n := Notifier{}
+	n.smtpPort = 25
+	n.dueDays = defaultDueDays
+	n.templates = make(map[string]*template.Template)
+	for kind, text := range defaultTemplates {
+		n.templates[kind] = template.Must(template.New(kind).Parse(text))
+	}
+	n.events = make(chan *pb.ProjectEvent, queueSize)
+	return &n
+}
+
+// Set the logger for the Notifier instance.
+func (n *Notifier) SetLogger(logger log.Logger) {
+	n.logger = logger
+}
+
+// Set the storage of the notified team members for the Notifier instance.
+func (n *Notifier) SetStore(store projstore.Store) {
+	n.store = store
+}
+
+// Set the smtp relay used to send notifications, user may be empty for an unauthenticated relay.
+func (n *Notifier) SetSmtpRelay(host string, port int, user string, password string, from string) {
+	n.smtpHost = host
+	if port > 0 {
+		n.smtpPort = port
+	}
+	n.smtpUser = user
+	n.smtpPassword = password
+	n.from = from
+}
+
+// Set how many days ahead of a task end date the reminder is sent, 0 to disable reminders.
+func (n *Notifier) SetDueDays(dueDays int) {
+	n.dueDays = dueDays
+}
+
+// Replace the default templates with any <kind>.tmpl files found in the directory.
+func (n *Notifier) LoadTemplates(dir string) error {
+	for kind := range defaultTemplates {
+		text, err := os.ReadFile(filepath.Join(dir, kind+".tmpl"))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		tmpl, err := template.New(kind).Parse(string(text))
+		if err != nil {
+			return err
+		}
+
+		n.templates[kind] = tmpl
+	}
+
+	return nil
+}
+
+// Start the goroutines sending event notifications and due date reminders.
+func (n *Notifier) Start() {
+	go n.notifyEvents()
+	if n.dueDays > 0 {
+		go n.remindDue()
+	}
+}
+
+// Queue a project change event for notification, used as a projservice event listener.
+func (n *Notifier) HandleEvent(event *pb.ProjectEvent) {
+	if kindFromEvent(event) == "" {
+		return
+	}
+
+	select {
+	case n.events <- event:
+	default:
+		level.Error(n.logger).Log("what", "notification queue full", "sequence", event.GetSequence())
+	}
+}
+
+// Get the notification kind for a project change event, or empty if nobody is notified.
+func kindFromEvent(event *pb.ProjectEvent) string {
+	if (event.GetEntityType() == "assignment") && (event.GetAction() == "create") {
+		return KindTaskAssigned
+	}
+
+	if (event.GetEntityType() == "task") && (event.GetAction() == "update") &&
+		(event.GetStatusId() != event.GetPreviousStatusId()) {
+		return KindTaskStatusChanged
+	}
+
+	return ""
+}
+
+// Send notifications for queued events.
+func (n *Notifier) notifyEvents() {
+	for event := range n.events {
+		kind := kindFromEvent(event)
+
+		// status changes go to all members of the task
+		var memberId int64
+		if kind == KindTaskAssigned {
+			memberId = event.GetMemberId()
+		}
+
+		recipients, err := n.store.Notifications().GetTaskRecipients(context.Background(), event.GetTaskId(),
+			event.GetMserviceId(), memberId)
+		if err != nil {
+			level.Error(n.logger).Log("what", "GetTaskRecipients", "error", err)
+			continue
+		}
+
+		var previousStatusName string
+		if kind == KindTaskStatusChanged && len(recipients) > 0 {
+			previousStatusName = n.getStatusName(event.GetMserviceId(), event.GetPreviousStatusId())
+		}
+
+		for _, recipient := range recipients {
+			msg := messageFromRecipient(recipient)
+			msg.PreviousStatusName = previousStatusName
+			n.notify(kind, msg)
+		}
+	}
+}
+
+// Periodically remind members of assigned tasks ending within the configured number of days.
+func (n *Notifier) remindDue() {
+	ticker := time.NewTicker(dueScanInterval)
+	defer ticker.Stop()
+
+	for {
+		n.scanDue()
+		<-ticker.C
+	}
+}
+
+// Remind members of assigned tasks ending within the configured number of days. A reminder is sent once, unless
+// the task has been modified since or the send failed.
+func (n *Notifier) scanDue() {
+	now := time.Now()
+	recipients, err := n.store.Notifications().GetDueRecipients(context.Background(), now,
+		now.AddDate(0, 0, n.dueDays), KindTaskDue)
+
+	if err != nil {
+		level.Error(n.logger).Log("what", "GetDueRecipients", "error", err)
+	}
+
+	for _, recipient := range recipients {
+		n.remind(recipient)
+	}
+}
+
+// Claim, send and record a due date reminder, so that one server only sends it, retried on failure until
+// maxReminderAttempts have been made.
+func (n *Notifier) remind(recipient *projstore.Recipient) {
+	msg := messageFromRecipient(recipient)
+	subject, body, err := n.render(KindTaskDue, msg)
+
+	notification := projstore.Notification{
+		MserviceId: msg.MserviceId,
+		MemberId:   msg.MemberId,
+		TaskId:     msg.TaskId,
+		Kind:       KindTaskDue,
+		Email:      msg.Email,
+		Subject:    subject,
+	}
+
+	notificationId, claimErr := n.store.Notifications().ClaimReminder(context.Background(), &notification,
+		recipient.NotificationId, reminderHoldSeconds)
+	if claimErr == projstore.ErrNotFound {
+		// claimed by another server
+		return
+	} else if claimErr != nil {
+		level.Error(n.logger).Log("what", "ClaimReminder", "error", claimErr)
+		return
+	}
+
+	if err == nil {
+		err = n.send(msg.Email, subject, body)
+	}
+
+	lastError := errorText(err)
+	attempts := int(recipient.Attempts) + 1
+
+	level.Info(n.logger).Log("endpoint", "notify", "kind", KindTaskDue, "memberid", msg.MemberId, "taskid",
+		msg.TaskId, "attempts", attempts, "error", lastError)
+
+	retrySeconds := 0
+	if (lastError != "") && (attempts < maxReminderAttempts) {
+		retrySeconds = int((dueScanInterval << uint(attempts-1)) / time.Second)
+	}
+
+	err = n.store.Notifications().RecordReminder(context.Background(), notificationId, lastError, retrySeconds)
+	if err != nil {
+		level.Error(n.logger).Log("what", "RecordReminder", "error", err)
+	}
+}
+
+// Render, send and log a single notification.
+func (n *Notifier) notify(kind string, msg *Message) {
+	subject, body, err := n.render(kind, msg)
+	if err == nil {
+		err = n.send(msg.Email, subject, body)
+	}
+
+	lastError := errorText(err)
+
+	level.Info(n.logger).Log("endpoint", "notify", "kind", kind, "memberid", msg.MemberId, "taskid", msg.TaskId,
+		"error", lastError)
+
+	notification := projstore.Notification{
+		MserviceId: msg.MserviceId,
+		MemberId:   msg.MemberId,
+		TaskId:     msg.TaskId,
+		Kind:       kind,
+		Email:      msg.Email,
+		Subject:    subject,
+		LastError:  lastError,
+	}
+
+	err = n.store.Notifications().LogNotification(context.Background(), &notification)
+	if err != nil {
+		level.Error(n.logger).Log("what", "LogNotification", "error", err)
+	}
+}
+
+// Get the error logged for a notification, cut to the column length, or empty if none.
+func errorText(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	lastError := err.Error()
+	if len(lastError) > maxErrorLength {
+		lastError = lastError[:maxErrorLength]
+	}
+
+	return lastError
+}
+
+// Render a template into its subject and body.
+func (n *Notifier) render(kind string, msg *Message) (string, string, error) {
+	var buf bytes.Buffer
+	err := n.templates[kind].Execute(&buf, msg)
+	if err != nil {
+		return "", "", err
+	}
+
+	text := strings.ReplaceAll(buf.String(), "\r\n", "\n")
+	header, body, _ := strings.Cut(text, "\n\n")
+	if !strings.HasPrefix(header, "Subject:") {
+		return "", "", fmt.Errorf("template %s does not start with a Subject: line", kind)
+	}
+
+	// subject is built from user data, keep it to a single header line
+	subject := strings.TrimSpace(strings.TrimPrefix(header, "Subject:"))
+	subject = strings.Join(strings.Fields(subject), " ")
+	if len(subject) > maxSubjectLen {
+		subject = subject[:maxSubjectLen]
+	}
+
+	return subject, body, nil
+}
+
+// Send a plain text message through the smtp relay.
+func (n *Notifier) send(to string, subject string, body string) error {
+	if strings.ContainsAny(to, "\r\n") {
+		return errors.New("invalid recipient address")
+	}
+
+	var auth smtp.Auth
+	if n.smtpUser != "" {
+		auth = smtp.PlainAuth("", n.smtpUser, n.smtpPassword, n.smtpHost)
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString("From: " + n.from + "\r\n")
+	buf.WriteString("To: " + to + "\r\n")
+	buf.WriteString("Subject: " + subject + "\r\n")
+	buf.WriteString("Date: " + time.Now().Format(time.RFC1123Z) + "\r\n")
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	buf.WriteString("\r\n")
+	buf.WriteString(strings.ReplaceAll(body, "\n", "\r\n"))
+
+	addr := net.JoinHostPort(n.smtpHost, strconv.Itoa(n.smtpPort))
+	return smtp.SendMail(addr, auth, n.from, []string{to}, buf.Bytes())
+}
+
+// Get the template fields for a team member assigned to a task.
+func messageFromRecipient(recipient *projstore.Recipient) *Message {
+	return &Message{
+		MserviceId:      recipient.MserviceId,
+		ProjectId:       recipient.ProjectId,
+		ProjectName:     recipient.ProjectName,
+		TaskId:          recipient.TaskId,
+		TaskName:        recipient.TaskName,
+		TaskDescription: recipient.TaskDescription,
+		StatusName:      recipient.StatusName,
+		EndDate:         recipient.EndDate.Format(endDateFormat),
+		MemberId:        recipient.MemberId,
+		MemberName:      recipient.MemberName,
+		Email:           recipient.Email,
+	}
+}
+
+// Get the name of a status type, or its id if it cannot be found.
+func (n *Notifier) getStatusName(mserviceId int64, statusId int32) string {
+	statusType, err := n.store.Types().GetStatusType(context.Background(), statusId, mserviceId)
+	if err != nil {
+		if err != projstore.ErrNotFound {
+			level.Error(n.logger).Log("what", "GetStatusType", "error", err)
+		}
+		return strconv.Itoa(int(statusId))
+	}
+
+	return statusType.GetStatusName()
+}
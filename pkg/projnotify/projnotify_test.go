@@ -0,0 +1,307 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projnotify
+
+import (
+	"context"
+	"net"
+	"net/textproto"
+	"strings"
+	"sync"
+	"testing"
+	"text/template"
+	"time"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+	"github.com/go-kit/kit/log"
+
+	pb "github.com/gaterace/mproject/pkg/mserviceproject"
+	"github.com/gaterace/mproject/pkg/projstore"
+)
+
+// Message accepted by the test smtp server.
+type sinkMessage struct {
+	to   string
+	data string
+}
+
+// Local smtp server that accepts every message, or refuses every recipient.
+type smtpSink struct {
+	listener net.Listener
+	refuse   bool
+	messages chan sinkMessage
+}
+
+// Helper to start an smtp server on a local port, closed at the end of the test.
+func newSmtpSink(t *testing.T, refuse bool) *smtpSink {
+	t.Helper()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sink := &smtpSink{listener: listener, refuse: refuse, messages: make(chan sinkMessage, 10)}
+	t.Cleanup(func() { listener.Close() })
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go sink.serve(conn)
+		}
+	}()
+
+	return sink
+}
+
+// Helper to talk smtp on a connection, enough for net/smtp.SendMail.
+func (sink *smtpSink) serve(conn net.Conn) {
+	defer conn.Close()
+
+	text := textproto.NewConn(conn)
+	text.PrintfLine("220 sink ESMTP")
+
+	var to string
+	for {
+		line, err := text.ReadLine()
+		if err != nil {
+			return
+		}
+
+		command := strings.ToUpper(line)
+		switch {
+		case strings.HasPrefix(command, "RCPT TO:") && sink.refuse:
+			text.PrintfLine("550 mailbox unavailable")
+		case strings.HasPrefix(command, "RCPT TO:"):
+			to = strings.Trim(line[len("RCPT TO:"):], "<> ")
+			text.PrintfLine("250 ok")
+		case command == "DATA":
+			text.PrintfLine("354 go ahead")
+			data, err := text.ReadDotBytes()
+			if err != nil {
+				return
+			}
+			sink.messages <- sinkMessage{to: to, data: string(data)}
+			text.PrintfLine("250 ok")
+		case command == "QUIT":
+			text.PrintfLine("221 bye")
+			return
+		default:
+			text.PrintfLine("250 ok")
+		}
+	}
+}
+
+// Helper to get the port of the smtp server.
+func (sink *smtpSink) port() int {
+	return sink.listener.Addr().(*net.TCPAddr).Port
+}
+
+// Helper to get a Notifier sending through the smtp server, on a memory store with a task due tomorrow, assigned to
+// ann and to bob, who has opted out. Returns the notifier, the store, the task id and the member ids.
+func newTestNotifier(t *testing.T, sink *smtpSink) (*Notifier, projstore.Store, int64, []int64) {
+	t.Helper()
+
+	ctx := context.Background()
+	store := projstore.NewMemoryStore()
+
+	projectId, err := store.Projects().CreateProject(ctx, &pb.Project{MserviceId: 7, Name: "alpha",
+		Description: "test project", StatusId: 1, StartDate: dml.DateTimeFromString("2030-01-07"),
+		EndDate: dml.DateTimeFromString("2030-03-01")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	taskId, err := store.Tasks().CreateTask(ctx, &pb.Task{MserviceId: 7, ProjectId: projectId, Name: "build",
+		Description: "test task", StatusId: 1, StartDate: dml.DateTimeFromTime(now),
+		EndDate: dml.DateTimeFromTime(now.Add(24 * time.Hour))})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	memberIds := make([]int64, 0)
+	for _, name := range []string{"ann", "bob"} {
+		personId, err := store.Persons().CreatePerson(ctx, &pb.Person{MserviceId: 7, Name: name,
+			Email: name + "@example.com"})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		memberId, err := store.Members().CreateMember(ctx, &pb.TeamMember{MserviceId: 7, ProjectId: projectId,
+			Name: name, ProjectRoleId: 1, Email: name + "@example.com", PersonId: personId})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = store.Assignments().AddAssignment(ctx, projectId, taskId, memberId, 7)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		memberIds = append(memberIds, memberId)
+	}
+
+	err = store.Members().SetMemberNotify(ctx, memberIds[1], 7, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	n := NewNotifier()
+	n.SetLogger(log.NewNopLogger())
+	n.SetStore(store)
+	n.SetSmtpRelay("127.0.0.1", sink.port(), "", "", "mproject@example.com")
+
+	return n, store, taskId, memberIds
+}
+
+// An assignment is mailed to the assigned member, unless the member opted out.
+func TestNotifyAssignment(t *testing.T) {
+	sink := newSmtpSink(t, false)
+	n, _, taskId, memberIds := newTestNotifier(t, sink)
+	n.SetDueDays(0)
+	n.Start()
+
+	// events are sent in order, so bob's would arrive before ann's
+	for _, memberId := range []int64{memberIds[1], memberIds[0]} {
+		n.HandleEvent(&pb.ProjectEvent{MserviceId: 7, EntityType: "assignment", Action: "create", TaskId: taskId,
+			MemberId: memberId})
+	}
+
+	select {
+	case msg := <-sink.messages:
+		if msg.to != "ann@example.com" {
+			t.Errorf("recipient: got %s, want ann@example.com", msg.to)
+		}
+		if !strings.Contains(msg.data, "Subject: [alpha] You have been assigned to build\n") {
+			t.Errorf("message: got %q, want the assignment subject", msg.data)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("no message for ann")
+	}
+
+	select {
+	case msg := <-sink.messages:
+		t.Errorf("unexpected message to %s", msg.to)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestRender(t *testing.T) {
+	n := NewNotifier()
+	msg := &Message{ProjectName: "alpha", TaskName: "build\r\nBcc: eve@example.com", MemberName: "ann",
+		EndDate: "2030-02-01"}
+
+	subject, body, err := n.render(KindTaskDue, msg)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// user data cannot add header lines
+	if subject != "[alpha] build Bcc: eve@example.com is due 2030-02-01" {
+		t.Errorf("subject: got %q", subject)
+	}
+	if !strings.HasPrefix(body, "Hello ann,") {
+		t.Errorf("body: got %q", body)
+	}
+
+	n.templates[KindTaskDue] = template.Must(template.New(KindTaskDue).Parse("Hello {{.MemberName}}\n\nbody"))
+	if _, _, err = n.render(KindTaskDue, msg); err == nil {
+		t.Error("template without a Subject: line accepted")
+	}
+}
+
+// Reminders repository recording the retry delays asked for, and asking for one second instead.
+type recordedReminders struct {
+	projstore.NotificationRepository
+	mutex   sync.Mutex
+	retries []int
+}
+
+func (r *recordedReminders) RecordReminder(ctx context.Context, notificationId int64, lastError string,
+	retrySeconds int) error {
+	r.mutex.Lock()
+	r.retries = append(r.retries, retrySeconds)
+	r.mutex.Unlock()
+
+	return r.NotificationRepository.RecordReminder(ctx, notificationId, lastError, min(retrySeconds, 1))
+}
+
+// Store with the recording reminders repository.
+type recordedStore struct {
+	projstore.Store
+	reminders *recordedReminders
+}
+
+func (s *recordedStore) Notifications() projstore.NotificationRepository {
+	return s.reminders
+}
+
+// A failed reminder is retried after a doubling delay, up to maxReminderAttempts attempts.
+func TestReminderRetries(t *testing.T) {
+	sink := newSmtpSink(t, true)
+	n, store, _, _ := newTestNotifier(t, sink)
+
+	reminders := &recordedReminders{NotificationRepository: store.Notifications()}
+	n.SetStore(&recordedStore{Store: store, reminders: reminders})
+
+	for i := 0; i <= maxReminderAttempts; i++ {
+		n.scanDue()
+		// a failed reminder is not retried until its delay has passed
+		n.scanDue()
+		if i < maxReminderAttempts {
+			time.Sleep(1100 * time.Millisecond)
+		}
+	}
+
+	want := []int{3600, 7200, 0}
+	if (len(reminders.retries) != len(want)) || (reminders.retries[0] != want[0]) ||
+		(reminders.retries[1] != want[1]) || (reminders.retries[2] != want[2]) {
+		t.Errorf("retry delays: got %v, want %v", reminders.retries, want)
+	}
+}
+
+// Servers scanning at once send a reminder once.
+func TestReminderClaimed(t *testing.T) {
+	sink := newSmtpSink(t, false)
+	n, store, _, _ := newTestNotifier(t, sink)
+
+	other := NewNotifier()
+	other.SetLogger(log.NewNopLogger())
+	other.SetStore(store)
+	other.SetSmtpRelay("127.0.0.1", sink.port(), "", "", "mproject@example.com")
+
+	var wg sync.WaitGroup
+	for _, notifier := range []*Notifier{n, other} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			notifier.scanDue()
+		}()
+	}
+	wg.Wait()
+
+	n.scanDue()
+
+	if len(sink.messages) != 1 {
+		t.Fatalf("reminders: got %d, want 1", len(sink.messages))
+	}
+
+	if msg := <-sink.messages; msg.to != "ann@example.com" {
+		t.Errorf("recipient: got %s, want ann@example.com", msg.to)
+	}
+}
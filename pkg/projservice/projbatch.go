@@ -0,0 +1,330 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projservice
+
+import (
+	"context"
+
+	pb "github.com/gaterace/mproject/pkg/mserviceproject"
+	"github.com/gaterace/mproject/pkg/projstore"
+)
+
+// Most items in a single batch request, all of them in one transaction.
+const maxBatchItems = 500
+
+// Helper to check the number of items in a batch request.
+func batchSizeHelper(count int, what string) *genericResponse {
+	resp := &genericResponse{}
+
+	if count == 0 {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = what + " missing"
+	} else if count > maxBatchItems {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "too many " + what
+	}
+
+	return resp
+}
+
+// Helper to record the error of a batch item in its result, returning an error that rolls back the batch
+// with the same error code and message.
+func (s *projService) batchItemError(result *pb.BatchResult, err error) error {
+	gResp := s.storeErrorHelper(err)
+	result.ErrorCode = gResp.ErrorCode
+	result.ErrorMessage = gResp.ErrorMessage
+
+	return &responseError{*gResp}
+}
+
+// create the tasks of a project together, or none of them
+func (s *projService) BatchCreateTasks(ctx context.Context, req *pb.BatchCreateTasksRequest) (*pb.BatchCreateTasksResponse, error) {
+	resp := &pb.BatchCreateTasksResponse{}
+
+	gResp := batchSizeHelper(len(req.GetTasks()), "tasks")
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	// check every task before starting, to report all the invalid ones at once
+	results := make([]*pb.BatchResult, len(req.GetTasks()))
+	tempIds := make(map[int64]bool)
+	invalid := false
+	for n, item := range req.GetTasks() {
+		results[n] = &pb.BatchResult{TempId: item.GetTempId()}
+
+		msg := validateTaskHelper(item.GetName(), item.GetDescription(), item.GetStartDate(), item.GetEndDate())
+		if msg == "" {
+			switch {
+			case (item.GetTempId() != 0) && tempIds[item.GetTempId()]:
+				msg = "temp_id not unique"
+			case (item.GetParentId() != 0) && (item.GetParentTempId() != 0):
+				msg = "only one of parent_id and parent_temp_id allowed"
+			case (item.GetParentTempId() != 0) && !tempIds[item.GetParentTempId()]:
+				msg = "parent_temp_id not found earlier in batch"
+			}
+		}
+
+		if msg != "" {
+			results[n].ErrorCode = 510
+			results[n].ErrorMessage = msg
+			invalid = true
+		}
+
+		if item.GetTempId() != 0 {
+			tempIds[item.GetTempId()] = true
+		}
+	}
+
+	if invalid {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "invalid tasks in batch"
+		resp.Results = results
+		return resp, nil
+	}
+
+	taskIds := make([]int64, len(req.GetTasks()))
+	err := s.store.WithTx(ctx, func(tx projstore.Store) error {
+		// make sure project id is valid
+		ok, err := tx.Projects().ProjectExists(ctx, req.GetProjectId(), req.GetMserviceId())
+		if err != nil {
+			return err
+		} else if !ok {
+			return &responseError{genericResponse{404, "project for task not found"}}
+		}
+
+		tempTaskIds := make(map[int64]int64)
+		for n, item := range req.GetTasks() {
+			parentId := item.GetParentId()
+			if item.GetParentTempId() != 0 {
+				parentId = tempTaskIds[item.GetParentTempId()]
+			} else if parentId != 0 {
+				// make sure parent task id is valid
+				ok, err = tx.Tasks().TaskExists(ctx, parentId, req.GetProjectId())
+				if err != nil {
+					return s.batchItemError(results[n], err)
+				} else if !ok {
+					return s.batchItemError(results[n], &responseError{genericResponse{404, "parent task not found"}})
+				}
+			}
+
+			task := &pb.Task{MserviceId: req.GetMserviceId(), ProjectId: req.GetProjectId(), Name: item.GetName(),
+				Description: item.GetDescription(), StatusId: item.GetStatusId(), StartDate: item.GetStartDate(),
+				EndDate: item.GetEndDate(), Priority: item.GetPriority(), ParentId: parentId,
+				Position: item.GetPosition()}
+
+			taskIds[n], err = tx.Tasks().CreateTask(ctx, task)
+			if err != nil {
+				return s.batchItemError(results[n], err)
+			}
+
+			if item.GetTempId() != 0 {
+				tempTaskIds[item.GetTempId()] = taskIds[n]
+			}
+		}
+
+		return nil
+	})
+
+	resp.Results = results
+	if err == nil {
+		for n, item := range req.GetTasks() {
+			results[n].TaskId = taskIds[n]
+			results[n].Version = 1
+
+			s.publishEvent(&pb.ProjectEvent{MserviceId: req.GetMserviceId(), ProjectId: req.GetProjectId(),
+				EntityType: EntityTask, Action: ActionCreate, TaskId: taskIds[n], Version: 1,
+				StatusId: item.GetStatusId()})
+		}
+	} else {
+		gResp = s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+	}
+
+	return resp, nil
+}
+
+// update the tasks of a project together, or none of them
+func (s *projService) BatchUpdateTasks(ctx context.Context, req *pb.BatchUpdateTasksRequest) (*pb.BatchUpdateTasksResponse, error) {
+	resp := &pb.BatchUpdateTasksResponse{}
+
+	gResp := batchSizeHelper(len(req.GetTasks()), "tasks")
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	// check every task before starting, to report all the invalid ones at once
+	results := make([]*pb.BatchResult, len(req.GetTasks()))
+	seen := make(map[int64]bool)
+	invalid := false
+	for n, item := range req.GetTasks() {
+		results[n] = &pb.BatchResult{TaskId: item.GetTaskId()}
+
+		msg := validateTaskHelper(item.GetName(), item.GetDescription(), item.GetStartDate(), item.GetEndDate())
+		if msg == "" {
+			switch {
+			case seen[item.GetTaskId()]:
+				msg = "task_id not unique"
+			}
+		}
+
+		if msg != "" {
+			results[n].ErrorCode = 510
+			results[n].ErrorMessage = msg
+			invalid = true
+		}
+
+		seen[item.GetTaskId()] = true
+	}
+
+	if invalid {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "invalid tasks in batch"
+		resp.Results = results
+		return resp, nil
+	}
+
+	previousStatusIds := make([]int32, len(req.GetTasks()))
+	err := s.store.WithTx(ctx, func(tx projstore.Store) error {
+		for n, item := range req.GetTasks() {
+			// make sure the task is in the project of the batch
+			projectId, statusId, err := tx.Tasks().GetTaskProject(ctx, item.GetTaskId(), req.GetMserviceId())
+			if (err == nil) && (projectId != req.GetProjectId()) {
+				err = projstore.ErrNotFound
+			}
+			if err != nil {
+				return s.batchItemError(results[n], err)
+			}
+
+			previousStatusIds[n] = statusId
+
+			task := &pb.Task{TaskId: item.GetTaskId(), Version: item.GetVersion(), MserviceId: req.GetMserviceId(),
+				Name: item.GetName(), Description: item.GetDescription(), StatusId: item.GetStatusId(),
+				StartDate: item.GetStartDate(), EndDate: item.GetEndDate(), Priority: item.GetPriority(),
+				Position: item.GetPosition()}
+
+			err = tx.Tasks().UpdateTask(ctx, task)
+			if err != nil {
+				return s.batchItemError(results[n], err)
+			}
+		}
+
+		return nil
+	})
+
+	resp.Results = results
+	if err == nil {
+		for n, item := range req.GetTasks() {
+			results[n].Version = item.GetVersion() + 1
+
+			s.publishEvent(&pb.ProjectEvent{MserviceId: req.GetMserviceId(), ProjectId: req.GetProjectId(),
+				EntityType: EntityTask, Action: ActionUpdate, TaskId: item.GetTaskId(), Version: results[n].GetVersion(),
+				StatusId: item.GetStatusId(), PreviousStatusId: previousStatusIds[n]})
+		}
+	} else {
+		gResp = s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+	}
+
+	return resp, nil
+}
+
+// assign team members to the tasks of a project together, or none of them
+func (s *projService) BatchAssignMembers(ctx context.Context, req *pb.BatchAssignMembersRequest) (*pb.BatchAssignMembersResponse, error) {
+	resp := &pb.BatchAssignMembersResponse{}
+
+	gResp := batchSizeHelper(len(req.GetAssignments()), "assignments")
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	// check every assignment before starting, to report all the invalid ones at once
+	type assignmentKey struct {
+		taskId   int64
+		memberId int64
+	}
+
+	results := make([]*pb.BatchResult, len(req.GetAssignments()))
+	seen := make(map[assignmentKey]bool)
+	invalid := false
+	for n, item := range req.GetAssignments() {
+		results[n] = &pb.BatchResult{TaskId: item.GetTaskId(), MemberId: item.GetMemberId()}
+
+		key := assignmentKey{item.GetTaskId(), item.GetMemberId()}
+		if seen[key] {
+			results[n].ErrorCode = 510
+			results[n].ErrorMessage = "assignment not unique"
+			invalid = true
+		}
+
+		seen[key] = true
+	}
+
+	if invalid {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "invalid assignments in batch"
+		resp.Results = results
+		return resp, nil
+	}
+
+	versions := make([]int32, len(req.GetAssignments()))
+	err := s.store.WithTx(ctx, func(tx projstore.Store) error {
+		for n, item := range req.GetAssignments() {
+			// make sure that refered task is in the project of the batch
+			projectId, _, err := tx.Tasks().GetTaskProject(ctx, item.GetTaskId(), req.GetMserviceId())
+			if (err != nil) || (projectId != req.GetProjectId()) {
+				return s.batchItemError(results[n], &responseError{genericResponse{404, "referenced task not found"}})
+			}
+
+			// make sure that refered team member is in the project
+			ok, err := tx.Members().MemberExists(ctx, item.GetMemberId(), req.GetProjectId(), req.GetMserviceId())
+			if (err != nil) || !ok {
+				return s.batchItemError(results[n], &responseError{genericResponse{404, "referenced member not found"}})
+			}
+
+			versions[n], err = tx.Assignments().AddAssignment(ctx, req.GetProjectId(), item.GetTaskId(),
+				item.GetMemberId(), req.GetMserviceId())
+			if err != nil {
+				return s.batchItemError(results[n], err)
+			}
+		}
+
+		return nil
+	})
+
+	resp.Results = results
+	if err == nil {
+		for n, item := range req.GetAssignments() {
+			results[n].Version = versions[n]
+
+			s.publishEvent(&pb.ProjectEvent{MserviceId: req.GetMserviceId(), ProjectId: req.GetProjectId(),
+				EntityType: EntityAssignment, Action: ActionCreate, TaskId: item.GetTaskId(),
+				MemberId: item.GetMemberId(), Version: versions[n]})
+		}
+	} else {
+		gResp = s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+	}
+
+	return resp, nil
+}
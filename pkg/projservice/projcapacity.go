@@ -0,0 +1,209 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projservice
+
+import (
+	"context"
+	"time"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+	sdec "github.com/shopspring/decimal"
+
+	pb "github.com/gaterace/mproject/pkg/mserviceproject"
+)
+
+const defaultReportWeeks = 4
+const maxReportWeeks = 52
+
+// Format of the days off of a team member, by day.
+const dbDateFormat = "2006-01-02"
+
+// Capacity inputs for a single team member.
+type memberCapacity struct {
+	memberId          int64
+	name              string
+	hoursPerDay       sdec.Decimal
+	allocationPercent int32
+	daysOff           map[string]bool
+}
+
+// Hours of a team member on a task, spread over the working days of the task.
+type memberAssignment struct {
+	memberId  int64
+	taskId    int64
+	startDate time.Time
+	endDate   time.Time
+	hours     sdec.Decimal
+}
+
+// Hours assigned to a team member on a single day.
+type dayLoad struct {
+	hours   sdec.Decimal
+	taskIds []int64
+}
+
+// Hours available per working day, after the project allocation.
+func (c *memberCapacity) dailyHours() sdec.Decimal {
+	return c.hoursPerDay.Mul(sdec.New(int64(c.allocationPercent), 0)).Div(sdec.New(100, 0))
+}
+
+// Hours available on a day, zero on weekends and days off.
+func (c *memberCapacity) hoursOn(day time.Time) sdec.Decimal {
+	if !isWorkday(day) || c.daysOff[day.Format(dbDateFormat)] {
+		return sdec.Decimal{}
+	}
+
+	return c.dailyHours()
+}
+
+// Get midnight local time of the day containing t.
+func startOfDay(t time.Time) time.Time {
+	t = t.In(time.Local)
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+}
+
+// Get midnight local time of the Monday of the week containing t.
+func startOfWeek(t time.Time) time.Time {
+	day := startOfDay(t)
+	offset := (int(day.Weekday()) + 6) % 7
+	return day.AddDate(0, 0, -offset)
+}
+
+// Saturday and Sunday are not working days.
+func isWorkday(t time.Time) bool {
+	return (t.Weekday() != time.Saturday) && (t.Weekday() != time.Sunday)
+}
+
+// Get the working days from start to end inclusive, or the start day if there are none.
+func workdaysBetween(start time.Time, end time.Time) []time.Time {
+	var days []time.Time
+	last := startOfDay(end)
+	for day := startOfDay(start); !day.After(last); day = day.AddDate(0, 0, 1) {
+		if isWorkday(day) {
+			days = append(days, day)
+		}
+	}
+
+	if len(days) == 0 {
+		days = append(days, startOfDay(start))
+	}
+
+	return days
+}
+
+// Spread each assignment evenly over its working days, returning the load per member per day.
+func dailyAssignedHours(assignments []*memberAssignment) map[int64]map[string]*dayLoad {
+	load := make(map[int64]map[string]*dayLoad)
+	for _, a := range assignments {
+		if !a.hours.IsPositive() {
+			continue
+		}
+
+		days := workdaysBetween(a.startDate, a.endDate)
+		perDay := a.hours.Div(sdec.New(int64(len(days)), 0))
+
+		memberLoad, ok := load[a.memberId]
+		if !ok {
+			memberLoad = make(map[string]*dayLoad)
+			load[a.memberId] = memberLoad
+		}
+
+		for _, day := range days {
+			key := day.Format(dbDateFormat)
+			dl, ok := memberLoad[key]
+			if !ok {
+				dl = &dayLoad{}
+				memberLoad[key] = dl
+			}
+			dl.hours = dl.hours.Add(perDay)
+			dl.taskIds = append(dl.taskIds, a.taskId)
+		}
+	}
+
+	return load
+}
+
+// Compare weekly assigned hours against capacity for each team member.
+func buildCapacityReport(capacities []*memberCapacity, assignments []*memberAssignment, start time.Time,
+	weeks int) []*pb.MemberCapacityReport {
+	var reports []*pb.MemberCapacityReport
+
+	load := dailyAssignedHours(assignments)
+	hundred := sdec.New(100, 0)
+
+	for _, c := range capacities {
+		report := pb.MemberCapacityReport{}
+		report.MemberId = c.memberId
+		report.Name = c.name
+		report.HoursPerDay = dml.ConvertDecimal(c.hoursPerDay)
+		report.AllocationPercent = c.allocationPercent
+
+		weekStart := startOfWeek(start)
+		for w := 0; w < weeks; w++ {
+			capacity := sdec.Decimal{}
+			assigned := sdec.Decimal{}
+			for d := 0; d < 7; d++ {
+				day := weekStart.AddDate(0, 0, d)
+				capacity = capacity.Add(c.hoursOn(day))
+				if dl, ok := load[c.memberId][day.Format(dbDateFormat)]; ok {
+					assigned = assigned.Add(dl.hours)
+				}
+			}
+
+			week := pb.WeekCapacity{}
+			week.WeekStart = dml.DateTimeFromTime(weekStart)
+			week.CapacityHours = dml.ConvertDecimal(capacity.Round(2))
+			week.AssignedHours = dml.ConvertDecimal(assigned.Round(2))
+			if capacity.IsPositive() {
+				week.UtilizationPercent = int32(assigned.Mul(hundred).Div(capacity).Round(0).IntPart())
+			}
+			week.IsOverbooked = assigned.Round(2).GreaterThan(capacity.Round(2))
+
+			report.Weeks = append(report.Weeks, &week)
+			weekStart = weekStart.AddDate(0, 0, 7)
+		}
+
+		reports = append(reports, &report)
+	}
+
+	return reports
+}
+
+// Helper to load the capacity and assignments of the team members of a project between two dates.
+func (s *projService) GetCapacityDataHelper(ctx context.Context, projectId int64, mserviceId int64, start time.Time,
+	end time.Time) (*genericResponse, []*memberCapacity, []*memberAssignment) {
+	storeCapacities, storeAssignments, err := s.store.Capacity().GetCapacityData(ctx, projectId, mserviceId, start, end)
+	if err != nil {
+		return s.storeErrorHelper(err), nil, nil
+	}
+
+	capacities := make([]*memberCapacity, 0, len(storeCapacities))
+	for _, sc := range storeCapacities {
+		c := &memberCapacity{memberId: sc.MemberId, name: sc.Name, hoursPerDay: sc.HoursPerDay,
+			allocationPercent: sc.AllocationPercent, daysOff: make(map[string]bool)}
+		for _, dayOff := range sc.DaysOff {
+			c.daysOff[dayOff.Format(dbDateFormat)] = true
+		}
+
+		capacities = append(capacities, c)
+	}
+
+	assignments := make([]*memberAssignment, 0, len(storeAssignments))
+	for _, sa := range storeAssignments {
+		assignments = append(assignments, &memberAssignment{memberId: sa.MemberId, taskId: sa.TaskId,
+			startDate: sa.StartDate, endDate: sa.EndDate, hours: sa.Hours})
+	}
+
+	return &genericResponse{}, capacities, assignments
+}
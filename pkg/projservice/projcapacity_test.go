@@ -0,0 +1,161 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projservice
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+	sdec "github.com/shopspring/decimal"
+)
+
+// Helper to get midnight local time of a day given as 2006-01-02.
+func testDay(t *testing.T, day string) time.Time {
+	t.Helper()
+
+	d, err := time.ParseInLocation(dbDateFormat, day, time.Local)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return d
+}
+
+// Helper to format a decimal with two places, for comparison.
+func testHours(d *dml.Decimal) string {
+	hours, err := d.ConvertDecimal()
+	if err != nil {
+		return "invalid"
+	}
+
+	return hours.StringFixed(2)
+}
+
+// Helper to get the capacity of a team member, with days off given as 2006-01-02.
+func testCapacity(memberId int64, hoursPerDay string, allocationPercent int32, daysOff ...string) *memberCapacity {
+	c := &memberCapacity{memberId: memberId, name: "member", hoursPerDay: sdec.RequireFromString(hoursPerDay),
+		allocationPercent: allocationPercent, daysOff: make(map[string]bool)}
+	for _, day := range daysOff {
+		c.daysOff[day] = true
+	}
+
+	return c
+}
+
+// Helper to get the hours of a team member on a task.
+func testAssignment(t *testing.T, memberId int64, taskId int64, start string, end string,
+	hours string) *memberAssignment {
+	t.Helper()
+
+	return &memberAssignment{memberId: memberId, taskId: taskId, startDate: testDay(t, start),
+		endDate: testDay(t, end), hours: sdec.RequireFromString(hours)}
+}
+
+func TestWorkdaysBetween(t *testing.T) {
+	tests := []struct {
+		name  string
+		start string
+		end   string
+		want  []string
+	}{
+		{"single day", "2030-01-08", "2030-01-08", []string{"2030-01-08"}},
+		{"week", "2030-01-07", "2030-01-11", []string{"2030-01-07", "2030-01-08", "2030-01-09", "2030-01-10",
+			"2030-01-11"}},
+		{"over a weekend", "2030-01-10", "2030-01-15", []string{"2030-01-10", "2030-01-11", "2030-01-14",
+			"2030-01-15"}},
+		{"weekend only", "2030-01-12", "2030-01-13", []string{"2030-01-12"}},
+		{"end before start", "2030-01-11", "2030-01-07", []string{"2030-01-11"}},
+	}
+
+	for _, tt := range tests {
+		// a time of day on the end date still includes that day
+		days := workdaysBetween(testDay(t, tt.start), testDay(t, tt.end).Add(17*time.Hour))
+
+		var got []string
+		for _, day := range days {
+			got = append(got, day.Format(dbDateFormat))
+		}
+
+		if len(got) != len(tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+			continue
+		}
+
+		for n := range got {
+			if got[n] != tt.want[n] {
+				t.Errorf("%s: got %v, want %v", tt.name, got, tt.want)
+				break
+			}
+		}
+	}
+}
+
+func TestBuildCapacityReport(t *testing.T) {
+	type week struct {
+		capacity    string
+		assigned    string
+		utilization int32
+		overbooked  bool
+	}
+
+	tests := []struct {
+		name        string
+		capacity    *memberCapacity
+		assignments []*memberAssignment
+		want        []week
+	}{
+		{"spread over a week", testCapacity(1, "8", 100),
+			[]*memberAssignment{testAssignment(t, 1, 10, "2030-01-07", "2030-01-11", "30")},
+			[]week{{"40.00", "30.00", 75, false}, {"40.00", "0.00", 0, false}}},
+		{"allocation and a day off", testCapacity(1, "8", 50, "2030-01-09"),
+			[]*memberAssignment{testAssignment(t, 1, 10, "2030-01-07", "2030-01-11", "20")},
+			[]week{{"16.00", "20.00", 125, true}, {"20.00", "0.00", 0, false}}},
+		{"weekend only task", testCapacity(1, "8", 100),
+			[]*memberAssignment{testAssignment(t, 1, 10, "2030-01-12", "2030-01-13", "8")},
+			[]week{{"40.00", "8.00", 20, false}, {"40.00", "0.00", 0, false}}},
+		{"task across weeks", testCapacity(1, "8", 100),
+			[]*memberAssignment{testAssignment(t, 1, 10, "2030-01-10", "2030-01-15", "16")},
+			[]week{{"40.00", "8.00", 20, false}, {"40.00", "8.00", 20, false}}},
+		{"other member", testCapacity(1, "8", 100),
+			[]*memberAssignment{testAssignment(t, 2, 10, "2030-01-07", "2030-01-11", "80")},
+			[]week{{"40.00", "0.00", 0, false}, {"40.00", "0.00", 0, false}}},
+		{"no capacity", testCapacity(1, "8", 0),
+			[]*memberAssignment{testAssignment(t, 1, 10, "2030-01-07", "2030-01-11", "10")},
+			[]week{{"0.00", "10.00", 0, true}, {"0.00", "0.00", 0, false}}},
+	}
+
+	for _, tt := range tests {
+		// the report starts on the Monday of the week of its start
+		reports := buildCapacityReport([]*memberCapacity{tt.capacity}, tt.assignments, testDay(t, "2030-01-09"),
+			len(tt.want))
+		if (len(reports) != 1) || (len(reports[0].GetWeeks()) != len(tt.want)) {
+			t.Fatalf("%s: got %v, want one report of %d weeks", tt.name, reports, len(tt.want))
+		}
+
+		for n, w := range reports[0].GetWeeks() {
+			want := tt.want[n]
+			weekStart := testDay(t, "2030-01-07").AddDate(0, 0, 7*n)
+			if !w.GetWeekStart().TimeFromDateTime().Equal(weekStart) {
+				t.Errorf("%s week %d: got start %v, want %v", tt.name, n, w.GetWeekStart().TimeFromDateTime(), weekStart)
+			}
+
+			got := week{testHours(w.GetCapacityHours()), testHours(w.GetAssignedHours()), w.GetUtilizationPercent(),
+				w.GetIsOverbooked()}
+			if got != want {
+				t.Errorf("%s week %d: got %+v, want %+v", tt.name, n, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projservice
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+
+	pb "github.com/gaterace/mproject/pkg/mserviceproject"
+)
+
+// Entity types carried by a ProjectEvent.
+const (
+	EntityTask       = "task"
+	EntityMember     = "member"
+	EntityAssignment = "assignment"
+)
+
+// Actions carried by a ProjectEvent.
+const (
+	ActionCreate = "create"
+	ActionUpdate = "update"
+	ActionDelete = "delete"
+)
+
+// Number of recent events kept for resuming a watch.
+const eventHistorySize = 1000
+
+// Buffered events per watcher before it is considered to have fallen behind.
+const watcherBufferSize = 256
+
+// Callback invoked for every event published by the projService.
+type EventListener func(event *pb.ProjectEvent)
+
+// A single watch_project subscription.
+type eventWatcher struct {
+	mserviceId int64
+	projectId  int64
+	events     chan *pb.ProjectEvent
+	lagged     bool
+}
+
+// In-process fan out of project change events, with a bounded history for resume.
+type eventHub struct {
+	mu        sync.Mutex
+	firstSeq  int64
+	lastSeq   int64
+	history   []*pb.ProjectEvent
+	watchers  map[*eventWatcher]struct{}
+	listeners []EventListener
+}
+
+// Get a new eventHub instance.
+func newEventHub() *eventHub {
+	hub := eventHub{}
+	// sequence numbers start from the startup time in microseconds, so a sequence
+	// handed out by a previous server instance is always detected as expired
+	hub.lastSeq = time.Now().UnixNano() / 1000
+	hub.firstSeq = hub.lastSeq + 1
+	hub.watchers = make(map[*eventWatcher]struct{})
+	return &hub
+}
+
+// Add a listener that is called for every published event.
+func (h *eventHub) addListener(listener EventListener) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.listeners = append(h.listeners, listener)
+}
+
+// Assign a sequence number to the event and deliver it to watchers and listeners.
+func (h *eventHub) publish(event *pb.ProjectEvent) {
+	h.mu.Lock()
+	h.lastSeq++
+	event.Sequence = h.lastSeq
+	event.Created = dml.DateTimeFromTime(time.Now())
+
+	h.history = append(h.history, event)
+	if len(h.history) > eventHistorySize {
+		h.history = h.history[len(h.history)-eventHistorySize:]
+	}
+	h.firstSeq = h.history[0].GetSequence()
+
+	for w := range h.watchers {
+		if w.mserviceId != event.GetMserviceId() || w.projectId != event.GetProjectId() {
+			continue
+		}
+		select {
+		case w.events <- event:
+		default:
+			// slow consumer, the watch will be closed and must resume
+			w.lagged = true
+			delete(h.watchers, w)
+			close(w.events)
+		}
+	}
+
+	listeners := h.listeners
+	h.mu.Unlock()
+
+	for _, listener := range listeners {
+		listener(event)
+	}
+}
+
+// Register a watcher for a project, returning the retained events after fromSequence.
+// The ok result is false if fromSequence is no longer available for resume.
+func (h *eventHub) subscribe(mserviceId int64, projectId int64, fromSequence int64) (*eventWatcher, []*pb.ProjectEvent, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	var replay []*pb.ProjectEvent
+	if fromSequence != 0 {
+		if (fromSequence < h.firstSeq-1) || (fromSequence > h.lastSeq) {
+			return nil, nil, false
+		}
+
+		for _, event := range h.history {
+			if event.GetSequence() > fromSequence && event.GetMserviceId() == mserviceId &&
+				event.GetProjectId() == projectId {
+				replay = append(replay, event)
+			}
+		}
+	}
+
+	w := &eventWatcher{mserviceId: mserviceId, projectId: projectId}
+	w.events = make(chan *pb.ProjectEvent, watcherBufferSize)
+	h.watchers[w] = struct{}{}
+
+	return w, replay, true
+}
+
+// Remove a watcher registered with subscribe.
+func (h *eventHub) unsubscribe(w *eventWatcher) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.watchers[w]; ok {
+		delete(h.watchers, w)
+		close(w.events)
+	}
+}
+
+// Whether the watcher was dropped for falling behind.
+func (h *eventHub) isLagged(w *eventWatcher) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return w.lagged
+}
@@ -0,0 +1,252 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projservice
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+	sdec "github.com/shopspring/decimal"
+
+	pb "github.com/gaterace/mproject/pkg/mserviceproject"
+)
+
+const defaultOverallocationDays = 28
+const maxOverallocationDays = 366
+
+// A task that leveling may shift later, with the hours of each assigned member.
+type levelTask struct {
+	taskId    int64
+	name      string
+	startDate time.Time
+	endDate   time.Time
+	priority  int32
+	floatDays int
+	hours     map[int64]sdec.Decimal
+}
+
+// Task dates and hierarchy used to compute float.
+type taskSchedule struct {
+	taskId    int64
+	name      string
+	startDate time.Time
+	endDate   time.Time
+	priority  int32
+	parentId  int64
+}
+
+// List the days from start to end where a team member is assigned more hours than capacity.
+func buildOverallocations(capacities []*memberCapacity, assignments []*memberAssignment, start time.Time,
+	end time.Time) []*pb.Overallocation {
+	var overs []*pb.Overallocation
+
+	load := dailyAssignedHours(assignments)
+	last := startOfDay(end)
+
+	for _, c := range capacities {
+		for day := startOfDay(start); !day.After(last); day = day.AddDate(0, 0, 1) {
+			dl, ok := load[c.memberId][day.Format(dbDateFormat)]
+			if !ok {
+				continue
+			}
+
+			capacity := c.hoursOn(day).Round(2)
+			assigned := dl.hours.Round(2)
+			if !assigned.GreaterThan(capacity) {
+				continue
+			}
+
+			over := pb.Overallocation{}
+			over.MemberId = c.memberId
+			over.Name = c.name
+			over.Day = dml.DateTimeFromTime(day)
+			over.CapacityHours = dml.ConvertDecimal(capacity)
+			over.AssignedHours = dml.ConvertDecimal(assigned)
+			over.ExcessHours = dml.ConvertDecimal(assigned.Sub(capacity))
+			over.TaskIds = dl.taskIds
+
+			overs = append(overs, &over)
+		}
+	}
+
+	return overs
+}
+
+// Place tasks in priority order, shifting a task later within its float when it would over-allocate
+// a member given the tasks already placed. Fixed assignments are placed first and never moved.
+func suggestLeveling(capacities []*memberCapacity, fixed []*memberAssignment,
+	tasks []*levelTask) ([]*pb.LevelingSuggestion, []int64) {
+	var suggestions []*pb.LevelingSuggestion
+	var unresolved []int64
+
+	byMember := make(map[int64]*memberCapacity)
+	for _, c := range capacities {
+		byMember[c.memberId] = c
+	}
+
+	load := make(map[int64]map[string]sdec.Decimal)
+	for memberId, days := range dailyAssignedHours(fixed) {
+		load[memberId] = make(map[string]sdec.Decimal)
+		for key, dl := range days {
+			load[memberId][key] = dl.hours
+		}
+	}
+
+	// try placing a task shifted by a number of days, adding it to the load if it fits or if place is set
+	tryPlace := func(t *levelTask, shift int, place bool) bool {
+		days := workdaysBetween(t.startDate.AddDate(0, 0, shift), t.endDate.AddDate(0, 0, shift))
+		fits := true
+		for memberId, hours := range t.hours {
+			c, ok := byMember[memberId]
+			if !ok {
+				continue
+			}
+
+			perDay := hours.Div(sdec.New(int64(len(days)), 0))
+			for _, day := range days {
+				total := load[memberId][day.Format(dbDateFormat)].Add(perDay)
+				if total.Round(2).GreaterThan(c.hoursOn(day).Round(2)) {
+					fits = false
+				}
+			}
+		}
+
+		if fits || place {
+			for memberId, hours := range t.hours {
+				if _, ok := load[memberId]; !ok {
+					load[memberId] = make(map[string]sdec.Decimal)
+				}
+
+				perDay := hours.Div(sdec.New(int64(len(days)), 0))
+				for _, day := range days {
+					key := day.Format(dbDateFormat)
+					load[memberId][key] = load[memberId][key].Add(perDay)
+				}
+			}
+		}
+
+		return fits
+	}
+
+	// higher priority tasks keep their dates, then earlier tasks
+	sort.SliceStable(tasks, func(i, j int) bool {
+		if tasks[i].priority != tasks[j].priority {
+			return tasks[i].priority > tasks[j].priority
+		}
+		if !tasks[i].startDate.Equal(tasks[j].startDate) {
+			return tasks[i].startDate.Before(tasks[j].startDate)
+		}
+		return tasks[i].taskId < tasks[j].taskId
+	})
+
+	for _, t := range tasks {
+		if tryPlace(t, 0, false) {
+			continue
+		}
+
+		resolved := false
+		for shift := 1; shift <= t.floatDays; shift++ {
+			if tryPlace(t, shift, false) {
+				suggestion := pb.LevelingSuggestion{}
+				suggestion.TaskId = t.taskId
+				suggestion.TaskName = t.name
+				suggestion.StartDate = dml.DateTimeFromTime(t.startDate)
+				suggestion.EndDate = dml.DateTimeFromTime(t.endDate)
+				suggestion.ProposedStartDate = dml.DateTimeFromTime(t.startDate.AddDate(0, 0, shift))
+				suggestion.ProposedEndDate = dml.DateTimeFromTime(t.endDate.AddDate(0, 0, shift))
+				suggestion.ShiftDays = int32(shift)
+				suggestion.FloatDays = int32(t.floatDays)
+
+				suggestions = append(suggestions, &suggestion)
+				resolved = true
+				break
+			}
+		}
+
+		if !resolved {
+			tryPlace(t, 0, true)
+			unresolved = append(unresolved, t.taskId)
+		}
+	}
+
+	return suggestions, unresolved
+}
+
+// Get whole days from a to b, zero if b is before a.
+func daysUntil(a time.Time, b time.Time) int {
+	days := int(startOfDay(b).Sub(startOfDay(a)).Hours()+12) / 24
+	if days < 0 {
+		days = 0
+	}
+
+	return days
+}
+
+// Build the movable tasks and fixed assignments for leveling. Tasks starting before start are fixed.
+// Float is the time between the end of a task and the end of its parent task, or of the project.
+func buildLevelTasks(schedules []*taskSchedule, assignments []*memberAssignment, start time.Time,
+	projectEnd time.Time) ([]*levelTask, []*memberAssignment) {
+	var tasks []*levelTask
+	var fixed []*memberAssignment
+
+	byTask := make(map[int64]*taskSchedule)
+	for _, ts := range schedules {
+		byTask[ts.taskId] = ts
+	}
+
+	movable := make(map[int64]*levelTask)
+	for _, a := range assignments {
+		ts, ok := byTask[a.taskId]
+		if !ok || ts.startDate.Before(startOfDay(start)) {
+			fixed = append(fixed, a)
+			continue
+		}
+
+		t, ok := movable[a.taskId]
+		if !ok {
+			limit := projectEnd
+			if parent, ok := byTask[ts.parentId]; ok && parent.endDate.Before(limit) {
+				limit = parent.endDate
+			}
+
+			t = &levelTask{taskId: ts.taskId, name: ts.name, startDate: ts.startDate, endDate: ts.endDate,
+				priority: ts.priority, floatDays: daysUntil(ts.endDate, limit)}
+			t.hours = make(map[int64]sdec.Decimal)
+			movable[a.taskId] = t
+			tasks = append(tasks, t)
+		}
+
+		t.hours[a.memberId] = t.hours[a.memberId].Add(a.hours)
+	}
+
+	return tasks, fixed
+}
+
+// Helper to get the dates, priority and parent of all tasks of a project.
+func (s *projService) GetTaskSchedulesHelper(ctx context.Context, projectId int64, mserviceId int64) (*genericResponse, []*taskSchedule) {
+	storeSchedules, err := s.store.Capacity().GetTaskSchedules(ctx, projectId, mserviceId)
+	if err != nil {
+		return s.storeErrorHelper(err), nil
+	}
+
+	schedules := make([]*taskSchedule, 0, len(storeSchedules))
+	for _, ts := range storeSchedules {
+		schedules = append(schedules, &taskSchedule{taskId: ts.TaskId, name: ts.Name, startDate: ts.StartDate,
+			endDate: ts.EndDate, priority: ts.Priority, parentId: ts.ParentId})
+	}
+
+	return &genericResponse{}, schedules
+}
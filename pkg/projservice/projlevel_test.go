@@ -0,0 +1,207 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projservice
+
+import (
+	"fmt"
+	"testing"
+
+	sdec "github.com/shopspring/decimal"
+)
+
+// Helper to get a task for leveling, with the hours of a single team member.
+func testLevelTask(t *testing.T, taskId int64, start string, end string, priority int32, floatDays int,
+	memberId int64, hours string) *levelTask {
+	t.Helper()
+
+	return &levelTask{taskId: taskId, name: fmt.Sprintf("task%d", taskId), startDate: testDay(t, start),
+		endDate: testDay(t, end), priority: priority, floatDays: floatDays,
+		hours: map[int64]sdec.Decimal{memberId: sdec.RequireFromString(hours)}}
+}
+
+func TestBuildOverallocations(t *testing.T) {
+	type over struct {
+		day      string
+		capacity string
+		assigned string
+		excess   string
+		taskIds  string
+	}
+
+	tests := []struct {
+		name        string
+		capacity    *memberCapacity
+		assignments []*memberAssignment
+		want        []over
+	}{
+		{"within capacity", testCapacity(1, "8", 100),
+			[]*memberAssignment{testAssignment(t, 1, 10, "2030-01-07", "2030-01-11", "40")}, nil},
+		{"day off", testCapacity(1, "8", 100, "2030-01-09"),
+			[]*memberAssignment{testAssignment(t, 1, 10, "2030-01-07", "2030-01-11", "40")},
+			[]over{{"2030-01-09", "0.00", "8.00", "8.00", "[10]"}}},
+		{"two tasks on a day", testCapacity(1, "8", 100),
+			[]*memberAssignment{testAssignment(t, 1, 10, "2030-01-07", "2030-01-08", "12"),
+				testAssignment(t, 1, 11, "2030-01-08", "2030-01-08", "4")},
+			[]over{{"2030-01-08", "8.00", "10.00", "2.00", "[10 11]"}}},
+		{"weekend only task", testCapacity(1, "8", 100),
+			[]*memberAssignment{testAssignment(t, 1, 10, "2030-01-12", "2030-01-13", "4")},
+			[]over{{"2030-01-12", "0.00", "4.00", "4.00", "[10]"}}},
+		{"after the range", testCapacity(1, "8", 100),
+			[]*memberAssignment{testAssignment(t, 1, 10, "2030-01-14", "2030-01-14", "12")}, nil},
+	}
+
+	for _, tt := range tests {
+		overs := buildOverallocations([]*memberCapacity{tt.capacity}, tt.assignments, testDay(t, "2030-01-07"),
+			testDay(t, "2030-01-13"))
+		if len(overs) != len(tt.want) {
+			t.Errorf("%s: got %d overallocations, want %d", tt.name, len(overs), len(tt.want))
+			continue
+		}
+
+		for n, o := range overs {
+			got := over{o.GetDay().TimeFromDateTime().Format(dbDateFormat), testHours(o.GetCapacityHours()),
+				testHours(o.GetAssignedHours()), testHours(o.GetExcessHours()), fmt.Sprint(o.GetTaskIds())}
+			if got != tt.want[n] {
+				t.Errorf("%s: got %+v, want %+v", tt.name, got, tt.want[n])
+			}
+		}
+	}
+}
+
+func TestSuggestLeveling(t *testing.T) {
+	type suggestion struct {
+		taskId        int64
+		shiftDays     int32
+		proposedStart string
+		proposedEnd   string
+	}
+
+	tests := []struct {
+		name       string
+		capacity   *memberCapacity
+		fixed      []*memberAssignment
+		tasks      []*levelTask
+		want       []suggestion
+		unresolved string
+	}{
+		{"fits", testCapacity(1, "8", 100), nil,
+			[]*levelTask{testLevelTask(t, 1, "2030-01-07", "2030-01-07", 0, 5, 1, "8")}, nil, "[]"},
+		{"shift within float", testCapacity(1, "8", 100),
+			[]*memberAssignment{testAssignment(t, 1, 99, "2030-01-07", "2030-01-07", "8")},
+			[]*levelTask{testLevelTask(t, 1, "2030-01-07", "2030-01-08", 0, 3, 1, "8")},
+			[]suggestion{{1, 1, "2030-01-08", "2030-01-09"}}, "[]"},
+		{"no float", testCapacity(1, "8", 100),
+			[]*memberAssignment{testAssignment(t, 1, 99, "2030-01-07", "2030-01-07", "8")},
+			[]*levelTask{testLevelTask(t, 1, "2030-01-07", "2030-01-07", 0, 0, 1, "8")}, nil, "[1]"},
+		{"float too short", testCapacity(1, "8", 100),
+			[]*memberAssignment{testAssignment(t, 1, 99, "2030-01-07", "2030-01-09", "24")},
+			[]*levelTask{testLevelTask(t, 1, "2030-01-07", "2030-01-07", 0, 2, 1, "8")}, nil, "[1]"},
+		{"shift over a weekend", testCapacity(1, "8", 100),
+			[]*memberAssignment{testAssignment(t, 1, 99, "2030-01-11", "2030-01-11", "8")},
+			[]*levelTask{testLevelTask(t, 1, "2030-01-11", "2030-01-11", 0, 5, 1, "8")},
+			[]suggestion{{1, 3, "2030-01-14", "2030-01-14"}}, "[]"},
+		{"day off", testCapacity(1, "8", 100, "2030-01-07"), nil,
+			[]*levelTask{testLevelTask(t, 1, "2030-01-07", "2030-01-07", 0, 2, 1, "4")},
+			[]suggestion{{1, 1, "2030-01-08", "2030-01-08"}}, "[]"},
+		{"higher priority keeps its dates", testCapacity(1, "8", 100), nil,
+			[]*levelTask{testLevelTask(t, 1, "2030-01-07", "2030-01-07", 1, 2, 1, "8"),
+				testLevelTask(t, 2, "2030-01-07", "2030-01-07", 5, 2, 1, "8")},
+			[]suggestion{{1, 1, "2030-01-08", "2030-01-08"}}, "[]"},
+		{"unresolved task still loads", testCapacity(1, "8", 100), nil,
+			[]*levelTask{testLevelTask(t, 1, "2030-01-07", "2030-01-07", 5, 0, 1, "12"),
+				testLevelTask(t, 2, "2030-01-07", "2030-01-07", 1, 1, 1, "4")},
+			[]suggestion{{2, 1, "2030-01-08", "2030-01-08"}}, "[1]"},
+	}
+
+	for _, tt := range tests {
+		suggestions, unresolved := suggestLeveling([]*memberCapacity{tt.capacity}, tt.fixed, tt.tasks)
+		if len(suggestions) != len(tt.want) {
+			t.Errorf("%s: got %d suggestions, want %d", tt.name, len(suggestions), len(tt.want))
+		} else {
+			for n, s := range suggestions {
+				got := suggestion{s.GetTaskId(), s.GetShiftDays(),
+					s.GetProposedStartDate().TimeFromDateTime().Format(dbDateFormat),
+					s.GetProposedEndDate().TimeFromDateTime().Format(dbDateFormat)}
+				if got != tt.want[n] {
+					t.Errorf("%s: got %+v, want %+v", tt.name, got, tt.want[n])
+				}
+			}
+		}
+
+		if got := fmt.Sprint(unresolved); got != tt.unresolved {
+			t.Errorf("%s: got unresolved %s, want %s", tt.name, got, tt.unresolved)
+		}
+	}
+}
+
+func TestBuildLevelTasks(t *testing.T) {
+	schedules := []*taskSchedule{
+		{taskId: 1, name: "design", startDate: testDay(t, "2030-01-07"), endDate: testDay(t, "2030-01-18")},
+		{taskId: 2, name: "sketch", startDate: testDay(t, "2030-01-07"), endDate: testDay(t, "2030-01-11"),
+			parentId: 1},
+		{taskId: 3, name: "review", startDate: testDay(t, "2030-01-14"), endDate: testDay(t, "2030-01-18"),
+			parentId: 1},
+		{taskId: 4, name: "kickoff", startDate: testDay(t, "2030-01-02"), endDate: testDay(t, "2030-01-03")},
+	}
+
+	assignments := []*memberAssignment{
+		testAssignment(t, 1, 1, "2030-01-07", "2030-01-18", "10"),
+		testAssignment(t, 1, 2, "2030-01-07", "2030-01-11", "8"),
+		testAssignment(t, 2, 2, "2030-01-07", "2030-01-11", "4"),
+		testAssignment(t, 1, 3, "2030-01-14", "2030-01-18", "6"),
+		testAssignment(t, 1, 4, "2030-01-02", "2030-01-03", "2"),
+	}
+
+	tasks, fixed := buildLevelTasks(schedules, assignments, testDay(t, "2030-01-07"), testDay(t, "2030-01-25"))
+
+	// the task started before leveling is fixed
+	if (len(fixed) != 1) || (fixed[0].taskId != 4) {
+		t.Errorf("fixed: got %v, want the assignment of task 4", fixed)
+	}
+
+	tests := []struct {
+		taskId    int64
+		floatDays int
+		hours     map[int64]string
+	}{
+		// float to the project end
+		{1, 7, map[int64]string{1: "10"}},
+		// float to the end of the parent task
+		{2, 7, map[int64]string{1: "8", 2: "4"}},
+		// ends with its parent, no float
+		{3, 0, map[int64]string{1: "6"}},
+	}
+
+	if len(tasks) != len(tests) {
+		t.Fatalf("tasks: got %d, want %d", len(tasks), len(tests))
+	}
+
+	for n, tt := range tests {
+		task := tasks[n]
+		if (task.taskId != tt.taskId) || (task.floatDays != tt.floatDays) {
+			t.Errorf("task %d: got task %d with float %d, want float %d", n, task.taskId, task.floatDays,
+				tt.floatDays)
+		}
+
+		if len(task.hours) != len(tt.hours) {
+			t.Errorf("task %d: got hours %v, want %v", tt.taskId, task.hours, tt.hours)
+		}
+
+		for memberId, hours := range tt.hours {
+			if !task.hours[memberId].Equal(sdec.RequireFromString(hours)) {
+				t.Errorf("task %d member %d: got %s hours, want %s", tt.taskId, memberId, task.hours[memberId], hours)
+			}
+		}
+	}
+}
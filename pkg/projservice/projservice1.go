@@ -16,7 +16,6 @@ package projservice
 
 import (
 	"context"
-	"database/sql"
 	"errors"
 	"strings"
 	"time"
@@ -24,9 +23,9 @@ import (
 	"github.com/go-kit/kit/log"
 	"github.com/go-kit/kit/log/level"
 
-	_ "github.com/go-sql-driver/mysql"
-
 	pb "github.com/gaterace/mproject/pkg/mserviceproject"
+	"github.com/gaterace/mproject/pkg/projhook"
+	"github.com/gaterace/mproject/pkg/projstore"
 	"google.golang.org/grpc"
 )
 
@@ -34,15 +33,19 @@ var NotImplemented = errors.New("not implemented")
 
 type projService struct {
 	pb.UnimplementedMServiceProjectServer
-	logger    log.Logger
-	db        *sql.DB
-	startSecs int64
+	logger     log.Logger
+	store      projstore.Store
+	startSecs  int64
+	events     *eventHub
+	hooks      *projhook.Dispatcher
+	statusMode bool
 }
 
 // Get a new projService instance.
 func NewProjectService() *projService {
 	svc := projService{}
 	svc.startSecs = time.Now().Unix()
+	svc.events = newEventHub()
 	return &svc
 }
 
@@ -51,9 +54,25 @@ func (s *projService) SetLogger(logger log.Logger) {
 	s.logger = logger
 }
 
-// Set the database connection for the projService instance.
-func (s *projService) SetDatabaseConnection(sqlDB *sql.DB) {
-	s.db = sqlDB
+// Set the storage of the service data for the projService instance.
+func (s *projService) SetStore(store projstore.Store) {
+	s.store = store
+}
+
+// Add a listener called for every project change event published by the projService instance.
+func (s *projService) AddEventListener(listener EventListener) {
+	s.events.addListener(listener)
+}
+
+// Set the webhook dispatcher used to redeliver logged webhook deliveries.
+func (s *projService) SetWebhookDispatcher(hooks *projhook.Dispatcher) {
+	s.hooks = hooks
+}
+
+// Set whether version conflicts and duplicate keys get their own error codes, for servers that also return gRPC
+// status errors; otherwise they are reported as not found and update failed, as before.
+func (s *projService) SetStatusMode(statusMode bool) {
+	s.statusMode = statusMode
 }
 
 // Bind this projService the gRPC server api.
@@ -81,41 +100,22 @@ func (s *projService) CreateProject(ctx context.Context, req *pb.CreateProjectRe
 		return resp, nil
 	}
 
-	sqlstring := `INSERT INTO tb_Project
-	(dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId, chvName, chvDescription,
-		intStatusId, dtmStartDate, dtmEndDate) VALUES(NOW(), NOW(), NOW(), 0, 1, ?, ?, ?, ?, ?, ?)`
-	stmt, err := s.db.Prepare(sqlstring)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
-		return resp, nil
-	}
-
-	defer stmt.Close()
+	project := &pb.Project{MserviceId: req.GetMserviceId(), Name: req.GetName(), Description: desc,
+		StatusId: req.GetStatusId(), StartDate: req.GetStartDate(), EndDate: req.GetEndDate()}
 
-	start_date := req.GetStartDate().TimeFromDateTime()
-	end_date := req.GetEndDate().TimeFromDateTime()
-
-	res, err := stmt.Exec(req.GetMserviceId(), req.GetName(), desc, req.GetStatusId(), start_date, end_date)
+	projectId, err := s.store.Projects().CreateProject(ctx, project)
 	if err == nil {
-		projectId, err := res.LastInsertId()
-		if err != nil {
-			level.Error(s.logger).Log("what", "LastInsertId", "error", err)
-		} else {
-			level.Debug(s.logger).Log("projectId", projectId)
-		}
+		level.Debug(s.logger).Log("projectId", projectId)
 
 		resp.ProjectId = projectId
 		resp.Version = 1
 	} else {
-		resp.ErrorCode = 501
-		resp.ErrorMessage = err.Error()
-		level.Error(s.logger).Log("what", "Exec", "error", err)
-		err = nil
+		gResp := s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
 	}
 
-	return resp, err
+	return resp, nil
 
 }
 
@@ -135,39 +135,20 @@ func (s *projService) UpdateProject(ctx context.Context, req *pb.UpdateProjectRe
 		return resp, nil
 	}
 
-	sqlstring := `UPDATE tb_Project SET dtmModified = NOW(), intVersion = ?, chvName = ?, chvDescription = ?, intStatusId = ?, 
-	dtmStartDate = ?, dtmEndDate = ?  WHERE inbProjectId = ? AND intVersion = ? AND inbMserviceId = ? AND bitIsDeleted = 0`
-
-	stmt, err := s.db.Prepare(sqlstring)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
-		return resp, nil
-	}
-
-	defer stmt.Close()
+	project := &pb.Project{ProjectId: req.GetProjectId(), Version: req.GetVersion(), MserviceId: req.GetMserviceId(),
+		Name: req.GetName(), Description: req.GetDescription(), StatusId: req.GetStatusId(),
+		StartDate: req.GetStartDate(), EndDate: req.GetEndDate()}
 
-	start_date := req.GetStartDate().TimeFromDateTime()
-	end_date := req.GetEndDate().TimeFromDateTime()
-
-	res, err := stmt.Exec(req.GetVersion()+1, req.GetName(), req.GetDescription(), req.GetStatusId(), start_date, end_date, req.GetProjectId(), req.GetVersion(), req.GetMserviceId())
+	err := s.store.Projects().UpdateProject(ctx, project)
 	if err == nil {
-		rowsAffected, _ := res.RowsAffected()
-		if rowsAffected == 1 {
-			resp.Version = req.GetVersion() + 1
-		} else {
-			resp.ErrorCode = 404
-			resp.ErrorMessage = "not found"
-		}
+		resp.Version = req.GetVersion() + 1
 	} else {
-		resp.ErrorCode = 501
-		resp.ErrorMessage = err.Error()
-		level.Error(s.logger).Log("what", "Exec", "error", err)
-		err = nil
+		gResp := s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
 	}
 
-	return resp, err
+	return resp, nil
 
 }
 
@@ -175,80 +156,33 @@ func (s *projService) UpdateProject(ctx context.Context, req *pb.UpdateProjectRe
 func (s *projService) DeleteProject(ctx context.Context, req *pb.DeleteProjectRequest) (*pb.DeleteProjectResponse, error) {
 	resp := &pb.DeleteProjectResponse{}
 
-	sqlstring := `UPDATE tb_Project SET dtmDeleted = NOW(), intVersion = ?, bitIsDeleted = 1
-	 WHERE inbProjectId = ? AND intVersion = ? AND inbMserviceId = ? AND bitIsDeleted = 0`
-
-	stmt, err := s.db.Prepare(sqlstring)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
-		return resp, nil
-	}
-
-	defer stmt.Close()
-
-	res, err := stmt.Exec(req.GetVersion()+1, req.GetProjectId(), req.GetVersion(), req.GetMserviceId())
+	err := s.store.Projects().DeleteProject(ctx, req.GetProjectId(), req.GetMserviceId(), req.GetVersion())
 	if err == nil {
-		rowsAffected, _ := res.RowsAffected()
-		if rowsAffected == 1 {
-			resp.Version = req.GetVersion() + 1
-		} else {
-			resp.ErrorCode = 404
-			resp.ErrorMessage = "not found"
-		}
+		resp.Version = req.GetVersion() + 1
 	} else {
-		resp.ErrorCode = 501
-		resp.ErrorMessage = err.Error()
-		level.Error(s.logger).Log("what", "Exec", "error", err)
-		err = nil
+		gResp := s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
 	}
 
-	return resp, err
+	return resp, nil
 
 }
 
 // get list of project names for this mservice id
 func (s *projService) GetProjectNames(ctx context.Context, req *pb.GetProjectNamesRequest) (*pb.GetProjectNamesResponse, error) {
 	resp := &pb.GetProjectNamesResponse{}
-	var err error
-
-	sqlstring := `SELECT chvName FROM tb_Project WHERE inbMserviceId = ? AND  bitIsDeleted = 0`
-	stmt, err := s.db.Prepare(sqlstring)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
-		return resp, nil
-	}
-
-	defer stmt.Close()
 
-	rows, err := stmt.Query(req.GetMserviceId())
-
-	if err != nil {
-		level.Error(s.logger).Log("what", "Query", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = err.Error()
-		return resp, nil
-	}
-
-	defer rows.Close()
-	for rows.Next() {
-		var projectName string
-		err = rows.Scan(&projectName)
-
-		if err != nil {
-			level.Error(s.logger).Log("what", "Scan", "error", err)
-			resp.ErrorCode = 500
-			resp.ErrorMessage = err.Error()
-			return resp, nil
-		}
-
-		resp.Names = append(resp.Names, projectName)
+	names, err := s.store.Projects().GetProjectNames(ctx, req.GetMserviceId())
+	if err == nil {
+		resp.Names = names
+	} else {
+		gResp := s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
 	}
 
-	return resp, err
+	return resp, nil
 }
 
 // get project entity by name
@@ -256,7 +190,7 @@ func (s *projService) GetProjectByName(ctx context.Context, req *pb.GetProjectBy
 	resp := &pb.GetProjectByNameResponse{}
 	var err error
 
-	gResp, project := s.GetProjectByNameHelper(req.GetName(), req.GetMserviceId())
+	gResp, project := s.GetProjectByNameHelper(ctx, req.GetName(), req.GetMserviceId())
 	resp.ErrorCode = gResp.ErrorCode
 	resp.ErrorMessage = gResp.ErrorMessage
 	if gResp.ErrorCode == 0 {
@@ -271,7 +205,7 @@ func (s *projService) GetProjectById(ctx context.Context, req *pb.GetProjectById
 	resp := &pb.GetProjectByIdResponse{}
 	var err error
 
-	gResp, project := s.GetProjectByIdHelper(req.GetProjectId(), req.GetMserviceId())
+	gResp, project := s.GetProjectByIdHelper(ctx, req.GetProjectId(), req.GetMserviceId())
 	resp.ErrorCode = gResp.ErrorCode
 	resp.ErrorMessage = gResp.ErrorMessage
 	if gResp.ErrorCode == 0 {
@@ -286,7 +220,7 @@ func (s *projService) GetProjectWrapperByName(ctx context.Context, req *pb.GetPr
 	resp := &pb.GetProjectWrapperByNameResponse{}
 	var err error
 
-	gResp, project := s.GetProjectByNameHelper(req.GetName(), req.GetMserviceId())
+	gResp, project := s.GetProjectByNameHelper(ctx, req.GetName(), req.GetMserviceId())
 	resp.ErrorCode = gResp.ErrorCode
 	resp.ErrorMessage = gResp.ErrorMessage
 	if gResp.ErrorCode != 0 {
@@ -297,12 +231,12 @@ func (s *projService) GetProjectWrapperByName(ctx context.Context, req *pb.GetPr
 
 	projectId := wrap.GetProjectId()
 
-	gResp, members := s.GetTeamMembersHelper(projectId, req.GetMserviceId())
+	gResp, members := s.GetTeamMembersHelper(ctx, projectId, req.GetMserviceId())
 	if gResp.ErrorCode == 0 {
 		wrap.TeamMembers = members
 	}
 
-	gResp, wraps := s.GetProjectTaskWrapperHelper(projectId, req.GetMserviceId())
+	gResp, wraps := s.GetProjectTaskWrapperHelper(ctx, projectId, req.GetMserviceId())
 
 	if gResp.ErrorCode != 0 {
 		resp.ErrorCode = gResp.ErrorCode
@@ -326,7 +260,7 @@ func (s *projService) GetProjectWrapperById(ctx context.Context, req *pb.GetProj
 	resp := &pb.GetProjectWrapperByIdResponse{}
 	var err error
 
-	gResp, project := s.GetProjectByIdHelper(req.GetProjectId(), req.GetMserviceId())
+	gResp, project := s.GetProjectByIdHelper(ctx, req.GetProjectId(), req.GetMserviceId())
 	resp.ErrorCode = gResp.ErrorCode
 	resp.ErrorMessage = gResp.ErrorMessage
 	if gResp.ErrorCode != 0 {
@@ -337,12 +271,12 @@ func (s *projService) GetProjectWrapperById(ctx context.Context, req *pb.GetProj
 
 	projectId := wrap.GetProjectId()
 
-	gResp, members := s.GetTeamMembersHelper(projectId, req.GetMserviceId())
+	gResp, members := s.GetTeamMembersHelper(ctx, projectId, req.GetMserviceId())
 	if gResp.ErrorCode == 0 {
 		wrap.TeamMembers = members
 	}
 
-	gResp, wraps := s.GetProjectTaskWrapperHelper(projectId, req.GetMserviceId())
+	gResp, wraps := s.GetProjectTaskWrapperHelper(ctx, projectId, req.GetMserviceId())
 
 	if gResp.ErrorCode != 0 {
 		resp.ErrorCode = gResp.ErrorCode
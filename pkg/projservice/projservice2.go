@@ -15,17 +15,13 @@ package projservice
 
 import (
 	"context"
-	"database/sql"
 	"regexp"
 	"strings"
 
 	"github.com/go-kit/kit/log/level"
 
-	"github.com/gaterace/dml-go/pkg/dml"
-
-	_ "github.com/go-sql-driver/mysql"
-
 	pb "github.com/gaterace/mproject/pkg/mserviceproject"
+	"github.com/gaterace/mproject/pkg/projstore"
 )
 
 var nameValidator = regexp.MustCompile("^[a-z0-9_\\-]{1,32}$")
@@ -46,493 +42,240 @@ func (s *projService) CreateStatusType(ctx context.Context, req *pb.CreateStatus
 		return resp, nil
 	}
 
-	sqlstring := `INSERT INTO tb_StatusType 
-		(intStatusId, dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId, 
-			chvStatusName, chvDescription) VALUES (?, NOW(), NOW(), NOW(), 0, 1, ?, ?, ?)`
-
-	stmt, err := s.db.Prepare(sqlstring)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
-		return resp, nil
-	}
-
-	defer stmt.Close()
+	statusType := &pb.StatusType{StatusId: req.GetStatusId(), MserviceId: req.GetMserviceId(),
+		StatusName: req.GetStatusName(), Description: desc}
 
-	_, err = stmt.Exec(req.GetStatusId(), req.GetMserviceId(), req.GetStatusName(), desc)
+	err := s.store.Types().CreateStatusType(ctx, statusType)
 	if err == nil {
 		resp.Version = 1
 	} else {
-		resp.ErrorCode = 501
-		resp.ErrorMessage = err.Error()
-		level.Error(s.logger).Log("what", "Exec", "error", err)
-		err = nil
+		gResp := s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
 	}
 
-	return resp, err
+	return resp, nil
 }
 
 // update a status type
 func (s *projService) UpdateStatusType(ctx context.Context, req *pb.UpdateStatusTypeRequest) (*pb.UpdateStatusTypeResponse, error) {
 	resp := &pb.UpdateStatusTypeResponse{}
-	var err error
 
-	sqlstring := `UPDATE tb_StatusType SET dtmModified = NOW(), intVersion = ?, chvStatusName = ?, chvDescription = ? 
-	WHERE intStatusId = ? AND inbMserviceId = ? AND bitIsDeleted = 0 AND intVersion = ?`
-
-	stmt, err := s.db.Prepare(sqlstring)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
-		return resp, nil
-	}
-
-	defer stmt.Close()
-
-	res, err := stmt.Exec(req.GetVersion()+1, req.GetStatusName(), req.GetDescription(), req.GetStatusId(), req.GetMserviceId(), req.GetVersion())
+	statusType := &pb.StatusType{StatusId: req.GetStatusId(), Version: req.GetVersion(), MserviceId: req.GetMserviceId(),
+		StatusName: req.GetStatusName(), Description: req.GetDescription()}
 
+	err := s.store.Types().UpdateStatusType(ctx, statusType)
 	if err == nil {
-		rowsAffected, _ := res.RowsAffected()
-		if rowsAffected == 1 {
-			resp.Version = req.GetVersion() + 1
-		} else {
-			resp.ErrorCode = 404
-			resp.ErrorMessage = "not found"
-		}
+		resp.Version = req.GetVersion() + 1
 	} else {
-		resp.ErrorCode = 501
-		resp.ErrorMessage = err.Error()
-		level.Error(s.logger).Log("what", "Exec", "error", err)
-		err = nil
+		gResp := s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
 	}
 
-	return resp, err
+	return resp, nil
 }
 
 // delete a status type
 func (s *projService) DeleteStatusType(ctx context.Context, req *pb.DeleteStatusTypeRequest) (*pb.DeleteStatusTypeResponse, error) {
 	resp := &pb.DeleteStatusTypeResponse{}
-	var err error
-
-	sqlstring := `UPDATE tb_StatusType SET dtmDeleted = NOW(), bitIsDeleted = 1, intVersion = ? 
-	WHERE intStatusId = ? AND inbMserviceId = ? AND bitIsDeleted = 0 AND intVersion = ?`
 
-	stmt, err := s.db.Prepare(sqlstring)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
-		return resp, nil
-	}
-
-	defer stmt.Close()
-
-	res, err := stmt.Exec(req.GetVersion()+1, req.GetStatusId(), req.GetMserviceId(), req.GetVersion())
+	err := s.store.Types().DeleteStatusType(ctx, req.GetStatusId(), req.GetMserviceId(), req.GetVersion())
 	if err == nil {
-		rowsAffected, _ := res.RowsAffected()
-		if rowsAffected == 1 {
-			resp.Version = req.GetVersion() + 1
-		} else {
-			resp.ErrorCode = 404
-			resp.ErrorMessage = "not found"
-		}
+		resp.Version = req.GetVersion() + 1
 	} else {
-		resp.ErrorCode = 501
-		resp.ErrorMessage = err.Error()
-		level.Error(s.logger).Log("what", "Exec", "error", err)
-		err = nil
+		gResp := s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
 	}
 
-	return resp, err
+	return resp, nil
 }
 
 // get status type by id
 func (s *projService) GetStatusType(ctx context.Context, req *pb.GetStatusTypeRequest) (*pb.GetStatusTypeResponse, error) {
 	resp := &pb.GetStatusTypeResponse{}
-	var err error
-
-	sqlstring := `SELECT intStatusId, dtmCreated, dtmModified, intVersion, inbMserviceId, chvStatusName, chvDescription
-	FROM tb_StatusType WHERE intStatusId = ? AND inbMserviceId = ? AND bitIsDeleted = 0`
-
-	stmt, err := s.db.Prepare(sqlstring)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
-		return resp, nil
-	}
-
-	defer stmt.Close()
-
-	var created string
-	var modified string
-
-	var statusType pb.StatusType
-
-	err = stmt.QueryRow(req.GetStatusId(), req.GetMserviceId()).Scan(&statusType.StatusId, &created, &modified,
-		&statusType.Version, &statusType.MserviceId, &statusType.StatusName,
-		&statusType.Description)
 
+	statusType, err := s.store.Types().GetStatusType(ctx, req.GetStatusId(), req.GetMserviceId())
 	if err == nil {
-		statusType.Created = dml.DateTimeFromString(created)
-		statusType.Modified = dml.DateTimeFromString(modified)
-
-		resp.ErrorCode = 0
-		resp.StatusType = &statusType
-	} else if err == sql.ErrNoRows {
-		resp.ErrorCode = 404
-		resp.ErrorMessage = "not found"
-		err = nil
+		resp.StatusType = statusType
 	} else {
-		level.Error(s.logger).Log("what", "QueryRow", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = err.Error()
-		err = nil
+		gResp := s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
 	}
 
-	return resp, err
+	return resp, nil
 }
 
 // get all status types for this mservice id
 func (s *projService) GetStatusTypes(ctx context.Context, req *pb.GetStatusTypesRequest) (*pb.GetStatusTypesResponse, error) {
 	resp := &pb.GetStatusTypesResponse{}
-	var err error
-
-	sqlstring := `SELECT intStatusId, dtmCreated, dtmModified, intVersion, inbMserviceId, chvStatusName, chvDescription
-	FROM tb_StatusType WHERE inbMserviceId = ? AND bitIsDeleted = 0`
-
-	stmt, err := s.db.Prepare(sqlstring)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
-		return resp, nil
-	}
-
-	defer stmt.Close()
-
-	rows, err := stmt.Query(req.GetMserviceId())
 
-	if err != nil {
-		level.Error(s.logger).Log("what", "Query", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = err.Error()
-		return resp, nil
-	}
-
-	defer rows.Close()
-	for rows.Next() {
-		var created string
-		var modified string
-		var statusType pb.StatusType
-
-		err := rows.Scan(&statusType.StatusId, &created, &modified, &statusType.Version, &statusType.MserviceId,
-			&statusType.StatusName, &statusType.Description)
-
-		if err != nil {
-			level.Error(s.logger).Log("what", "Scan", "error", err)
-			resp.ErrorCode = 500
-			resp.ErrorMessage = err.Error()
-			return resp, nil
-		}
-
-		statusType.Created = dml.DateTimeFromString(created)
-		statusType.Modified = dml.DateTimeFromString(modified)
-		resp.StatusTypes = append(resp.StatusTypes, &statusType)
+	statusTypes, err := s.store.Types().GetStatusTypes(ctx, req.GetMserviceId())
+	if err == nil {
+		resp.StatusTypes = statusTypes
+	} else {
+		gResp := s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
 	}
 
-	return resp, err
+	return resp, nil
 }
 
 // create a new task
 func (s *projService) CreateTask(ctx context.Context, req *pb.CreateTaskRequest) (*pb.CreateTaskResponse, error) {
 	resp := &pb.CreateTaskResponse{}
 
-	if !nameValidator.MatchString(req.GetName()) {
+	if msg := validateTaskHelper(req.GetName(), req.GetDescription(), req.GetStartDate(), req.GetEndDate()); msg != "" {
 		resp.ErrorCode = 510
-		resp.ErrorMessage = "name invalid format"
+		resp.ErrorMessage = msg
 		return resp, nil
 	}
 
-	desc := strings.TrimSpace(req.GetDescription())
-	if desc == "" {
-		resp.ErrorCode = 510
-		resp.ErrorMessage = "description missing"
-		return resp, nil
-	}
-
-	// make sure project id is valid
-	sqlstring1 := `SELECT inbProjectId FROM tb_Project WHERE inbProjectId = ? AND inbMserviceId = ? AND bitIsDeleted = 0`
-	stmt1, err := s.db.Prepare(sqlstring1)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
-		return resp, nil
-	}
-
-	defer stmt1.Close()
+	task := &pb.Task{MserviceId: req.GetMserviceId(), ProjectId: req.GetProjectId(), Name: req.GetName(),
+		Description: req.GetDescription(), StatusId: req.GetStatusId(), StartDate: req.GetStartDate(),
+		EndDate: req.GetEndDate(), Priority: req.GetPriority(), ParentId: req.GetParentId(), Position: req.GetPosition()}
 
-	var projectId int64
-
-	err = stmt1.QueryRow(req.GetProjectId(), req.GetMserviceId()).Scan(&projectId)
-	if err == nil {
-		// OK
-	} else if err == sql.ErrNoRows {
-		resp.ErrorCode = 404
-		resp.ErrorMessage = "project for task not found"
-		return resp, nil
-	} else {
-		level.Error(s.logger).Log("what", "QueryRow", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = err.Error()
-		return resp, nil
-	}
-
-	if req.GetParentId() != 0 {
-
-		// make sure parent task id is valid
-		sqlstring2 := `SELECT inbTaskId FROM tb_Task WHERE inbTaskId = ? AND inbProjectId = ? AND bitIsDeleted = 0`
-		stmt2, err := s.db.Prepare(sqlstring2)
+	var taskId int64
+	err := s.store.WithTx(ctx, func(tx projstore.Store) error {
+		// make sure project id is valid
+		ok, err := tx.Projects().ProjectExists(ctx, req.GetProjectId(), req.GetMserviceId())
 		if err != nil {
-			level.Error(s.logger).Log("what", "Prepare", "error", err)
-			resp.ErrorCode = 500
-			resp.ErrorMessage = "db.Prepare failed"
-			return resp, nil
+			return err
+		} else if !ok {
+			return &responseError{genericResponse{404, "project for task not found"}}
 		}
 
-		defer stmt2.Close()
-
-		var parentTaskId int64
-		err = stmt2.QueryRow(req.GetParentId(), req.GetProjectId()).Scan(&parentTaskId)
-		if err == nil {
-			// OK
-		} else if err == sql.ErrNoRows {
-			resp.ErrorCode = 404
-			resp.ErrorMessage = "parent task not found"
-			return resp, nil
-		} else {
-			level.Error(s.logger).Log("what", "QueryRow", "error", err)
-			resp.ErrorCode = 500
-			resp.ErrorMessage = err.Error()
-			return resp, nil
+		if req.GetParentId() != 0 {
+			// make sure parent task id is valid
+			ok, err = tx.Tasks().TaskExists(ctx, req.GetParentId(), req.GetProjectId())
+			if err != nil {
+				return err
+			} else if !ok {
+				return &responseError{genericResponse{404, "parent task not found"}}
+			}
 		}
 
-	}
-
-	sqlstring := `INSERT INTO tb_Task (dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId, 
-		inbProjectId, chvName, chvDescription, intStatusId, dtmStartDate, dtmEndDate, intPriority, inbParentId,
-		intPosition) VALUES (NOW(), NOW(), NOW(), 0, 1, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
-	stmt, err := s.db.Prepare(sqlstring)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
-		return resp, nil
-	}
-
-	defer stmt.Close()
-
-	start_date := req.GetStartDate().TimeFromDateTime()
-	end_date := req.GetEndDate().TimeFromDateTime()
-
-	res, err := stmt.Exec(req.GetMserviceId(), req.GetProjectId(), req.GetName(), req.GetDescription(), req.GetStatusId(), start_date,
-		end_date, req.GetPriority(), req.GetParentId(), req.GetPosition())
+		taskId, err = tx.Tasks().CreateTask(ctx, task)
+		return err
+	})
 
 	if err == nil {
-		taskId, err := res.LastInsertId()
-		if err != nil {
-			level.Error(s.logger).Log("what", "LastInsertId", "error", err)
-		} else {
-			level.Debug(s.logger).Log("taskId", taskId)
-		}
+		level.Debug(s.logger).Log("taskId", taskId)
 
 		resp.TaskId = taskId
 		resp.Version = 1
+
+		s.publishEvent(&pb.ProjectEvent{MserviceId: req.GetMserviceId(), ProjectId: req.GetProjectId(),
+			EntityType: EntityTask, Action: ActionCreate, TaskId: taskId, Version: 1, StatusId: req.GetStatusId()})
 	} else {
-		resp.ErrorCode = 501
-		resp.ErrorMessage = err.Error()
-		level.Error(s.logger).Log("what", "Exec", "error", err)
-		err = nil
+		gResp := s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
 	}
 
-	return resp, err
+	return resp, nil
 }
 
 // update an existing task
 func (s *projService) UpdateTask(ctx context.Context, req *pb.UpdateTaskRequest) (*pb.UpdateTaskResponse, error) {
 	resp := &pb.UpdateTaskResponse{}
-	var err error
 
-	if !nameValidator.MatchString(req.GetName()) {
+	if msg := validateTaskHelper(req.GetName(), req.GetDescription(), req.GetStartDate(), req.GetEndDate()); msg != "" {
 		resp.ErrorCode = 510
-		resp.ErrorMessage = "name invalid format"
-		return resp, nil
-	}
-
-	desc := strings.TrimSpace(req.GetDescription())
-	if desc == "" {
-		resp.ErrorCode = 510
-		resp.ErrorMessage = "description missing"
-		return resp, nil
-	}
-
-	sqlstring := `UPDATE tb_Task SET dtmModified = NOW(), intVersion = ?, chvName = ?, chvDescription = ?, intStatusId = ?, 
-	dtmStartDate = ?, dtmEndDate = ?, intPriority = ?, intPosition = ? WHERE inbTaskId = ? AND intVersion = ? 
-	AND inbMserviceId = ? AND bitIsDeleted = 0`
-
-	stmt, err := s.db.Prepare(sqlstring)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
+		resp.ErrorMessage = msg
 		return resp, nil
 	}
 
-	defer stmt.Close()
+	task := &pb.Task{TaskId: req.GetTaskId(), Version: req.GetVersion(), MserviceId: req.GetMserviceId(),
+		Name: req.GetName(), Description: req.GetDescription(), StatusId: req.GetStatusId(), StartDate: req.GetStartDate(),
+		EndDate: req.GetEndDate(), Priority: req.GetPriority(), Position: req.GetPosition()}
 
-	start_date := req.GetStartDate().TimeFromDateTime()
-	end_date := req.GetEndDate().TimeFromDateTime()
+	// the previous status is read with the task locked, so the event has the status this update replaced
+	var projectId int64
+	var previousStatusId int32
+	err := s.store.WithTx(ctx, func(tx projstore.Store) error {
+		var err error
+		projectId, previousStatusId, err = tx.Tasks().GetTaskProject(ctx, req.GetTaskId(), req.GetMserviceId())
+		if err != nil {
+			return err
+		}
 
-	res, err := stmt.Exec(req.GetVersion()+1, req.GetName(), req.GetDescription(), req.GetStatusId(), start_date,
-		end_date, req.GetPriority(), req.GetPosition(), req.GetTaskId(), req.GetVersion(), req.GetMserviceId())
+		return tx.Tasks().UpdateTask(ctx, task)
+	})
 
 	if err == nil {
-		rowsAffected, _ := res.RowsAffected()
-		if rowsAffected == 1 {
-			resp.Version = req.GetVersion() + 1
-		} else {
-			resp.ErrorCode = 404
-			resp.ErrorMessage = "not found"
-		}
+		resp.Version = req.GetVersion() + 1
+
+		s.publishEvent(&pb.ProjectEvent{MserviceId: req.GetMserviceId(), ProjectId: projectId,
+			EntityType: EntityTask, Action: ActionUpdate, TaskId: req.GetTaskId(), Version: resp.GetVersion(),
+			StatusId: req.GetStatusId(), PreviousStatusId: previousStatusId})
 	} else {
-		resp.ErrorCode = 501
-		resp.ErrorMessage = err.Error()
-		level.Error(s.logger).Log("what", "Exec", "error", err)
-		err = nil
+		gResp := s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
 	}
 
-	return resp, err
+	return resp, nil
 }
 
 // delete an existing task
 func (s *projService) DeleteTask(ctx context.Context, req *pb.DeleteTaskRequest) (*pb.DeleteTaskResponse, error) {
 	resp := &pb.DeleteTaskResponse{}
-	var err error
-
-	sqlstring := `UPDATE tb_Task SET dtmDeleted = NOW(), intVersion = ?, bitIsDeleted = 1
-	 WHERE inbTaskId = ? AND intVersion = ? AND inbMserviceId = ? AND bitIsDeleted = 0`
 
-	stmt, err := s.db.Prepare(sqlstring)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
+	gResp, projectId, statusId := s.GetTaskProjectHelper(ctx, req.GetTaskId(), req.GetMserviceId())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
 		return resp, nil
 	}
 
-	defer stmt.Close()
-
-	res, err := stmt.Exec(req.GetVersion()+1, req.GetTaskId(), req.GetVersion(), req.GetMserviceId())
+	err := s.store.Tasks().DeleteTask(ctx, req.GetTaskId(), req.GetMserviceId(), req.GetVersion())
 	if err == nil {
-		rowsAffected, _ := res.RowsAffected()
-		if rowsAffected == 1 {
-			resp.Version = req.GetVersion() + 1
-		} else {
-			resp.ErrorCode = 404
-			resp.ErrorMessage = "not found"
-		}
+		resp.Version = req.GetVersion() + 1
+
+		s.publishEvent(&pb.ProjectEvent{MserviceId: req.GetMserviceId(), ProjectId: projectId,
+			EntityType: EntityTask, Action: ActionDelete, TaskId: req.GetTaskId(), Version: resp.GetVersion(),
+			StatusId: statusId})
 	} else {
-		resp.ErrorCode = 501
-		resp.ErrorMessage = err.Error()
-		level.Error(s.logger).Log("what", "Exec", "error", err)
-		err = nil
+		gResp = s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
 	}
 
-	return resp, err
+	return resp, nil
 }
 
 // get a task by id
 func (s *projService) GetTaskById(ctx context.Context, req *pb.GetTaskByIdRequest) (*pb.GetTaskByIdResponse, error) {
 	resp := &pb.GetTaskByIdResponse{}
 
-	var err error
-
-	sqlstring := `SELECT t.inbTaskId, t.dtmCreated, t.dtmModified, t.intVersion, t.inbMserviceId, t.inbProjectId, t.chvName,
-	t.chvDescription, t.intStatusId, t.dtmStartDate, t.dtmEndDate, t.intPriority, t.inbParentId, t.intPosition, s.chvStatusName 
-	FROM tb_Task AS t 
-	JOIN tb_StatusType AS s ON t.intStatusId = s.intStatusId 
-	WHERE t.inbTaskId = ? AND t.inbMserviceId = ? AND t.bitIsDeleted = 0`
-
-	stmt, err := s.db.Prepare(sqlstring)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
-		return resp, nil
-	}
-
-	defer stmt.Close()
-
-	var created string
-	var modified string
-	var start_date string
-	var end_date string
-
-	var task pb.Task
-
-	err = stmt.QueryRow(req.GetTaskId(), req.GetMserviceId()).Scan(&task.TaskId, &created, &modified, &task.Version, &task.MserviceId,
-		&task.ProjectId, &task.Name, &task.Description, &task.StatusId, &start_date, &end_date, &task.Priority, &task.ParentId,
-		&task.Position, &task.StatusName)
-
+	task, err := s.store.Tasks().GetTaskById(ctx, req.GetTaskId(), req.GetMserviceId())
 	if err == nil {
-		task.Created = dml.DateTimeFromString(created)
-		task.Modified = dml.DateTimeFromString(modified)
-		task.StartDate = dml.DateTimeFromString(start_date)
-		task.EndDate = dml.DateTimeFromString(end_date)
-		resp.ErrorCode = 0
-		resp.Task = &task
-	} else if err == sql.ErrNoRows {
-		resp.ErrorCode = 404
-		resp.ErrorMessage = "not found"
-		err = nil
+		resp.Task = task
 	} else {
-		level.Error(s.logger).Log("what", "QueryRow", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = err.Error()
-		err = nil
+		gResp := s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
 	}
 
-	return resp, err
+	return resp, nil
 }
 
 // get a task with associations by id
 func (s *projService) GetTaskWrapperById(ctx context.Context, req *pb.GetTaskWrapperByIdRequest) (*pb.GetTaskWrapperByIdResponse, error) {
 	resp := &pb.GetTaskWrapperByIdResponse{}
-	var err error
 
-	sqlstring1 := `SELECT inbProjectId FROM tb_Task WHERE inbTaskId = ? AND inbMserviceId = ? AND bitIsDeleted = 0`
-	stmt1, err := s.db.Prepare(sqlstring1)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
-		return resp, nil
-	}
-
-	defer stmt1.Close()
-
-	var existingProjectId int64
-	err = stmt1.QueryRow(req.GetTaskId(), req.GetMserviceId()).Scan(&existingProjectId)
+	existingProjectId, _, err := s.store.Tasks().GetTaskProject(ctx, req.GetTaskId(), req.GetMserviceId())
 	if err != nil {
 		resp.ErrorCode = 404
 		resp.ErrorMessage = "referenced task not found"
 		return resp, nil
 	}
 
-	gResp, wraps := s.GetProjectTaskWrapperHelper(existingProjectId, req.GetMserviceId())
+	gResp, wraps := s.GetProjectTaskWrapperHelper(ctx, existingProjectId, req.GetMserviceId())
 	if gResp.ErrorCode != 0 {
 		resp.ErrorCode = gResp.ErrorCode
 		resp.ErrorMessage = gResp.ErrorMessage
@@ -546,76 +289,58 @@ func (s *projService) GetTaskWrapperById(ctx context.Context, req *pb.GetTaskWra
 		}
 	}
 
-	return resp, err
+	return resp, nil
 }
 
 // reorder the positions of child tasks
 func (s *projService) ReorderChildTasks(ctx context.Context, req *pb.ReorderChildTasksRequest) (*pb.ReorderChildTasksResponse, error) {
 	resp := &pb.ReorderChildTasksResponse{}
-	var err error
-
-	sqlstring := `UPDATE tb_Task SET dtmModified = NOW(), intVersion = ?
-	 WHERE inbTaskId = ? AND intVersion = ? AND inbMserviceId = ? AND bitIsDeleted = 0`
 
-	stmt, err := s.db.Prepare(sqlstring)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
-		return resp, nil
-	}
+	// the parent and its children are updated together, or not at all
+	var projectId int64
+	var statusId int32
+	err := s.store.WithTx(ctx, func(tx projstore.Store) error {
+		var err error
+		projectId, statusId, err = tx.Tasks().GetTaskProject(ctx, req.GetTaskId(), req.GetMserviceId())
+		if err != nil {
+			return err
+		}
 
-	defer stmt.Close()
+		err = tx.Tasks().TouchTask(ctx, req.GetTaskId(), req.GetMserviceId(), req.GetVersion())
+		if err != nil {
+			return err
+		}
 
-	res, err := stmt.Exec(req.GetVersion()+1, req.GetTaskId(), req.GetVersion(), req.GetMserviceId())
-	if err == nil {
-		rowsAffected, _ := res.RowsAffected()
-		if rowsAffected == 1 {
-			resp.Version = req.GetVersion() + 1
-		} else {
-			resp.ErrorCode = 404
-			resp.ErrorMessage = "not found"
-			return resp, nil
+		for pos, childId := range req.GetChildTaskIds() {
+			err = tx.Tasks().SetTaskPosition(ctx, childId, req.GetTaskId(), req.GetMserviceId(), int32(pos+1))
+			if err != nil {
+				return err
+			}
 		}
-	} else {
-		resp.ErrorCode = 501
-		resp.ErrorMessage = err.Error()
-		level.Error(s.logger).Log("what", "Exec", "error", err)
-		return resp, nil
-	}
 
-	sqlstring1 := `UPDATE tb_Task SET dtmModified = NOW(), intVersion = intVersion + 1, intPosition = ?
-	WHERE inbTaskId = ? AND inbMserviceId = ? AND bitIsDeleted = 0 AND inbParentId = ?`
+		return nil
+	})
 
-	stmt1, err := s.db.Prepare(sqlstring1)
 	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
+		gResp := s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
 		return resp, nil
 	}
 
-	defer stmt1.Close()
-
-	for pos, childId := range req.GetChildTaskIds() {
-		// s.logger.Printf("childId: %d, taskId: %d, mservice: %d, pos: %d\n", childId, req.GetMserviceId(), req.GetTaskId(), pos)
-		res, err := stmt1.Exec(pos+1, childId, req.GetMserviceId(), req.GetTaskId())
-		if err == nil {
-			rowsAffected, _ := res.RowsAffected()
-			if rowsAffected != 1 {
-				resp.ErrorCode = 404
-				resp.ErrorMessage = "not found"
-				return resp, nil
-			}
-		} else {
-			resp.ErrorCode = 501
-			resp.ErrorMessage = err.Error()
-			level.Error(s.logger).Log("what", "Exec", "error", err)
-			return resp, nil
-		}
+	resp.Version = req.GetVersion() + 1
+
+	s.publishEvent(&pb.ProjectEvent{MserviceId: req.GetMserviceId(), ProjectId: projectId,
+		EntityType: EntityTask, Action: ActionUpdate, TaskId: req.GetTaskId(), Version: resp.GetVersion(),
+		StatusId: statusId, PreviousStatusId: statusId})
+
+	for _, childId := range req.GetChildTaskIds() {
+		// child version is bumped in place, so it is not known here
+		s.publishEvent(&pb.ProjectEvent{MserviceId: req.GetMserviceId(), ProjectId: projectId,
+			EntityType: EntityTask, Action: ActionUpdate, TaskId: childId})
 	}
 
-	return resp, err
+	return resp, nil
 }
 
 // get list of tasks in project
@@ -623,7 +348,7 @@ func (s *projService) GetTasksByProject(ctx context.Context, req *pb.GetTasksByP
 	resp := &pb.GetTasksByProjectResponse{}
 	var err error
 
-	gResp, tasks := s.GetProjectTasksHelper(req.GetProjectId(), req.GetMserviceId())
+	gResp, tasks := s.GetProjectTasksHelper(ctx, req.GetProjectId(), req.GetMserviceId())
 
 	resp.ErrorCode = gResp.ErrorCode
 	resp.ErrorMessage = gResp.ErrorMessage
@@ -15,179 +15,148 @@ package projservice
 
 import (
 	"context"
-	"database/sql"
 	"strings"
 	"time"
 
 	"github.com/go-kit/kit/log/level"
 
-	"github.com/gaterace/dml-go/pkg/dml"
-
-	_ "github.com/go-sql-driver/mysql"
+	sdec "github.com/shopspring/decimal"
 
 	pb "github.com/gaterace/mproject/pkg/mserviceproject"
+	"github.com/gaterace/mproject/pkg/projstore"
 )
 
 // create a new team member for the project
 func (s *projService) CreateTeamMember(ctx context.Context, req *pb.CreateTeamMemberRequest) (*pb.CreateTeamMemberResponse, error) {
 	resp := &pb.CreateTeamMemberResponse{}
-	var err error
 
-	sqlstring := `INSERT INTO tb_TeamMember (dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId, inbProjectId,
-		chvName, intProjectRoleId, chvEmail) VALUES(NOW(), NOW(), NOW(), 0, 1, ?, ?, ?, ?, ?)`
+	// a team member is the membership of a person in the project
+	gResp, person := s.ResolvePersonHelper(ctx, req.GetPersonId(), req.GetName(), req.GetEmail(), req.GetMserviceId())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
 
-	stmt, err := s.db.Prepare(sqlstring)
+	isMember, err := s.store.Members().PersonIsMember(ctx, req.GetProjectId(), person.GetPersonId(), req.GetMserviceId())
 	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
+		gResp = s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	if isMember {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "person is already a team member of the project"
 		return resp, nil
 	}
 
-	defer stmt.Close()
+	member := &pb.TeamMember{MserviceId: req.GetMserviceId(), ProjectId: req.GetProjectId(), Name: person.GetName(),
+		ProjectRoleId: req.GetProjectRoleId(), Email: person.GetEmail(), PersonId: person.GetPersonId()}
 
-	res, err := stmt.Exec(req.GetMserviceId(), req.GetProjectId(), req.GetName(), req.GetProjectRoleId(), req.GetEmail())
+	memberId, err := s.store.Members().CreateMember(ctx, member)
 	if err == nil {
-		memberId, err := res.LastInsertId()
-		if err != nil {
-			level.Error(s.logger).Log("what", "LastInsertId", "error", err)
-		} else {
-			level.Debug(s.logger).Log("memberId", memberId)
-		}
+		level.Debug(s.logger).Log("memberId", memberId)
 
 		resp.MemberId = memberId
 		resp.Version = 1
+
+		s.publishEvent(&pb.ProjectEvent{MserviceId: req.GetMserviceId(), ProjectId: req.GetProjectId(),
+			EntityType: EntityMember, Action: ActionCreate, MemberId: memberId, Version: 1})
 	} else {
-		resp.ErrorCode = 501
-		resp.ErrorMessage = err.Error()
-		level.Error(s.logger).Log("what", "Exec", "error", err)
-		err = nil
+		gResp = s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
 	}
 
-	return resp, err
+	return resp, nil
 }
 
 // update an existing team member
 func (s *projService) UpdateTeamMember(ctx context.Context, req *pb.UpdateTeamMemberRequest) (*pb.UpdateTeamMemberResponse, error) {
 	resp := &pb.UpdateTeamMemberResponse{}
-	var err error
 
-	sqlstring := `UPDATE tb_TeamMember SET dtmModified = NOW(), intVersion = ?, chvName = ?, intProjectRoleId = ?, chvEmail = ?
-	WHERE inbMemberId = ? AND inbMserviceId = ? AND intVersion = ? AND bitIsDeleted = 0`
+	var projectId int64
+	err := s.store.WithTx(ctx, func(tx projstore.Store) error {
+		var personId int64
+		var err error
+		projectId, personId, err = tx.Members().GetMemberProject(ctx, req.GetMemberId(), req.GetMserviceId())
+		if err != nil {
+			return err
+		}
+
+		member := &pb.TeamMember{MemberId: req.GetMemberId(), Version: req.GetVersion(), MserviceId: req.GetMserviceId(),
+			Name: req.GetName(), ProjectRoleId: req.GetProjectRoleId(), Email: normalizeEmail(req.GetEmail())}
 
-	stmt, err := s.db.Prepare(sqlstring)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
-		return resp, nil
-	}
+		err = tx.Members().UpdateMember(ctx, member)
+		if err != nil {
+			return err
+		}
 
-	defer stmt.Close()
+		// name and email belong to the person, so carry them to the other memberships of the person
+		err = tx.Persons().UpdatePersonFromMember(ctx, req.GetMemberId(), personId, req.GetMserviceId())
+		if err != nil {
+			return err
+		}
 
-	res, err := stmt.Exec(req.GetVersion()+1, req.GetName(), req.GetProjectRoleId(), req.GetEmail(), req.GetMemberId(),
-		req.GetMserviceId(), req.GetVersion())
+		return tx.Persons().SyncPersonMembers(ctx, personId, req.GetMserviceId())
+	})
 
 	if err == nil {
-		rowsAffected, _ := res.RowsAffected()
-		if rowsAffected == 1 {
-			resp.Version = req.GetVersion() + 1
-		} else {
-			resp.ErrorCode = 404
-			resp.ErrorMessage = "not found"
-		}
+		resp.Version = req.GetVersion() + 1
+
+		s.publishEvent(&pb.ProjectEvent{MserviceId: req.GetMserviceId(), ProjectId: projectId,
+			EntityType: EntityMember, Action: ActionUpdate, MemberId: req.GetMemberId(), Version: resp.GetVersion()})
 	} else {
-		resp.ErrorCode = 501
-		resp.ErrorMessage = err.Error()
-		level.Error(s.logger).Log("what", "Exec", "error", err)
-		err = nil
+		gResp := s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
 	}
 
-	return resp, err
+	return resp, nil
 }
 
 // delete an existing team member
 func (s *projService) DeleteTeamMember(ctx context.Context, req *pb.DeleteTeamMemberRequest) (*pb.DeleteTeamMemberResponse, error) {
 	resp := &pb.DeleteTeamMemberResponse{}
-	var err error
 
-	sqlstring := `UPDATE tb_TeamMember SET dtmDeleted = NOW(), intVersion = ?, bitIsDeleted = 1
-	WHERE inbMemberId = ? AND inbMserviceId = ? AND intVersion = ? AND bitIsDeleted = 0`
-
-	stmt, err := s.db.Prepare(sqlstring)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
+	gResp, projectId := s.GetMemberProjectHelper(ctx, req.GetMemberId(), req.GetMserviceId())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
 		return resp, nil
 	}
 
-	defer stmt.Close()
-
-	res, err := stmt.Exec(req.GetVersion()+1, req.GetMemberId(), req.GetMserviceId(), req.GetVersion())
+	err := s.store.Members().DeleteMember(ctx, req.GetMemberId(), req.GetMserviceId(), req.GetVersion())
 	if err == nil {
-		rowsAffected, _ := res.RowsAffected()
-		if rowsAffected == 1 {
-			resp.Version = req.GetVersion() + 1
-		} else {
-			resp.ErrorCode = 404
-			resp.ErrorMessage = "not found"
-		}
+		resp.Version = req.GetVersion() + 1
+
+		s.publishEvent(&pb.ProjectEvent{MserviceId: req.GetMserviceId(), ProjectId: projectId,
+			EntityType: EntityMember, Action: ActionDelete, MemberId: req.GetMemberId(), Version: resp.GetVersion()})
 	} else {
-		resp.ErrorCode = 501
-		resp.ErrorMessage = err.Error()
-		level.Error(s.logger).Log("what", "Exec", "error", err)
-		err = nil
+		gResp = s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
 	}
 
-	return resp, err
+	return resp, nil
 }
 
 // get team member by id
 func (s *projService) GetTeamMemberById(ctx context.Context, req *pb.GetTeamMemberByIdRequest) (*pb.GetTeamMemberByIdResponse, error) {
 	resp := &pb.GetTeamMemberByIdResponse{}
-	var err error
-
-	sqlstring := `SELECT m.inbMemberId, m.dtmCreated, m.dtmModified, m.intVersion, m.inbMserviceId, m.inbProjectId, m.chvName,
-	m.intProjectRoleId, m.chvEmail, r.chvRoleName
-	FROM tb_TeamMember AS m
-	JOIN tb_ProjectRoleType AS r ON m.intProjectRoleId = r.intProjectRoleId
-	WHERE m.inbMemberId = ? AND m.inbMserviceId = ? AND m.bitIsDeleted = 0`
-
-	stmt, err := s.db.Prepare(sqlstring)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
-		return resp, nil
-	}
-
-	defer stmt.Close()
-
-	var created string
-	var modified string
-	var member pb.TeamMember
-
-	err = stmt.QueryRow(req.GetMemberId(), req.GetMserviceId()).Scan(&member.MemberId, &created, &modified, &member.Version,
-		&member.MserviceId, &member.ProjectId, &member.Name, &member.ProjectRoleId, &member.Email, &member.RoleName)
 
+	member, err := s.store.Members().GetMemberById(ctx, req.GetMemberId(), req.GetMserviceId())
 	if err == nil {
-		member.Created = dml.DateTimeFromString(created)
-		member.Modified = dml.DateTimeFromString(modified)
-		resp.ErrorCode = 0
-		resp.TeamMember = &member
-	} else if err == sql.ErrNoRows {
-		resp.ErrorCode = 404
-		resp.ErrorMessage = "not found"
-		err = nil
+		resp.TeamMember = member
 	} else {
-		level.Error(s.logger).Log("what", "QueryRow", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = err.Error()
-		err = nil
+		gResp := s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
 	}
 
-	return resp, err
+	return resp, nil
 }
 
 // get team members by project
@@ -195,7 +164,7 @@ func (s *projService) GetTeamMemberByProject(ctx context.Context, req *pb.GetTea
 	resp := &pb.GetTeamMemberByProjectResponse{}
 	var err error
 
-	gResp, members := s.GetTeamMembersHelper(req.GetProjectId(), req.GetMserviceId())
+	gResp, members := s.GetTeamMembersHelper(ctx, req.GetProjectId(), req.GetMserviceId())
 	resp.ErrorCode = gResp.ErrorCode
 	resp.ErrorMessage = gResp.ErrorMessage
 	if gResp.ErrorCode == 0 {
@@ -208,235 +177,89 @@ func (s *projService) GetTeamMemberByProject(ctx context.Context, req *pb.GetTea
 // get team members by task
 func (s *projService) GetTeamMemberByTask(ctx context.Context, req *pb.GetTeamMemberByTaskRequest) (*pb.GetTeamMemberByTaskResponse, error) {
 	resp := &pb.GetTeamMemberByTaskResponse{}
-	var err error
 
-	sqlstring1 := `SELECT inbProjectId FROM tb_Task WHERE inbTaskId = ? AND inbMserviceId = ? AND bitIsDeleted = 0`
-	stmt1, err := s.db.Prepare(sqlstring1)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
-		return resp, nil
-	}
-
-	defer stmt1.Close()
-
-	var existingProjectId int64
-	err = stmt1.QueryRow(req.GetTaskId(), req.GetMserviceId()).Scan(&existingProjectId)
+	existingProjectId, _, err := s.store.Tasks().GetTaskProject(ctx, req.GetTaskId(), req.GetMserviceId())
 	if err != nil {
 		resp.ErrorCode = 404
 		resp.ErrorMessage = "referenced task not found"
 		return resp, nil
 	}
 
-	sqlstring := `SELECT m.inbMemberId,  m.dtmCreated, m.dtmModified, m.intVersion,
-	m.inbMserviceId, m.inbProjectId, m.chvName, m.intProjectRoleId, m.chvEmail, t.decTaskHours, r.chvRoleName 
-	FROM tb_TaskToMember AS t 
-	JOIN tb_TeamMember AS m ON t.inbMemberId = m.inbMemberId
-	JOIN tb_ProjectRoleType AS r ON m.intProjectRoleId = r.intProjectRoleId
-	WHERE t.inbProjectId = ? AND t.inbTaskId= ? AND t.inbMserviceId = ?
-	AND t.bitIsDeleted = 0 AND m.bitIsDeleted = 0`
-	stmt, err := s.db.Prepare(sqlstring)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
-		return resp, nil
-	}
-
-	defer stmt.Close()
-	rows, err := stmt.Query(existingProjectId, req.GetTaskId(), req.GetMserviceId())
-	if err != nil {
-		level.Error(s.logger).Log("what", "Query", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = err.Error()
-		return resp, nil
-	}
-
-	defer rows.Close()
-	for rows.Next() {
-		var created string
-		var modified string
-		var task_hours string
-		var member pb.TeamMember
-
-		err := rows.Scan(&member.MemberId, &created, &modified, &member.Version, &member.MserviceId, &member.ProjectId,
-			&member.Name, &member.ProjectRoleId, &member.Email, &task_hours, &member.RoleName)
-
-		if err != nil {
-			level.Error(s.logger).Log("what", "Scan", "error", err)
-			resp.ErrorCode = 500
-			resp.ErrorMessage = err.Error()
-			return resp, nil
-		}
-
-		member.Created = dml.DateTimeFromString(created)
-		member.Modified = dml.DateTimeFromString(modified)
-		d, err := dml.DecimalFromString(task_hours)
-		if err == nil {
-			member.TaskHours = d
-		}
-
-		resp.TeamMembers = append(resp.TeamMembers, &member)
+	members, err := s.store.Members().GetMembersByTask(ctx, existingProjectId, req.GetTaskId(), req.GetMserviceId())
+	if err == nil {
+		resp.TeamMembers = members
+	} else {
+		gResp := s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
 	}
 
-	return resp, err
+	return resp, nil
 }
 
 // add a team member to a task
 func (s *projService) AddTeamMemberToTask(ctx context.Context, req *pb.AddTeamMemberToTaskRequest) (*pb.AddTeamMemberToTaskResponse, error) {
 	resp := &pb.AddTeamMemberToTaskResponse{}
-	var err error
-
-	// make sure that refered task is in this mservice id
-	sqlstring1 := `SELECT inbProjectId FROM tb_Task WHERE inbTaskId = ? AND inbMserviceId = ? AND bitIsDeleted = 0`
-	stmt1, err := s.db.Prepare(sqlstring1)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
-		return resp, nil
-	}
-
-	defer stmt1.Close()
 
 	var existingProjectId int64
-	err = stmt1.QueryRow(req.GetTaskId(), req.GetMserviceId()).Scan(&existingProjectId)
-	if err != nil {
-		resp.ErrorCode = 404
-		resp.ErrorMessage = "referenced task not found"
-		return resp, nil
-	}
-
-	// make sure that refered team member is in this mservice id
-	sqlstring2 := `SELECT inbMemberId FROM tb_TeamMember WHERE inbMemberId = ? AND inbMserviceId = ? AND bitIsDeleted = 0`
-	stmt2, err := s.db.Prepare(sqlstring2)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
-		return resp, nil
-	}
-
-	defer stmt2.Close()
-
-	var existingMemberId int64
-
-	err = stmt2.QueryRow(req.GetMemberId(), req.GetMserviceId()).Scan(&existingMemberId)
-
-	if err != nil {
-		resp.ErrorCode = 404
-		resp.ErrorMessage = "referenced member not found"
-		return resp, nil
-	}
-
-	sqlstring3 := `INSERT INTO tb_TaskToMember
-	(inbProjectId, inbTaskId, inbMemberId, dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, inbMserviceId, decTaskHours)
-	VALUES (?, ?, ?, NOW(), NOW(), NOW(), 0, ?, 0.0)`
-	stmt3, err := s.db.Prepare(sqlstring3)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
-		return resp, nil
-	}
-
-	defer stmt3.Close()
-
-	_, err = stmt3.Exec(existingProjectId, req.GetTaskId(), req.GetMemberId(), req.GetMserviceId())
-	if err == nil {
-		// OK
-		return resp, nil
-	}
-
-	// might have previously deleted, reuse
-	sqlstring4 := `UPDATE tb_TaskToMember SET dtmCreated = NOW(), dtmModified = NOW(), dtmDeleted = NOW(),
-	bitIsDeleted = 0, decTaskHours = 0.0 WHERE inbProjectId = ? AND inbTaskId = ? AND inbMemberId = ? AND inbMserviceId = ?
-	AND bitIsDeleted = 1`
-
-	stmt4, err := s.db.Prepare(sqlstring4)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
-		return resp, nil
-	}
+	err := s.store.WithTx(ctx, func(tx projstore.Store) error {
+		// make sure that refered task is in this mservice id
+		var err error
+		existingProjectId, _, err = tx.Tasks().GetTaskProject(ctx, req.GetTaskId(), req.GetMserviceId())
+		if err != nil {
+			return &responseError{genericResponse{404, "referenced task not found"}}
+		}
 
-	defer stmt4.Close()
+		// make sure that refered team member is in the project of the task
+		ok, err := tx.Members().MemberExists(ctx, req.GetMemberId(), existingProjectId, req.GetMserviceId())
+		if (err != nil) || !ok {
+			return &responseError{genericResponse{404, "referenced member not found"}}
+		}
 
-	res, err := stmt4.Exec(existingProjectId, req.GetTaskId(), req.GetMemberId(), req.GetMserviceId())
+		resp.Version, err = tx.Assignments().AddAssignment(ctx, existingProjectId, req.GetTaskId(), req.GetMemberId(),
+			req.GetMserviceId())
+		return err
+	})
 
 	if err == nil {
-		rowsAffected, _ := res.RowsAffected()
-		if rowsAffected != 1 {
-			resp.ErrorCode = 404
-			resp.ErrorMessage = "not found"
-		}
+		s.publishEvent(&pb.ProjectEvent{MserviceId: req.GetMserviceId(), ProjectId: existingProjectId,
+			EntityType: EntityAssignment, Action: ActionCreate, TaskId: req.GetTaskId(), MemberId: req.GetMemberId(),
+			Version: resp.GetVersion()})
 	} else {
-		resp.ErrorCode = 501
-		resp.ErrorMessage = err.Error()
-		level.Error(s.logger).Log("what", "Exec", "error", err)
-		err = nil
+		resp.Version = 0
+		gResp := s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
 	}
 
-	return resp, err
-
+	return resp, nil
 }
 
 // remove a team member from a task
 func (s *projService) RemoveTeamMemberFromTask(ctx context.Context, req *pb.RemoveTeamMemberFromTaskRequest) (*pb.RemoveTeamMemberFromTaskResponse, error) {
 	resp := &pb.RemoveTeamMemberFromTaskResponse{}
-	var err error
-
-	sqlstring1 := `SELECT inbProjectId FROM tb_Task WHERE inbTaskId = ? AND inbMserviceId = ? AND bitIsDeleted = 0`
-	stmt1, err := s.db.Prepare(sqlstring1)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
-		return resp, nil
-	}
-
-	defer stmt1.Close()
 
-	var existingProjectId int64
-	err = stmt1.QueryRow(req.GetTaskId(), req.GetMserviceId()).Scan(&existingProjectId)
+	existingProjectId, _, err := s.store.Tasks().GetTaskProject(ctx, req.GetTaskId(), req.GetMserviceId())
 	if err != nil {
 		resp.ErrorCode = 404
 		resp.ErrorMessage = "referenced task not found"
 		return resp, nil
 	}
 
-	sqlstring := `UPDATE tb_TaskToMember SET dtmDeleted = NOW(),
-	bitIsDeleted = 1, decTaskHours = 0.0 WHERE inbProjectId = ? AND inbTaskId = ? AND inbMemberId = ? AND inbMserviceId = ?
-	AND bitIsDeleted = 0`
-
-	stmt, err := s.db.Prepare(sqlstring)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
-		return resp, nil
-	}
-
-	defer stmt.Close()
-
-	res, err := stmt.Exec(existingProjectId, req.GetTaskId(), req.GetMemberId(), req.GetMserviceId())
-
+	err = s.store.Assignments().RemoveAssignment(ctx, existingProjectId, req.GetTaskId(), req.GetMemberId(),
+		req.GetMserviceId(), req.GetVersion())
 	if err == nil {
-		rowsAffected, _ := res.RowsAffected()
-		if rowsAffected != 1 {
-			resp.ErrorCode = 404
-			resp.ErrorMessage = "not found"
-		}
+		resp.Version = req.GetVersion() + 1
+		s.publishEvent(&pb.ProjectEvent{MserviceId: req.GetMserviceId(), ProjectId: existingProjectId,
+			EntityType: EntityAssignment, Action: ActionDelete, TaskId: req.GetTaskId(), MemberId: req.GetMemberId(),
+			Version: resp.GetVersion()})
 	} else {
-		resp.ErrorCode = 501
-		resp.ErrorMessage = err.Error()
-		level.Error(s.logger).Log("what", "Exec", "error", err)
-		err = nil
+		gResp := s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
 	}
 
-	return resp, err
+	return resp, nil
 }
 
 // add to existing task hours for task and member
@@ -444,55 +267,79 @@ func (s *projService) AddTaskHours(ctx context.Context, req *pb.AddTaskHoursRequ
 	resp := &pb.AddTaskHoursResponse{}
 	var err error
 
-	sqlstring1 := `SELECT inbProjectId FROM tb_Task WHERE inbTaskId = ? AND inbMserviceId = ? AND bitIsDeleted = 0`
-	stmt1, err := s.db.Prepare(sqlstring1)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
+	// negative hours correct earlier entries; team members recording their own hours are limited to positive hours
+	// by authorization
+	if req.GetTaskHours() != nil {
+		_, err = req.GetTaskHours().ConvertDecimal()
+	}
+	if (req.GetTaskHours() == nil) || (err != nil) {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "task_hours missing"
 		return resp, nil
 	}
 
-	defer stmt1.Close()
-
-	var existingProjectId int64
-	err = stmt1.QueryRow(req.GetTaskId(), req.GetMserviceId()).Scan(&existingProjectId)
+	existingProjectId, _, err := s.store.Tasks().GetTaskProject(ctx, req.GetTaskId(), req.GetMserviceId())
 	if err != nil {
 		resp.ErrorCode = 404
 		resp.ErrorMessage = "referenced task not found"
 		return resp, nil
 	}
 
-	sqlstring := `UPDATE tb_TaskToMember SET dtmModified = NOW(),
-	decTaskHours = decTaskHours + ? WHERE inbProjectId = ? AND inbTaskId = ? AND inbMemberId = ? AND inbMserviceId = ?
-	AND bitIsDeleted = 0`
+	err = s.store.Assignments().AddTaskHours(ctx, existingProjectId, req.GetTaskId(), req.GetMemberId(),
+		req.GetMserviceId(), req.GetVersion(), req.GetTaskHours())
+	if err == nil {
+		resp.Version = req.GetVersion() + 1
+		s.publishEvent(&pb.ProjectEvent{MserviceId: req.GetMserviceId(), ProjectId: existingProjectId,
+			EntityType: EntityAssignment, Action: ActionUpdate, TaskId: req.GetTaskId(), MemberId: req.GetMemberId(),
+			Version: resp.GetVersion(), TaskHours: req.GetTaskHours()})
+	} else {
+		gResp := s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+	}
 
-	stmt, err := s.db.Prepare(sqlstring)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
+	return resp, nil
+}
+
+// replace the task hours for task and member
+func (s *projService) SetTaskHours(ctx context.Context, req *pb.SetTaskHoursRequest) (*pb.SetTaskHoursResponse, error) {
+	resp := &pb.SetTaskHoursResponse{}
+	var err error
+
+	var taskHours sdec.Decimal
+	if req.GetTaskHours() != nil {
+		taskHours, err = req.GetTaskHours().ConvertDecimal()
+	}
+	if (req.GetTaskHours() == nil) || (err != nil) || taskHours.IsNegative() {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "task_hours missing or negative"
 		return resp, nil
 	}
 
-	defer stmt.Close()
-
-	res, err := stmt.Exec(req.GetTaskHours().StringFromDecimal(), existingProjectId, req.GetTaskId(), req.GetMemberId(), req.GetMserviceId())
+	gResp, projectId, _ := s.GetTaskProjectHelper(ctx, req.GetTaskId(), req.GetMserviceId())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = "referenced task not found"
+		return resp, nil
+	}
 
+	err = s.store.Assignments().SetTaskHours(ctx, projectId, req.GetTaskId(), req.GetMemberId(), req.GetMserviceId(),
+		req.GetVersion(), req.GetTaskHours())
 	if err == nil {
-		rowsAffected, _ := res.RowsAffected()
-		if rowsAffected != 1 {
-			resp.ErrorCode = 404
-			resp.ErrorMessage = "not found"
-		}
+		resp.Version = req.GetVersion() + 1
+		resp.TaskHours = req.GetTaskHours()
+
+		// not reported as task hours added, since the hours replace the previous total
+		s.publishEvent(&pb.ProjectEvent{MserviceId: req.GetMserviceId(), ProjectId: projectId,
+			EntityType: EntityAssignment, Action: ActionUpdate, TaskId: req.GetTaskId(), MemberId: req.GetMemberId(),
+			Version: resp.GetVersion()})
 	} else {
-		resp.ErrorCode = 501
-		resp.ErrorMessage = err.Error()
-		level.Error(s.logger).Log("what", "Exec", "error", err)
-		err = nil
+		gResp = s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
 	}
 
-	return resp, err
+	return resp, nil
 }
 
 // create a new project role type
@@ -511,203 +358,123 @@ func (s *projService) CreateProjectRoleType(ctx context.Context, req *pb.CreateP
 		return resp, nil
 	}
 
-	sqlstring := `INSERT INTO tb_ProjectRoleType
-	(intProjectRoleId, dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId, chvRoleName, chvDescription)
-	VALUES(?, NOW(), NOW(), NOW(), 0, 1, ?, ?, ?)`
-
-	stmt, err := s.db.Prepare(sqlstring)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
-		return resp, nil
-	}
-
-	defer stmt.Close()
+	role := &pb.ProjectRoleType{ProjectRoleId: req.GetProjectRoleId(), MserviceId: req.GetMserviceId(),
+		RoleName: req.GetRoleName(), Description: desc}
 
-	_, err = stmt.Exec(req.GetProjectRoleId(), req.GetMserviceId(), req.GetRoleName(), desc)
+	err := s.store.Types().CreateRoleType(ctx, role)
 	if err == nil {
 		resp.Version = 1
 	} else {
-		resp.ErrorCode = 501
-		resp.ErrorMessage = err.Error()
-		level.Error(s.logger).Log("what", "Exec", "error", err)
-		err = nil
+		gResp := s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
 	}
 
-	return resp, err
+	return resp, nil
 }
 
 // update an existing project role type
 func (s *projService) UpdateProjectRoleType(ctx context.Context, req *pb.UpdateProjectRoleTypeRequest) (*pb.UpdateProjectRoleTypeResponse, error) {
 	resp := &pb.UpdateProjectRoleTypeResponse{}
-	var err error
 
-	sqlstring := `UPDATE tb_ProjectRoleType SET dtmModified = NOW(), intVersion = ?, chvRoleName = ?, chvDescription = ?
-	WHERE  intProjectRoleId = ? AND inbMserviceId = ? AND intVersion = ? AND bitIsDeleted = 0`
-
-	stmt, err := s.db.Prepare(sqlstring)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
-		return resp, nil
-	}
-
-	defer stmt.Close()
-
-	res, err := stmt.Exec(req.GetVersion()+1, req.GetRoleName(), req.GetDescription(), req.GetProjectRoleId(),
-		req.GetMserviceId(), req.GetVersion())
+	role := &pb.ProjectRoleType{ProjectRoleId: req.GetProjectRoleId(), Version: req.GetVersion(),
+		MserviceId: req.GetMserviceId(), RoleName: req.GetRoleName(), Description: req.GetDescription()}
 
+	err := s.store.Types().UpdateRoleType(ctx, role)
 	if err == nil {
-		rowsAffected, _ := res.RowsAffected()
-		if rowsAffected == 1 {
-			resp.Version = req.GetVersion() + 1
-		} else {
-			resp.ErrorCode = 404
-			resp.ErrorMessage = "not found"
-		}
+		resp.Version = req.GetVersion() + 1
 	} else {
-		resp.ErrorCode = 501
-		resp.ErrorMessage = err.Error()
-		level.Error(s.logger).Log("what", "Exec", "error", err)
-		err = nil
+		gResp := s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
 	}
 
-	return resp, err
+	return resp, nil
 }
 
 // delete an existing project role type
 func (s *projService) DeleteProjectRoleType(ctx context.Context, req *pb.DeleteProjectRoleTypeRequest) (*pb.DeleteProjectRoleTypeResponse, error) {
 	resp := &pb.DeleteProjectRoleTypeResponse{}
-	var err error
-
-	sqlstring := `UPDATE tb_ProjectRoleType SET dtmDeleted = NOW(), intVersion = ?, bitIsDeleted = 1
-	WHERE  intProjectRoleId = ? AND inbMserviceId = ? AND intVersion = ? AND bitIsDeleted = 0`
-
-	stmt, err := s.db.Prepare(sqlstring)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
-		return resp, nil
-	}
-
-	defer stmt.Close()
-
-	res, err := stmt.Exec(req.GetVersion()+1, req.GetProjectRoleId(), req.GetMserviceId(), req.GetVersion())
 
+	err := s.store.Types().DeleteRoleType(ctx, req.GetProjectRoleId(), req.GetMserviceId(), req.GetVersion())
 	if err == nil {
-		rowsAffected, _ := res.RowsAffected()
-		if rowsAffected == 1 {
-			resp.Version = req.GetVersion() + 1
-		} else {
-			resp.ErrorCode = 404
-			resp.ErrorMessage = "not found"
-		}
+		resp.Version = req.GetVersion() + 1
 	} else {
-		resp.ErrorCode = 501
-		resp.ErrorMessage = err.Error()
-		level.Error(s.logger).Log("what", "Exec", "error", err)
-		err = nil
+		gResp := s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
 	}
 
-	return resp, err
+	return resp, nil
 }
 
 // get a project role type by id
 func (s *projService) GetProjectRoleType(ctx context.Context, req *pb.GetProjectRoleTypeRequest) (*pb.GetProjectRoleTypeResponse, error) {
 	resp := &pb.GetProjectRoleTypeResponse{}
-	var err error
-
-	sqlstring := `SELECT intProjectRoleId, dtmCreated, dtmModified, intVersion, inbMserviceId, chvRoleName, chvDescription
-	FROM tb_ProjectRoleType WHERE intProjectRoleId = ? AND inbMserviceId = ? AND bitIsDeleted = 0`
-
-	stmt, err := s.db.Prepare(sqlstring)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
-		return resp, nil
-	}
-
-	defer stmt.Close()
-
-	var created string
-	var modified string
-	var role pb.ProjectRoleType
-
-	err = stmt.QueryRow(req.GetProjectRoleId(), req.GetMserviceId()).Scan(&role.ProjectRoleId, &created, &modified, &role.Version,
-		&role.MserviceId, &role.RoleName, &role.Description)
 
+	role, err := s.store.Types().GetRoleType(ctx, req.GetProjectRoleId(), req.GetMserviceId())
 	if err == nil {
-		role.Created = dml.DateTimeFromString(created)
-		role.Modified = dml.DateTimeFromString(modified)
-
-		resp.ErrorCode = 0
-		resp.RoleType = &role
-	} else if err == sql.ErrNoRows {
-		resp.ErrorCode = 404
-		resp.ErrorMessage = "not found"
-		err = nil
+		resp.RoleType = role
 	} else {
-		level.Error(s.logger).Log("what", "QueryRow", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = err.Error()
-		err = nil
+		gResp := s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
 	}
 
-	return resp, err
+	return resp, nil
 }
 
 // get all project role types for an mservice id
 func (s *projService) GetProjectRoleTypes(ctx context.Context, req *pb.GetProjectRoleTypesRequest) (*pb.GetProjectRoleTypesResponse, error) {
 	resp := &pb.GetProjectRoleTypesResponse{}
-	var err error
 
-	sqlstring := `SELECT intProjectRoleId, dtmCreated, dtmModified, intVersion, inbMserviceId, chvRoleName, chvDescription
-	FROM tb_ProjectRoleType WHERE inbMserviceId = ? AND bitIsDeleted = 0`
-
-	stmt, err := s.db.Prepare(sqlstring)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
-		return resp, nil
+	roles, err := s.store.Types().GetRoleTypes(ctx, req.GetMserviceId())
+	if err == nil {
+		resp.RoleTypes = roles
+	} else {
+		gResp := s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
 	}
 
-	defer stmt.Close()
-	rows, err := stmt.Query(req.GetMserviceId())
+	return resp, nil
+}
 
-	if err != nil {
-		level.Error(s.logger).Log("what", "Query", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = err.Error()
+// set whether a team member receives email notifications
+func (s *projService) SetMemberNotify(ctx context.Context, req *pb.SetMemberNotifyRequest) (*pb.SetMemberNotifyResponse, error) {
+	resp := &pb.SetMemberNotifyResponse{}
+
+	gResp, _ := s.GetMemberProjectHelper(ctx, req.GetMemberId(), req.GetMserviceId())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
 		return resp, nil
 	}
 
-	defer rows.Close()
-	for rows.Next() {
-		var created string
-		var modified string
-		var role pb.ProjectRoleType
-
-		err := rows.Scan(&role.ProjectRoleId, &created, &modified, &role.Version, &role.MserviceId, &role.RoleName, &role.Description)
+	err := s.store.Members().SetMemberNotify(ctx, req.GetMemberId(), req.GetMserviceId(), req.GetOptOut())
+	if err != nil {
+		gResp = s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+	}
 
-		if err != nil {
-			level.Error(s.logger).Log("what", "Scan", "error", err)
-			resp.ErrorCode = 500
-			resp.ErrorMessage = err.Error()
-			return resp, nil
-		}
+	return resp, nil
+}
 
-		role.Created = dml.DateTimeFromString(created)
-		role.Modified = dml.DateTimeFromString(modified)
+// get whether a team member receives email notifications
+func (s *projService) GetMemberNotify(ctx context.Context, req *pb.GetMemberNotifyRequest) (*pb.GetMemberNotifyResponse, error) {
+	resp := &pb.GetMemberNotifyResponse{}
 
-		resp.RoleTypes = append(resp.RoleTypes, &role)
+	optOut, err := s.store.Members().GetMemberNotify(ctx, req.GetMemberId(), req.GetMserviceId())
+	if err == nil {
+		resp.OptOut = optOut
+	} else {
+		gResp := s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
 	}
 
-	return resp, err
+	return resp, nil
 }
 
 // get current server version and uptime - health check
@@ -720,3 +487,53 @@ func (s *projService) GetServerVersion(ctx context.Context, req *pb.GetServerVer
 
 	return resp, nil
 }
+
+// watch a project for changes to tasks, team members and assignments
+func (s *projService) WatchProject(req *pb.WatchProjectRequest, stream pb.MServiceProject_WatchProjectServer) error {
+	resp := &pb.WatchProjectResponse{}
+
+	gResp, _ := s.GetProjectByIdHelper(stream.Context(), req.GetProjectId(), req.GetMserviceId())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return stream.Send(resp)
+	}
+
+	watcher, replay, ok := s.events.subscribe(req.GetMserviceId(), req.GetProjectId(), req.GetFromSequence())
+	if !ok {
+		resp.ErrorCode = 410
+		resp.ErrorMessage = "from_sequence no longer available"
+		return stream.Send(resp)
+	}
+
+	defer s.events.unsubscribe(watcher)
+
+	for _, event := range replay {
+		err := stream.Send(&pb.WatchProjectResponse{Event: event})
+		if err != nil {
+			return err
+		}
+	}
+
+	ctx := stream.Context()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-watcher.events:
+			if !ok {
+				if s.events.isLagged(watcher) {
+					resp.ErrorCode = 410
+					resp.ErrorMessage = "watch fell behind, resume from last sequence"
+					return stream.Send(resp)
+				}
+				return nil
+			}
+
+			err := stream.Send(&pb.WatchProjectResponse{Event: event})
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,202 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projservice
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/log/level"
+
+	pb "github.com/gaterace/mproject/pkg/mserviceproject"
+	"github.com/gaterace/mproject/pkg/projhook"
+)
+
+const defaultDeliveryLimit = 50
+const maxDeliveryLimit = 500
+
+// create a new webhook
+func (s *projService) CreateWebhook(ctx context.Context, req *pb.CreateWebhookRequest) (*pb.CreateWebhookResponse, error) {
+	resp := &pb.CreateWebhookResponse{}
+
+	gResp := s.ValidateWebhookHelper(req.GetUrl(), req.GetEventTypes())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	secret, err := projhook.NewSecret()
+	if err != nil {
+		level.Error(s.logger).Log("what", "NewSecret", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "secret generation failed"
+		return resp, nil
+	}
+
+	webhook := &pb.Webhook{MserviceId: req.GetMserviceId(), Url: req.GetUrl(), EventTypes: req.GetEventTypes(),
+		IsActive: req.GetIsActive()}
+
+	webhookId, err := s.store.Webhooks().CreateWebhook(ctx, webhook, secret)
+	if err == nil {
+		level.Debug(s.logger).Log("webhookId", webhookId)
+
+		resp.WebhookId = webhookId
+		resp.Version = 1
+		resp.Secret = secret
+	} else {
+		gResp := s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+	}
+
+	return resp, nil
+}
+
+// update an existing webhook
+func (s *projService) UpdateWebhook(ctx context.Context, req *pb.UpdateWebhookRequest) (*pb.UpdateWebhookResponse, error) {
+	resp := &pb.UpdateWebhookResponse{}
+
+	gResp := s.ValidateWebhookHelper(req.GetUrl(), req.GetEventTypes())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	webhook := &pb.Webhook{WebhookId: req.GetWebhookId(), Version: req.GetVersion(), MserviceId: req.GetMserviceId(),
+		Url: req.GetUrl(), EventTypes: req.GetEventTypes(), IsActive: req.GetIsActive()}
+
+	err := s.store.Webhooks().UpdateWebhook(ctx, webhook)
+	if err == nil {
+		resp.Version = req.GetVersion() + 1
+	} else {
+		gResp := s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+	}
+
+	return resp, nil
+}
+
+// delete an existing webhook
+func (s *projService) DeleteWebhook(ctx context.Context, req *pb.DeleteWebhookRequest) (*pb.DeleteWebhookResponse, error) {
+	resp := &pb.DeleteWebhookResponse{}
+
+	err := s.store.Webhooks().DeleteWebhook(ctx, req.GetWebhookId(), req.GetMserviceId(), req.GetVersion())
+	if err == nil {
+		resp.Version = req.GetVersion() + 1
+	} else {
+		gResp := s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+	}
+
+	return resp, nil
+}
+
+// get all webhooks for the account
+func (s *projService) GetWebhooks(ctx context.Context, req *pb.GetWebhooksRequest) (*pb.GetWebhooksResponse, error) {
+	resp := &pb.GetWebhooksResponse{}
+
+	webhooks, err := s.store.Webhooks().GetWebhooks(ctx, req.GetMserviceId())
+	if err == nil {
+		resp.Webhooks = webhooks
+	} else {
+		gResp := s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+	}
+
+	return resp, nil
+}
+
+// get the most recent deliveries for a webhook
+func (s *projService) GetWebhookDeliveries(ctx context.Context, req *pb.GetWebhookDeliveriesRequest) (*pb.GetWebhookDeliveriesResponse, error) {
+	resp := &pb.GetWebhookDeliveriesResponse{}
+
+	limit := req.GetLimit()
+	if limit <= 0 {
+		limit = defaultDeliveryLimit
+	} else if limit > maxDeliveryLimit {
+		limit = maxDeliveryLimit
+	}
+
+	deliveries, err := s.store.Webhooks().GetDeliveries(ctx, req.GetWebhookId(), req.GetMserviceId(), limit)
+	if err == nil {
+		resp.Deliveries = deliveries
+	} else {
+		gResp := s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+	}
+
+	return resp, nil
+}
+
+// queue a logged webhook delivery to be sent again
+func (s *projService) RedeliverWebhook(ctx context.Context, req *pb.RedeliverWebhookRequest) (*pb.RedeliverWebhookResponse, error) {
+	resp := &pb.RedeliverWebhookResponse{}
+
+	if s.hooks == nil {
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "webhooks not configured"
+		return resp, nil
+	}
+
+	delivery, err := s.hooks.Redeliver(req.GetMserviceId(), req.GetDeliveryId())
+	if err == projhook.ErrNotFound {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "not found"
+	} else if err != nil {
+		level.Error(s.logger).Log("what", "Redeliver", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = err.Error()
+	} else {
+		resp.Delivery = delivery
+	}
+
+	return resp, nil
+}
+
+// validate the url and event types of a webhook, local addresses allowed if the webhook dispatcher allows them
+func (s *projService) ValidateWebhookHelper(webhookUrl string, eventTypes []string) *genericResponse {
+	resp := &genericResponse{}
+
+	err := projhook.ValidateUrl(webhookUrl, false)
+	if s.hooks != nil {
+		err = s.hooks.ValidateUrl(webhookUrl)
+	}
+
+	if (err != nil) || (len(webhookUrl) > 255) {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "url invalid format"
+		return resp
+	}
+
+	if len(eventTypes) == 0 {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "event_types required"
+		return resp
+	}
+
+	for _, eventType := range eventTypes {
+		if !projhook.IsValidEventType(eventType) {
+			resp.ErrorCode = 510
+			resp.ErrorMessage = "event_types invalid: " + eventType
+			return resp
+		}
+	}
+
+	return resp
+}
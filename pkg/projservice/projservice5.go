@@ -0,0 +1,305 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projservice
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	sdec "github.com/shopspring/decimal"
+
+	pb "github.com/gaterace/mproject/pkg/mserviceproject"
+	"github.com/gaterace/mproject/pkg/projstore"
+)
+
+// set the working capacity of a team member
+func (s *projService) SetMemberCapacity(ctx context.Context, req *pb.SetMemberCapacityRequest) (*pb.SetMemberCapacityResponse, error) {
+	resp := &pb.SetMemberCapacityResponse{}
+	var err error
+
+	var hoursPerDay sdec.Decimal
+	if req.GetHoursPerDay() != nil {
+		hoursPerDay, err = req.GetHoursPerDay().ConvertDecimal()
+	}
+	if (req.GetHoursPerDay() == nil) || (err != nil) || hoursPerDay.IsNegative() || hoursPerDay.GreaterThan(sdec.New(24, 0)) {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "hours_per_day must be between 0 and 24"
+		return resp, nil
+	}
+
+	if (req.GetAllocationPercent() < 0) || (req.GetAllocationPercent() > 100) {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "allocation_percent must be between 0 and 100"
+		return resp, nil
+	}
+
+	gResp, projectId := s.GetMemberProjectHelper(ctx, req.GetMemberId(), req.GetMserviceId())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	capacity := &pb.MemberCapacity{MemberId: req.GetMemberId(), Version: req.GetVersion(), MserviceId: req.GetMserviceId(),
+		ProjectId: projectId, HoursPerDay: req.GetHoursPerDay(), AllocationPercent: req.GetAllocationPercent()}
+
+	err = s.store.Capacity().SetMemberCapacity(ctx, capacity)
+	if err == nil {
+		resp.Version = req.GetVersion() + 1
+	} else {
+		gResp := s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+	}
+
+	return resp, nil
+}
+
+// get the working capacity and upcoming days off of a team member
+func (s *projService) GetMemberCapacity(ctx context.Context, req *pb.GetMemberCapacityRequest) (*pb.GetMemberCapacityResponse, error) {
+	resp := &pb.GetMemberCapacityResponse{}
+
+	gResp, projectId := s.GetMemberProjectHelper(ctx, req.GetMemberId(), req.GetMserviceId())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	capacity, err := s.store.Capacity().GetMemberCapacity(ctx, req.GetMemberId(), req.GetMserviceId())
+	if err == nil {
+		capacity.ProjectId = projectId
+		resp.Capacity = capacity
+	} else {
+		gResp := s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+	}
+
+	return resp, nil
+}
+
+// add a day when a team member is not available
+func (s *projService) AddMemberDayOff(ctx context.Context, req *pb.AddMemberDayOffRequest) (*pb.AddMemberDayOffResponse, error) {
+	resp := &pb.AddMemberDayOffResponse{}
+
+	if req.GetDayOff() == nil {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "day_off required"
+		return resp, nil
+	}
+
+	gResp, _ := s.GetMemberProjectHelper(ctx, req.GetMemberId(), req.GetMserviceId())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	dayOff := startOfDay(req.GetDayOff().TimeFromDateTime())
+
+	err := s.store.Capacity().AddDayOff(ctx, req.GetMemberId(), req.GetMserviceId(), dayOff, req.GetReason())
+	if err != nil {
+		gResp := s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+	}
+
+	return resp, nil
+}
+
+// remove a day when a team member is not available
+func (s *projService) RemoveMemberDayOff(ctx context.Context, req *pb.RemoveMemberDayOffRequest) (*pb.RemoveMemberDayOffResponse, error) {
+	resp := &pb.RemoveMemberDayOffResponse{}
+
+	if req.GetDayOff() == nil {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "day_off required"
+		return resp, nil
+	}
+
+	dayOff := startOfDay(req.GetDayOff().TimeFromDateTime())
+
+	err := s.store.Capacity().RemoveDayOff(ctx, req.GetMemberId(), req.GetMserviceId(), dayOff)
+	if err != nil {
+		gResp := s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+	}
+
+	return resp, nil
+}
+
+// set the estimated hours for a team member on a task
+func (s *projService) SetAssignmentEstimate(ctx context.Context, req *pb.SetAssignmentEstimateRequest) (*pb.SetAssignmentEstimateResponse, error) {
+	resp := &pb.SetAssignmentEstimateResponse{}
+	var err error
+
+	var estimatedHours sdec.Decimal
+	if req.GetEstimatedHours() != nil {
+		estimatedHours, err = req.GetEstimatedHours().ConvertDecimal()
+	}
+	if (req.GetEstimatedHours() == nil) || (err != nil) || estimatedHours.IsNegative() {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "estimated_hours invalid"
+		return resp, nil
+	}
+
+	err = s.store.Capacity().SetAssignmentEstimate(ctx, req.GetTaskId(), req.GetMemberId(), req.GetMserviceId(),
+		estimatedHours)
+	if errors.Is(err, projstore.ErrNotFound) {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "referenced assignment not found"
+	} else if err != nil {
+		gResp := s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+	}
+
+	return resp, nil
+}
+
+// compare weekly assigned hours of team members against their capacity
+func (s *projService) GetCapacityReport(ctx context.Context, req *pb.GetCapacityReportRequest) (*pb.GetCapacityReportResponse, error) {
+	resp := &pb.GetCapacityReportResponse{}
+
+	gResp, _ := s.GetProjectByIdHelper(ctx, req.GetProjectId(), req.GetMserviceId())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	weeks := int(req.GetWeeks())
+	if weeks <= 0 {
+		weeks = defaultReportWeeks
+	} else if weeks > maxReportWeeks {
+		weeks = maxReportWeeks
+	}
+
+	start := time.Now()
+	if req.GetStartDate().GetMilliseconds() != 0 {
+		start = req.GetStartDate().TimeFromDateTime()
+	}
+
+	start = startOfWeek(start)
+	end := start.AddDate(0, 0, 7*weeks).Add(-time.Second)
+
+	gResp, capacities, assignments := s.GetCapacityDataHelper(ctx, req.GetProjectId(), req.GetMserviceId(), start, end)
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	resp.Members = buildCapacityReport(capacities, assignments, start, weeks)
+
+	return resp, nil
+}
+
+// list days where task assignments of a team member exceed capacity
+func (s *projService) GetOverallocations(ctx context.Context, req *pb.GetOverallocationsRequest) (*pb.GetOverallocationsResponse, error) {
+	resp := &pb.GetOverallocationsResponse{}
+
+	gResp, _ := s.GetProjectByIdHelper(ctx, req.GetProjectId(), req.GetMserviceId())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	start := time.Now()
+	if req.GetStartDate().GetMilliseconds() != 0 {
+		start = req.GetStartDate().TimeFromDateTime()
+	}
+
+	start = startOfDay(start)
+	end := start.AddDate(0, 0, defaultOverallocationDays-1)
+	if req.GetEndDate().GetMilliseconds() != 0 {
+		end = startOfDay(req.GetEndDate().TimeFromDateTime())
+	}
+
+	if end.Before(start) || end.After(start.AddDate(0, 0, maxOverallocationDays)) {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "end_date must be after start_date and within a year"
+		return resp, nil
+	}
+
+	end = end.AddDate(0, 0, 1).Add(-time.Second)
+
+	gResp, capacities, assignments := s.GetCapacityDataHelper(ctx, req.GetProjectId(), req.GetMserviceId(), start, end)
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	resp.Overallocations = buildOverallocations(capacities, assignments, start, end)
+
+	return resp, nil
+}
+
+// propose task date shifts within available float to resolve over-allocations, without applying them
+func (s *projService) SuggestLeveling(ctx context.Context, req *pb.SuggestLevelingRequest) (*pb.SuggestLevelingResponse, error) {
+	resp := &pb.SuggestLevelingResponse{}
+
+	gResp, project := s.GetProjectByIdHelper(ctx, req.GetProjectId(), req.GetMserviceId())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	start := time.Now()
+	if req.GetStartDate().GetMilliseconds() != 0 {
+		start = req.GetStartDate().TimeFromDateTime()
+	}
+
+	start = startOfDay(start)
+
+	gResp, schedules := s.GetTaskSchedulesHelper(ctx, req.GetProjectId(), req.GetMserviceId())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	// tasks are never shifted past the project end, but may already end after it
+	projectEnd := project.GetEndDate().TimeFromDateTime()
+	end := projectEnd
+	for _, ts := range schedules {
+		if ts.endDate.After(end) {
+			end = ts.endDate
+		}
+	}
+
+	if end.Before(start) {
+		return resp, nil
+	}
+
+	end = startOfDay(end).AddDate(0, 0, 1).Add(-time.Second)
+
+	gResp, capacities, assignments := s.GetCapacityDataHelper(ctx, req.GetProjectId(), req.GetMserviceId(), start, end)
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	tasks, fixed := buildLevelTasks(schedules, assignments, start, projectEnd)
+	resp.Suggestions, resp.UnresolvedTaskIds = suggestLeveling(capacities, fixed, tasks)
+
+	return resp, nil
+}
@@ -0,0 +1,257 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projservice
+
+import (
+	"context"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+	sdec "github.com/shopspring/decimal"
+
+	pb "github.com/gaterace/mproject/pkg/mserviceproject"
+	"github.com/gaterace/mproject/pkg/projstore"
+)
+
+// create a new person in the account directory
+func (s *projService) CreatePerson(ctx context.Context, req *pb.CreatePersonRequest) (*pb.CreatePersonResponse, error) {
+	resp := &pb.CreatePersonResponse{}
+
+	gResp, person := s.CreatePersonHelper(ctx, req.GetName(), req.GetEmail(), req.GetMserviceId())
+	resp.ErrorCode = gResp.ErrorCode
+	resp.ErrorMessage = gResp.ErrorMessage
+	if gResp.ErrorCode == 0 {
+		resp.PersonId = person.GetPersonId()
+		resp.Version = person.GetVersion()
+	}
+
+	return resp, nil
+}
+
+// update an existing person
+func (s *projService) UpdatePerson(ctx context.Context, req *pb.UpdatePersonRequest) (*pb.UpdatePersonResponse, error) {
+	resp := &pb.UpdatePersonResponse{}
+
+	email := normalizeEmail(req.GetEmail())
+	if (req.GetName() == "") || (email == "") {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "name and email are required"
+		return resp, nil
+	}
+
+	person := &pb.Person{PersonId: req.GetPersonId(), Version: req.GetVersion(), MserviceId: req.GetMserviceId(),
+		Name: req.GetName(), Email: email}
+
+	err := s.store.WithTx(ctx, func(tx projstore.Store) error {
+		err := tx.Persons().UpdatePerson(ctx, person)
+		if err != nil {
+			return err
+		}
+
+		return tx.Persons().SyncPersonMembers(ctx, req.GetPersonId(), req.GetMserviceId())
+	})
+
+	if err == nil {
+		resp.Version = req.GetVersion() + 1
+	} else {
+		gResp := s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+	}
+
+	return resp, nil
+}
+
+// delete an existing person
+func (s *projService) DeletePerson(ctx context.Context, req *pb.DeletePersonRequest) (*pb.DeletePersonResponse, error) {
+	resp := &pb.DeletePersonResponse{}
+
+	// a person can only be removed from the directory once they are no longer on any project
+	count, err := s.store.Persons().CountPersonMembers(ctx, req.GetPersonId(), req.GetMserviceId())
+	if err != nil {
+		gResp := s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	if count > 0 {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "person is still a team member of a project"
+		return resp, nil
+	}
+
+	err = s.store.Persons().DeletePerson(ctx, req.GetPersonId(), req.GetMserviceId(), req.GetVersion())
+	if err == nil {
+		resp.Version = req.GetVersion() + 1
+	} else {
+		gResp := s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+	}
+
+	return resp, nil
+}
+
+// get person by id
+func (s *projService) GetPersonById(ctx context.Context, req *pb.GetPersonByIdRequest) (*pb.GetPersonByIdResponse, error) {
+	resp := &pb.GetPersonByIdResponse{}
+
+	gResp, person := s.GetPersonByIdHelper(ctx, req.GetPersonId(), req.GetMserviceId())
+	resp.ErrorCode = gResp.ErrorCode
+	resp.ErrorMessage = gResp.ErrorMessage
+	if gResp.ErrorCode == 0 {
+		resp.Person = person
+	}
+
+	return resp, nil
+}
+
+// get person by email
+func (s *projService) GetPersonByEmail(ctx context.Context, req *pb.GetPersonByEmailRequest) (*pb.GetPersonByEmailResponse, error) {
+	resp := &pb.GetPersonByEmailResponse{}
+
+	gResp, person := s.GetPersonByEmailHelper(ctx, req.GetEmail(), req.GetMserviceId())
+	resp.ErrorCode = gResp.ErrorCode
+	resp.ErrorMessage = gResp.ErrorMessage
+	if gResp.ErrorCode == 0 {
+		if person.GetIsDeleted() {
+			resp.ErrorCode = 404
+			resp.ErrorMessage = "not found"
+		} else {
+			resp.Person = person
+		}
+	}
+
+	return resp, nil
+}
+
+// get all persons in the account directory
+func (s *projService) GetPersons(ctx context.Context, req *pb.GetPersonsRequest) (*pb.GetPersonsResponse, error) {
+	resp := &pb.GetPersonsResponse{}
+
+	persons, err := s.store.Persons().GetPersons(ctx, req.GetMserviceId())
+	if err == nil {
+		resp.Persons = persons
+	} else {
+		gResp := s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+	}
+
+	return resp, nil
+}
+
+// get the project memberships of a person
+func (s *projService) GetPersonProjects(ctx context.Context, req *pb.GetPersonProjectsRequest) (*pb.GetPersonProjectsResponse, error) {
+	resp := &pb.GetPersonProjectsResponse{}
+
+	gResp, _ := s.GetPersonByIdHelper(ctx, req.GetPersonId(), req.GetMserviceId())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	projects, err := s.store.Persons().GetPersonProjects(ctx, req.GetPersonId(), req.GetMserviceId())
+	if err != nil {
+		gResp := s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	total := sdec.Decimal{}
+	for _, project := range projects {
+		hours, err := project.GetTaskHours().ConvertDecimal()
+		if err == nil {
+			total = total.Add(hours)
+		}
+	}
+
+	resp.Projects = projects
+	resp.TotalHours = dml.ConvertDecimal(total)
+
+	return resp, nil
+}
+
+// get the task assignments of a person across projects
+func (s *projService) GetPersonTasks(ctx context.Context, req *pb.GetPersonTasksRequest) (*pb.GetPersonTasksResponse, error) {
+	resp := &pb.GetPersonTasksResponse{}
+
+	gResp, _ := s.GetPersonByIdHelper(ctx, req.GetPersonId(), req.GetMserviceId())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	gResp, tasks := s.GetPersonTasksHelper(ctx, req.GetPersonId(), req.GetMserviceId())
+	resp.ErrorCode = gResp.ErrorCode
+	resp.ErrorMessage = gResp.ErrorMessage
+	if gResp.ErrorCode == 0 {
+		resp.Tasks = tasks
+	}
+
+	return resp, nil
+}
+
+// bind a person to an MService user id
+func (s *projService) SetPersonUser(ctx context.Context, req *pb.SetPersonUserRequest) (*pb.SetPersonUserResponse, error) {
+	resp := &pb.SetPersonUserResponse{}
+
+	err := s.store.Persons().SetPersonUser(ctx, req.GetPersonId(), req.GetMserviceId(), req.GetVersion(),
+		req.GetUserId())
+	if err == nil {
+		resp.Version = req.GetVersion() + 1
+	} else {
+		gResp := s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+	}
+
+	return resp, nil
+}
+
+// get the task assignments of the calling user across projects
+func (s *projService) GetMyTasks(ctx context.Context, req *pb.GetMyTasksRequest) (*pb.GetMyTasksResponse, error) {
+	resp := &pb.GetMyTasksResponse{}
+
+	gResp, person := s.GetPersonByUserHelper(ctx, req.GetUserId(), req.GetMserviceId())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	gResp, tasks := s.GetPersonTasksHelper(ctx, person.GetPersonId(), req.GetMserviceId())
+	resp.ErrorCode = gResp.ErrorCode
+	resp.ErrorMessage = gResp.ErrorMessage
+	if gResp.ErrorCode == 0 {
+		total := sdec.Decimal{}
+		for _, task := range tasks {
+			if task.GetTaskHours() != nil {
+				hours, err := task.GetTaskHours().ConvertDecimal()
+				if err == nil {
+					total = total.Add(hours)
+				}
+			}
+		}
+
+		resp.Person = person
+		resp.Tasks = tasks
+		resp.TotalHours = dml.ConvertDecimal(total)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,138 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projservice
+
+import (
+	"context"
+
+	"github.com/go-kit/kit/log/level"
+
+	pb "github.com/gaterace/mproject/pkg/mserviceproject"
+	"github.com/gaterace/mproject/pkg/projstore"
+)
+
+// grant a user or project role access to a project
+func (s *projService) CreateProjectGrant(ctx context.Context, req *pb.CreateProjectGrantRequest) (*pb.CreateProjectGrantResponse, error) {
+	resp := &pb.CreateProjectGrantResponse{}
+
+	_, ok := projstore.GrantAccessLevels[req.GetAccess()]
+	if !ok {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "access must be owner, editor or viewer"
+		return resp, nil
+	}
+
+	if (req.GetUserId() > 0) == (req.GetProjectRoleId() > 0) {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "exactly one of user_id or project_role_id is required"
+		return resp, nil
+	}
+
+	gResp, _ := s.GetProjectByIdHelper(ctx, req.GetProjectId(), req.GetMserviceId())
+	if gResp.ErrorCode != 0 {
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	exists, err := s.store.Grants().GrantExists(ctx, req.GetProjectId(), req.GetMserviceId(), req.GetUserId(),
+		req.GetProjectRoleId())
+	if err != nil {
+		gResp := s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+		return resp, nil
+	}
+
+	if exists {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "grant already exists, use update_project_grant"
+		return resp, nil
+	}
+
+	grant := &pb.ProjectGrant{MserviceId: req.GetMserviceId(), ProjectId: req.GetProjectId(), UserId: req.GetUserId(),
+		ProjectRoleId: req.GetProjectRoleId(), Access: req.GetAccess()}
+
+	grantId, err := s.store.Grants().CreateGrant(ctx, grant)
+	if err == nil {
+		level.Debug(s.logger).Log("grantId", grantId)
+
+		resp.GrantId = grantId
+		resp.Version = 1
+	} else {
+		gResp := s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+	}
+
+	return resp, nil
+}
+
+// update the access of an existing project grant
+func (s *projService) UpdateProjectGrant(ctx context.Context, req *pb.UpdateProjectGrantRequest) (*pb.UpdateProjectGrantResponse, error) {
+	resp := &pb.UpdateProjectGrantResponse{}
+
+	_, ok := projstore.GrantAccessLevels[req.GetAccess()]
+	if !ok {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "access must be owner, editor or viewer"
+		return resp, nil
+	}
+
+	grant := &pb.ProjectGrant{GrantId: req.GetGrantId(), Version: req.GetVersion(), MserviceId: req.GetMserviceId(),
+		Access: req.GetAccess()}
+
+	err := s.store.Grants().UpdateGrant(ctx, grant)
+	if err == nil {
+		resp.Version = req.GetVersion() + 1
+	} else {
+		gResp := s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+	}
+
+	return resp, nil
+}
+
+// delete an existing project grant
+func (s *projService) DeleteProjectGrant(ctx context.Context, req *pb.DeleteProjectGrantRequest) (*pb.DeleteProjectGrantResponse, error) {
+	resp := &pb.DeleteProjectGrantResponse{}
+
+	err := s.store.Grants().DeleteGrant(ctx, req.GetGrantId(), req.GetMserviceId(), req.GetVersion())
+	if err == nil {
+		resp.Version = req.GetVersion() + 1
+	} else {
+		gResp := s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+	}
+
+	return resp, nil
+}
+
+// get the grants of a project
+func (s *projService) GetProjectGrants(ctx context.Context, req *pb.GetProjectGrantsRequest) (*pb.GetProjectGrantsResponse, error) {
+	resp := &pb.GetProjectGrantsResponse{}
+
+	grants, err := s.store.Grants().GetGrantsByProject(ctx, req.GetProjectId(), req.GetMserviceId())
+	if err == nil {
+		resp.Grants = grants
+	} else {
+		gResp := s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,150 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projservice
+
+import (
+	"context"
+	"errors"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/go-kit/kit/log/level"
+
+	pb "github.com/gaterace/mproject/pkg/mserviceproject"
+	"github.com/gaterace/mproject/pkg/projauth"
+	"github.com/gaterace/mproject/pkg/projstore"
+)
+
+// Roles an api key can be given, as projsvc claim values.
+var apiKeyRoles = map[string]bool{
+	"projadmin":  true,
+	"projrw":     true,
+	"projro":     true,
+	"projmember": true,
+	"projuser":   true,
+}
+
+// create an api key for automation
+func (s *projService) CreateApiKey(ctx context.Context, req *pb.CreateApiKeyRequest) (*pb.CreateApiKeyResponse, error) {
+	resp := &pb.CreateApiKeyResponse{}
+	var err error
+
+	if req.GetName() == "" {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "name is required"
+		return resp, nil
+	}
+
+	if !apiKeyRoles[req.GetRole()] {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "role must be projadmin, projrw, projro, projmember or projuser"
+		return resp, nil
+	}
+
+	for _, scope := range req.GetScopes() {
+		_, err = path.Match(scope, "")
+		if (scope == "") || strings.Contains(scope, ",") || (err != nil) {
+			resp.ErrorCode = 510
+			resp.ErrorMessage = "invalid scope: " + scope
+			return resp, nil
+		}
+	}
+
+	if req.GetExpires() == nil {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "expires is required"
+		return resp, nil
+	}
+
+	expires := req.GetExpires().TimeFromDateTime()
+	if !expires.After(time.Now()) {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "expires must be in the future"
+		return resp, nil
+	}
+
+	// the key acts as its user, so the user must be a person of the account
+	if req.GetUserId() != 0 {
+		_, err = s.store.Persons().GetPersonByUser(ctx, req.GetUserId(), req.GetMserviceId())
+		if errors.Is(err, projstore.ErrNotFound) {
+			resp.ErrorCode = 510
+			resp.ErrorMessage = "user_id is not bound to a person in the account"
+			return resp, nil
+		} else if err != nil {
+			gResp := s.storeErrorHelper(err)
+			resp.ErrorCode = gResp.ErrorCode
+			resp.ErrorMessage = gResp.ErrorMessage
+			return resp, nil
+		}
+	}
+
+	apiKey, prefix, hash, err := projauth.NewApiKey()
+	if err != nil {
+		level.Error(s.logger).Log("what", "NewApiKey", "error", err)
+		resp.ErrorCode = 500
+		resp.ErrorMessage = "key generation failed"
+		return resp, nil
+	}
+
+	key := &pb.ApiKey{MserviceId: req.GetMserviceId(), Name: req.GetName(), KeyPrefix: prefix, Role: req.GetRole(),
+		Scopes: req.GetScopes(), UserId: req.GetUserId(), Expires: req.GetExpires()}
+
+	apiKeyId, err := s.store.ApiKeys().CreateApiKey(ctx, key, hash)
+	if err == nil {
+		level.Debug(s.logger).Log("apiKeyId", apiKeyId)
+
+		resp.ApiKeyId = apiKeyId
+		resp.Version = 1
+		resp.ApiKey = apiKey
+	} else {
+		gResp := s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+	}
+
+	return resp, nil
+}
+
+// revoke an existing api key
+func (s *projService) RevokeApiKey(ctx context.Context, req *pb.RevokeApiKeyRequest) (*pb.RevokeApiKeyResponse, error) {
+	resp := &pb.RevokeApiKeyResponse{}
+
+	err := s.store.ApiKeys().RevokeApiKey(ctx, req.GetApiKeyId(), req.GetMserviceId(), req.GetVersion())
+	if err == nil {
+		resp.Version = req.GetVersion() + 1
+	} else {
+		gResp := s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+	}
+
+	return resp, nil
+}
+
+// get the api keys of the account
+func (s *projService) GetApiKeys(ctx context.Context, req *pb.GetApiKeysRequest) (*pb.GetApiKeysResponse, error) {
+	resp := &pb.GetApiKeysResponse{}
+
+	apiKeys, err := s.store.ApiKeys().GetApiKeys(ctx, req.GetMserviceId())
+	if err == nil {
+		resp.ApiKeys = apiKeys
+	} else {
+		gResp := s.storeErrorHelper(err)
+		resp.ErrorCode = gResp.ErrorCode
+		resp.ErrorMessage = gResp.ErrorMessage
+	}
+
+	return resp, nil
+}
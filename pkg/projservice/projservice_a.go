@@ -14,15 +14,16 @@
 package projservice
 
 import (
-	"database/sql"
-
-	"github.com/go-kit/kit/log/level"
+	"context"
+	"errors"
+	"strings"
 
 	"github.com/gaterace/dml-go/pkg/dml"
-
-	_ "github.com/go-sql-driver/mysql"
+	"github.com/go-kit/kit/log/level"
 
 	pb "github.com/gaterace/mproject/pkg/mserviceproject"
+	"github.com/gaterace/mproject/pkg/projstore"
+	"google.golang.org/protobuf/proto"
 )
 
 // Generic response to set specific API method response.
@@ -31,227 +32,89 @@ type genericResponse struct {
 	ErrorMessage string
 }
 
-// Helper to build Project from projectId and mserviceId.
-func (s *projService) GetProjectByIdHelper(projectId int64, mserviceId int64) (*genericResponse, *pb.Project) {
-	resp := &genericResponse{}
-
-	sqlstring := `SELECT p.inbProjectId, p.dtmCreated, p.dtmModified, p.intVersion, p.inbMserviceId, p.chvName, 
-	p.chvDescription, p.intStatusId, p.dtmStartDate, p.dtmEndDate, s.chvStatusName 
-	FROM tb_Project AS p 
-    JOIN tb_StatusType AS s ON p.intStatusId = s.intStatusId
-	WHERE p.inbProjectId = ? AND p.inbMserviceId = ? 
-	AND p.bitIsDeleted = 0`
-
-	stmt, err := s.db.Prepare(sqlstring)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
-		return resp, nil
-	}
-
-	defer stmt.Close()
-
-	var created string
-	var modified string
-	var start_date string
-	var end_date string
-
-	var project pb.Project
-
-	err = stmt.QueryRow(projectId, mserviceId).Scan(&project.ProjectId, &created, &modified, &project.Version,
-		&project.MserviceId, &project.Name, &project.Description, &project.StatusId, &start_date, &end_date, &project.StatusName)
-
-	if err == nil {
-		project.Created = dml.DateTimeFromString(created)
-		project.Modified = dml.DateTimeFromString(modified)
-		project.StartDate = dml.DateTimeFromString(start_date)
-		project.EndDate = dml.DateTimeFromString(end_date)
-		resp.ErrorCode = 0
-	} else if err == sql.ErrNoRows {
-		resp.ErrorCode = 404
-		resp.ErrorMessage = "not found"
-
-	} else {
-		level.Error(s.logger).Log("what", "QueryRow", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = err.Error()
-
-	}
+// Error that rolls back a store transaction, with the error code and message for the response.
+type responseError struct {
+	genericResponse
+}
 
-	return resp, &project
+func (e *responseError) Error() string {
+	return e.ErrorMessage
 }
 
-// Helper to build Project from projectName and mserviceId.
-func (s *projService) GetProjectByNameHelper(projectName string, mserviceId int64) (*genericResponse, *pb.Project) {
+// Helper to convert an error from the store to an error code and message, logging database failures.
+func (s *projService) storeErrorHelper(err error) *genericResponse {
 	resp := &genericResponse{}
 
-	sqlstring := `SELECT p.inbProjectId, p.dtmCreated, p.dtmModified, p.intVersion, p.inbMserviceId, p.chvName, 
-	p.chvDescription, p.intStatusId, p.dtmStartDate, p.dtmEndDate, s.chvStatusName 
-	FROM tb_Project AS p 
-    JOIN tb_StatusType AS s ON p.intStatusId = s.intStatusId
-	WHERE p.chvName = ? AND p.inbMserviceId = ? 
-	AND p.bitIsDeleted = 0`
-
-	stmt, err := s.db.Prepare(sqlstring)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
-		return resp, nil
-	}
-
-	defer stmt.Close()
-
-	var created string
-	var modified string
-	var start_date string
-	var end_date string
-
-	var project pb.Project
-
-	err = stmt.QueryRow(projectName, mserviceId).Scan(&project.ProjectId, &created, &modified, &project.Version,
-		&project.MserviceId, &project.Name, &project.Description, &project.StatusId, &start_date, &end_date, &project.StatusName)
-
+	var respErr *responseError
+	var dbErr *projstore.DbError
 	if err == nil {
-		project.Created = dml.DateTimeFromString(created)
-		project.Modified = dml.DateTimeFromString(modified)
-		project.StartDate = dml.DateTimeFromString(start_date)
-		project.EndDate = dml.DateTimeFromString(end_date)
-		resp.ErrorCode = 0
-	} else if err == sql.ErrNoRows {
+		return resp
+	} else if errors.As(err, &respErr) {
+		resp.ErrorCode = respErr.ErrorCode
+		resp.ErrorMessage = respErr.ErrorMessage
+	} else if errors.Is(err, projstore.ErrNotFound) {
 		resp.ErrorCode = 404
 		resp.ErrorMessage = "not found"
-
-	} else {
-		level.Error(s.logger).Log("what", "QueryRow", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = err.Error()
-
-	}
-
-	return resp, &project
-}
-
-// Helper to get the list of team members for a project.
-func (s *projService) GetTeamMembersHelper(projectId int64, mserviceId int64) (*genericResponse, []*pb.TeamMember) {
-	members := make([]*pb.TeamMember, 0)
-	resp := &genericResponse{}
-
-	sqlstring := `SELECT m.inbMemberId, m.dtmCreated, m.dtmModified, m.intVersion, m.inbMserviceId, m.inbProjectId, m.chvName,
-	m.intProjectRoleId, m.chvEmail, r.chvRoleName
-	FROM tb_TeamMember AS m
-	JOIN tb_ProjectRoleType AS r ON m.intProjectRoleId = r.intProjectRoleId
-	WHERE m.inbProjectId = ? AND m.inbMserviceId = ? AND m.bitIsDeleted = 0`
-
-	stmt, err := s.db.Prepare(sqlstring)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
-		return resp, nil
-	}
-
-	defer stmt.Close()
-
-	rows, err := stmt.Query(projectId, mserviceId)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Query", "error", err)
-		resp.ErrorCode = 500
+	} else if errors.Is(err, projstore.ErrVersionConflict) && !s.statusMode {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "not found"
+	} else if errors.Is(err, projstore.ErrVersionConflict) {
+		resp.ErrorCode = 412
+		resp.ErrorMessage = "version conflict"
+	} else if errors.As(err, &dbErr) && (dbErr.What == "Duplicate") && s.statusMode {
+		resp.ErrorCode = 409
 		resp.ErrorMessage = err.Error()
-		return resp, nil
-	}
-
-	defer rows.Close()
-	for rows.Next() {
-		var created string
-		var modified string
-		var member pb.TeamMember
-
-		err := rows.Scan(&member.MemberId, &created, &modified, &member.Version, &member.MserviceId, &member.ProjectId,
-			&member.Name, &member.ProjectRoleId, &member.Email, &member.RoleName)
-
-		if err != nil {
-			level.Error(s.logger).Log("what", "Scan", "error", err)
+	} else if errors.As(err, &dbErr) {
+		level.Error(s.logger).Log("what", dbErr.What, "error", dbErr.Err)
+		switch dbErr.What {
+		case "Prepare":
+			resp.ErrorCode = 500
+			resp.ErrorMessage = "db.Prepare failed"
+		case "Exec", "Duplicate":
+			resp.ErrorCode = 501
+			resp.ErrorMessage = err.Error()
+		default:
 			resp.ErrorCode = 500
 			resp.ErrorMessage = err.Error()
-			return resp, nil
 		}
-
-		member.Created = dml.DateTimeFromString(created)
-		member.Modified = dml.DateTimeFromString(modified)
-		members = append(members, &member)
-	}
-
-	return resp, members
-}
-
-// Helper to get the list of tasks for a project.
-func (s *projService) GetProjectTasksHelper(projectId int64, mserviceId int64) (*genericResponse, []*pb.Task) {
-	tasks := make([]*pb.Task, 0)
-	resp := &genericResponse{}
-
-	sqlstring := `SELECT t.inbTaskId, t.dtmCreated, t.dtmModified, t.intVersion, t.inbMserviceId, t.inbProjectId, t.chvName,
-	t.chvDescription, t.intStatusId, t.dtmStartDate, t.dtmEndDate, t.intPriority, t.inbParentId, t.intPosition, s.chvStatusName 
-	FROM tb_Task AS t 
-	JOIN tb_StatusType AS s ON t.intStatusId = s.intStatusId 
-	WHERE t.inbProjectId = ? AND t.inbMserviceId = ? AND t.bitIsDeleted = 0
-	ORDER by t.inbParentId, t.intPosition`
-
-	stmt, err := s.db.Prepare(sqlstring)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
-		return resp, nil
-	}
-
-	defer stmt.Close()
-
-	rows, err := stmt.Query(projectId, mserviceId)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Query", "error", err)
+	} else {
+		level.Error(s.logger).Log("what", "store", "error", err)
 		resp.ErrorCode = 500
 		resp.ErrorMessage = err.Error()
-		return resp, nil
 	}
 
-	defer rows.Close()
-	for rows.Next() {
-		var created string
-		var modified string
-		var start_date string
-		var end_date string
-
-		var task pb.Task
-
-		err = rows.Scan(&task.TaskId, &created, &modified, &task.Version, &task.MserviceId,
-			&task.ProjectId, &task.Name, &task.Description, &task.StatusId, &start_date, &end_date, &task.Priority, &task.ParentId,
-			&task.Position, &task.StatusName)
+	return resp
+}
 
-		if err != nil {
-			level.Error(s.logger).Log("what", "Scan", "error", err)
-			resp.ErrorCode = 500
-			resp.ErrorMessage = err.Error()
-			return resp, nil
-		}
+// Helper to build Project from projectId and mserviceId.
+func (s *projService) GetProjectByIdHelper(ctx context.Context, projectId int64, mserviceId int64) (*genericResponse, *pb.Project) {
+	project, err := s.store.Projects().GetProjectById(ctx, projectId, mserviceId)
+	return s.storeErrorHelper(err), project
+}
 
-		task.Created = dml.DateTimeFromString(created)
-		task.Modified = dml.DateTimeFromString(modified)
-		task.StartDate = dml.DateTimeFromString(start_date)
-		task.EndDate = dml.DateTimeFromString(end_date)
+// Helper to build Project from projectName and mserviceId.
+func (s *projService) GetProjectByNameHelper(ctx context.Context, projectName string, mserviceId int64) (*genericResponse, *pb.Project) {
+	project, err := s.store.Projects().GetProjectByName(ctx, projectName, mserviceId)
+	return s.storeErrorHelper(err), project
+}
 
-		tasks = append(tasks, &task)
-	}
+// Helper to get the list of team members for a project.
+func (s *projService) GetTeamMembersHelper(ctx context.Context, projectId int64, mserviceId int64) (*genericResponse, []*pb.TeamMember) {
+	members, err := s.store.Members().GetMembersByProject(ctx, projectId, mserviceId)
+	return s.storeErrorHelper(err), members
+}
 
-	return resp, tasks
+// Helper to get the list of tasks for a project.
+func (s *projService) GetProjectTasksHelper(ctx context.Context, projectId int64, mserviceId int64) (*genericResponse, []*pb.Task) {
+	tasks, err := s.store.Tasks().GetTasksByProject(ctx, projectId, mserviceId)
+	return s.storeErrorHelper(err), tasks
 }
 
 // Helper to get the list of TaskWrapper objects for a project.
-func (s *projService) GetProjectTaskWrapperHelper(projectId int64, mserviceId int64) (*genericResponse, []*pb.TaskWrapper) {
+func (s *projService) GetProjectTaskWrapperHelper(ctx context.Context, projectId int64, mserviceId int64) (*genericResponse, []*pb.TaskWrapper) {
 	wraps := make([]*pb.TaskWrapper, 0)
 
-	resp, tasks := s.GetProjectTasksHelper(projectId, mserviceId)
+	resp, tasks := s.GetProjectTasksHelper(ctx, projectId, mserviceId)
 
 	if resp.ErrorCode != 0 {
 		return resp, nil
@@ -275,7 +138,7 @@ func (s *projService) GetProjectTaskWrapperHelper(projectId int64, mserviceId in
 
 	memberMap := make(map[int64]*pb.TeamMember)
 
-	resp, members := s.GetTeamMembersHelper(projectId, mserviceId)
+	resp, members := s.GetTeamMembersHelper(ctx, projectId, mserviceId)
 
 	if resp.ErrorCode != 0 {
 		return resp, nil
@@ -284,7 +147,7 @@ func (s *projService) GetProjectTaskWrapperHelper(projectId int64, mserviceId in
 		memberMap[member.GetMemberId()] = member
 	}
 
-	resp, mbrtasks := s.GetTaskToMemberHelper(projectId, mserviceId)
+	resp, mbrtasks := s.GetTaskToMemberHelper(ctx, projectId, mserviceId)
 	if resp.ErrorCode != 0 {
 		return resp, nil
 	}
@@ -294,9 +157,10 @@ func (s *projService) GetProjectTaskWrapperHelper(projectId int64, mserviceId in
 		if ok {
 			mbr, ok := memberMap[mbrtask.GetMemberId()]
 			if ok {
-				member := *mbr
+				member := proto.Clone(mbr).(*pb.TeamMember)
 				member.TaskHours = mbrtask.GetTaskHours()
-				wtask.TeamMembers = append(wtask.TeamMembers, &member)
+				member.AssignmentVersion = mbrtask.GetVersion()
+				wtask.TeamMembers = append(wtask.TeamMembers, member)
 			}
 		}
 	}
@@ -305,58 +169,155 @@ func (s *projService) GetProjectTaskWrapperHelper(projectId int64, mserviceId in
 }
 
 // Helper to get the list of TaskToMember mappings fpr a project.
-func (s *projService) GetTaskToMemberHelper(projectId int64, mserviceId int64) (*genericResponse, []*pb.TaskToMember) {
-	mbrtasks := make([]*pb.TaskToMember, 0)
+func (s *projService) GetTaskToMemberHelper(ctx context.Context, projectId int64, mserviceId int64) (*genericResponse, []*pb.TaskToMember) {
+	mbrtasks, err := s.store.Assignments().GetAssignmentsByProject(ctx, projectId, mserviceId)
+	return s.storeErrorHelper(err), mbrtasks
+}
+
+// Helper to get the project id and status id for a task.
+func (s *projService) GetTaskProjectHelper(ctx context.Context, taskId int64, mserviceId int64) (*genericResponse, int64, int32) {
+	projectId, statusId, err := s.store.Tasks().GetTaskProject(ctx, taskId, mserviceId)
+	return s.storeErrorHelper(err), projectId, statusId
+}
+
+// Helper to get the project id for a team member.
+func (s *projService) GetMemberProjectHelper(ctx context.Context, memberId int64, mserviceId int64) (*genericResponse, int64) {
+	projectId, _, err := s.store.Members().GetMemberProject(ctx, memberId, mserviceId)
+	return s.storeErrorHelper(err), projectId
+}
+
+// Helper to get a person by id.
+func (s *projService) GetPersonByIdHelper(ctx context.Context, personId int64, mserviceId int64) (*genericResponse, *pb.Person) {
+	person, err := s.store.Persons().GetPersonById(ctx, personId, mserviceId)
+	return s.storeErrorHelper(err), person
+}
+
+// Helper to get the person with an email address, including a deleted person.
+func (s *projService) GetPersonByEmailHelper(ctx context.Context, email string, mserviceId int64) (*genericResponse, *pb.Person) {
+	person, err := s.store.Persons().GetPersonByEmail(ctx, normalizeEmail(email), mserviceId)
+	return s.storeErrorHelper(err), person
+}
+
+// Helper to get the person bound to an MService user id.
+func (s *projService) GetPersonByUserHelper(ctx context.Context, userId int64, mserviceId int64) (*genericResponse, *pb.Person) {
 	resp := &genericResponse{}
 
-	sqlstring := `SELECT inbProjectId, inbTaskId, inbMemberId, dtmCreated, dtmModified, 
-	inbMserviceId, decTaskHours FROM tb_TaskToMember WHERE inbProjectId = ? AND inbMemberId = ? 
-	AND bitIsDeleted = 0`
+	if userId == 0 {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "no user id in token"
+		return resp, nil
+	}
 
-	stmt, err := s.db.Prepare(sqlstring)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Prepare", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = "db.Prepare failed"
+	person, err := s.store.Persons().GetPersonByUser(ctx, userId, mserviceId)
+	if errors.Is(err, projstore.ErrNotFound) {
+		resp.ErrorCode = 404
+		resp.ErrorMessage = "no person bound to user"
 		return resp, nil
 	}
 
-	defer stmt.Close()
+	return s.storeErrorHelper(err), person
+}
 
-	rows, err := stmt.Query(projectId, mserviceId)
-	if err != nil {
-		level.Error(s.logger).Log("what", "Query", "error", err)
-		resp.ErrorCode = 500
-		resp.ErrorMessage = err.Error()
+// Helper to create a person, or restore a deleted person with the same email address.
+func (s *projService) CreatePersonHelper(ctx context.Context, name string, email string, mserviceId int64) (*genericResponse, *pb.Person) {
+	resp := &genericResponse{}
+
+	email = normalizeEmail(email)
+	if (name == "") || (email == "") {
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "name and email are required"
 		return resp, nil
 	}
 
-	defer rows.Close()
-	for rows.Next() {
-		var created string
-		var modified string
-		var task_hours string
-		var t2m pb.TaskToMember
-
-		err := rows.Scan(&t2m.ProjectId, &t2m.TaskId, &t2m.MemberId, &created, &modified, &t2m.MserviceId, &task_hours)
-		if err != nil {
-			level.Error(s.logger).Log("what", "Scan", "error", err)
-			resp.ErrorCode = 500
-			resp.ErrorMessage = err.Error()
+	gResp, existing := s.GetPersonByEmailHelper(ctx, email, mserviceId)
+	if gResp.ErrorCode == 0 {
+		if !existing.GetIsDeleted() {
+			resp.ErrorCode = 510
+			resp.ErrorMessage = "person with email already exists"
 			return resp, nil
 		}
 
-		t2m.Created = dml.DateTimeFromString(created)
-		t2m.Modified = dml.DateTimeFromString(modified)
-		d, err := dml.DecimalFromString(task_hours)
-		if err == nil {
-			t2m.TaskHours = d
+		err := s.store.Persons().RestorePerson(ctx, existing.GetPersonId(), mserviceId, existing.GetVersion(), name)
+		if err != nil {
+			return s.storeErrorHelper(err), nil
 		}
 
-		mbrtasks = append(mbrtasks, &t2m)
+		existing.IsDeleted = false
+		existing.Version = existing.GetVersion() + 1
+		existing.Name = name
+		return resp, existing
+	} else if gResp.ErrorCode != 404 {
+		return gResp, nil
+	}
+
+	person := &pb.Person{MserviceId: mserviceId, Name: name, Email: email}
+
+	personId, err := s.store.Persons().CreatePerson(ctx, person)
+	if err != nil {
+		return s.storeErrorHelper(err), nil
+	}
+
+	level.Debug(s.logger).Log("personId", personId)
+
+	person.PersonId = personId
+	person.Version = 1
+	return resp, person
+}
+
+// Helper to resolve the person for a new team member, by id or else by email, creating the person if needed.
+func (s *projService) ResolvePersonHelper(ctx context.Context, personId int64, name string, email string,
+	mserviceId int64) (*genericResponse, *pb.Person) {
+	if personId != 0 {
+		return s.GetPersonByIdHelper(ctx, personId, mserviceId)
+	}
+
+	if normalizeEmail(email) == "" {
+		resp := &genericResponse{}
+		resp.ErrorCode = 510
+		resp.ErrorMessage = "email or person_id is required"
+		return resp, nil
+	}
+
+	gResp, person := s.GetPersonByEmailHelper(ctx, email, mserviceId)
+	if (gResp.ErrorCode == 0) && !person.GetIsDeleted() {
+		return gResp, person
+	} else if (gResp.ErrorCode != 0) && (gResp.ErrorCode != 404) {
+		return gResp, nil
+	}
+
+	return s.CreatePersonHelper(ctx, name, email, mserviceId)
+}
+
+// Email addresses are compared without case or surrounding spaces.
+func normalizeEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+// Helper to get the task assignments of a person across projects.
+func (s *projService) GetPersonTasksHelper(ctx context.Context, personId int64, mserviceId int64) (*genericResponse, []*pb.PersonTask) {
+	tasks, err := s.store.Persons().GetPersonTasks(ctx, personId, mserviceId)
+	return s.storeErrorHelper(err), tasks
+}
+
+// Helper to publish a change event for a project.
+func (s *projService) publishEvent(event *pb.ProjectEvent) {
+	s.events.publish(event)
+	level.Debug(s.logger).Log("event", event.GetEntityType(), "action", event.GetAction(),
+		"sequence", event.GetSequence())
+}
+
+// Helper to check the fields of a task being created or updated, returning the error message, or empty if valid.
+func validateTaskHelper(name string, description string, startDate *dml.DateTime, endDate *dml.DateTime) string {
+	switch {
+	case !nameValidator.MatchString(name):
+		return "name invalid format"
+	case strings.TrimSpace(description) == "":
+		return "description missing"
+	case (startDate == nil) || (endDate == nil):
+		return "start_date or end_date missing"
 	}
 
-	return resp, mbrtasks
+	return ""
 }
 
 // Helper to convert a Project to a ProjectWrapper.
@@ -0,0 +1,502 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projservice
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+	"github.com/go-kit/kit/log"
+
+	pb "github.com/gaterace/mproject/pkg/mserviceproject"
+	"github.com/gaterace/mproject/pkg/projhook"
+	"github.com/gaterace/mproject/pkg/projstore"
+)
+
+const testMserviceId = int64(7)
+
+// Response with an in-band error.
+type errorResponse interface {
+	GetErrorCode() int32
+	GetErrorMessage() string
+}
+
+// Helper to get a projService on a new memory store, with a status type and a project role type.
+func newMemoryService(t *testing.T) (*projService, projstore.Store) {
+	t.Helper()
+
+	store := projstore.NewMemoryStore()
+
+	s := NewProjectService()
+	s.SetLogger(log.NewNopLogger())
+	s.SetStore(store)
+
+	ctx := context.Background()
+	checkResponse(t, "CreateStatusType")(s.CreateStatusType(ctx, &pb.CreateStatusTypeRequest{
+		MserviceId: testMserviceId, StatusId: 1, StatusName: "open", Description: "open"}))
+	checkResponse(t, "CreateProjectRoleType")(s.CreateProjectRoleType(ctx, &pb.CreateProjectRoleTypeRequest{
+		MserviceId: testMserviceId, ProjectRoleId: 1, RoleName: "developer", Description: "developer"}))
+
+	return s, store
+}
+
+// Helper to fail the test if a call returns an error or an error code.
+func checkResponse(t *testing.T, what string) func(errorResponse, error) {
+	t.Helper()
+
+	return func(resp errorResponse, err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatalf("%s: %v", what, err)
+		}
+		if resp.GetErrorCode() != 0 {
+			t.Fatalf("%s: %d %s", what, resp.GetErrorCode(), resp.GetErrorMessage())
+		}
+	}
+}
+
+// Helper to create a project, returning its id.
+func createTestProject(t *testing.T, s *projService, name string) int64 {
+	t.Helper()
+
+	resp, err := s.CreateProject(context.Background(), &pb.CreateProjectRequest{MserviceId: testMserviceId,
+		Name: name, Description: "test project", StatusId: 1,
+		StartDate: dml.DateTimeFromString("2030-01-07"), EndDate: dml.DateTimeFromString("2030-03-01")})
+	checkResponse(t, "CreateProject")(resp, err)
+
+	return resp.GetProjectId()
+}
+
+// Helper to create a task, returning its id.
+func createTestTask(t *testing.T, s *projService, projectId int64, name string, parentId int64) int64 {
+	t.Helper()
+
+	resp, err := s.CreateTask(context.Background(), &pb.CreateTaskRequest{MserviceId: testMserviceId,
+		ProjectId: projectId, Name: name, Description: "test task", StatusId: 1, ParentId: parentId,
+		StartDate: dml.DateTimeFromString("2030-01-07"), EndDate: dml.DateTimeFromString("2030-01-18")})
+	checkResponse(t, "CreateTask")(resp, err)
+
+	return resp.GetTaskId()
+}
+
+// Helper to create a team member, returning its id.
+func createTestMember(t *testing.T, s *projService, projectId int64, name string, email string) int64 {
+	t.Helper()
+
+	resp, err := s.CreateTeamMember(context.Background(), &pb.CreateTeamMemberRequest{MserviceId: testMserviceId,
+		ProjectId: projectId, Name: name, ProjectRoleId: 1, Email: email})
+	checkResponse(t, "CreateTeamMember")(resp, err)
+
+	return resp.GetMemberId()
+}
+
+func TestMemoryProjectVersions(t *testing.T) {
+	s, _ := newMemoryService(t)
+	ctx := context.Background()
+
+	projectId := createTestProject(t, s, "alpha")
+
+	dup, err := s.CreateProject(ctx, &pb.CreateProjectRequest{MserviceId: testMserviceId, Name: "alpha",
+		Description: "again", StatusId: 1, StartDate: dml.DateTimeFromString("2030-01-07"),
+		EndDate: dml.DateTimeFromString("2030-03-01")})
+	if (err != nil) || (dup.GetErrorCode() == 0) {
+		t.Fatalf("duplicate project name accepted: %v %v", dup, err)
+	}
+
+	update := &pb.UpdateProjectRequest{MserviceId: testMserviceId, ProjectId: projectId, Version: 1, Name: "beta",
+		Description: "renamed", StatusId: 1, StartDate: dml.DateTimeFromString("2030-01-07"),
+		EndDate: dml.DateTimeFromString("2030-03-01")}
+
+	updated, err := s.UpdateProject(ctx, update)
+	checkResponse(t, "UpdateProject")(updated, err)
+	if updated.GetVersion() != 2 {
+		t.Errorf("version after update: got %d, want 2", updated.GetVersion())
+	}
+
+	stale, err := s.UpdateProject(ctx, update)
+	if (err != nil) || (stale.GetErrorCode() != 404) {
+		t.Errorf("stale update: got %d %v, want 404", stale.GetErrorCode(), err)
+	}
+
+	project, err := s.GetProjectByName(ctx, &pb.GetProjectByNameRequest{MserviceId: testMserviceId, Name: "beta"})
+	checkResponse(t, "GetProjectByName")(project, err)
+	if project.GetProject().GetProjectId() != projectId {
+		t.Errorf("project by name: got %d, want %d", project.GetProject().GetProjectId(), projectId)
+	}
+
+	deleted, err := s.DeleteProject(ctx, &pb.DeleteProjectRequest{MserviceId: testMserviceId, ProjectId: projectId,
+		Version: 2})
+	checkResponse(t, "DeleteProject")(deleted, err)
+
+	gone, err := s.GetProjectById(ctx, &pb.GetProjectByIdRequest{MserviceId: testMserviceId, ProjectId: projectId})
+	if (err != nil) || (gone.GetErrorCode() != 404) {
+		t.Errorf("deleted project: got %d %v, want 404", gone.GetErrorCode(), err)
+	}
+
+	// the unique key on the name covers deleted projects
+	reused, err := s.CreateProject(ctx, &pb.CreateProjectRequest{MserviceId: testMserviceId, Name: "beta",
+		Description: "again", StatusId: 1, StartDate: dml.DateTimeFromString("2030-01-07"),
+		EndDate: dml.DateTimeFromString("2030-03-01")})
+	if (err != nil) || (reused.GetErrorCode() != 501) {
+		t.Errorf("name of deleted project: got %d %v, want 501", reused.GetErrorCode(), err)
+	}
+
+	// servers returning gRPC statuses tell version conflicts and duplicates apart
+	s.SetStatusMode(true)
+
+	update.ProjectId = createTestProject(t, s, "gamma")
+	update.Name = "gamma"
+	update.Version = 2
+	stale, err = s.UpdateProject(ctx, update)
+	if (err != nil) || (stale.GetErrorCode() != 412) {
+		t.Errorf("stale update with status mode: got %d %v, want 412", stale.GetErrorCode(), err)
+	}
+
+	reused, err = s.CreateProject(ctx, &pb.CreateProjectRequest{MserviceId: testMserviceId, Name: "beta",
+		Description: "again", StatusId: 1, StartDate: dml.DateTimeFromString("2030-01-07"),
+		EndDate: dml.DateTimeFromString("2030-03-01")})
+	if (err != nil) || (reused.GetErrorCode() != 409) {
+		t.Errorf("name of deleted project with status mode: got %d %v, want 409", reused.GetErrorCode(), err)
+	}
+}
+
+func TestMemoryTaskAssignments(t *testing.T) {
+	s, _ := newMemoryService(t)
+	ctx := context.Background()
+
+	projectId := createTestProject(t, s, "alpha")
+	taskId := createTestTask(t, s, projectId, "build", 0)
+	memberId := createTestMember(t, s, projectId, "ann", "ann@example.com")
+
+	added, err := s.AddTeamMemberToTask(ctx, &pb.AddTeamMemberToTaskRequest{MserviceId: testMserviceId,
+		TaskId: taskId, MemberId: memberId})
+	checkResponse(t, "AddTeamMemberToTask")(added, err)
+
+	again, err := s.AddTeamMemberToTask(ctx, &pb.AddTeamMemberToTaskRequest{MserviceId: testMserviceId,
+		TaskId: taskId, MemberId: memberId})
+	if (err != nil) || (again.GetErrorCode() == 0) {
+		t.Errorf("member added twice: %v %v", again, err)
+	}
+
+	hours, _ := dml.DecimalFromString("12.50")
+	stale, err := s.AddTaskHours(ctx, &pb.AddTaskHoursRequest{MserviceId: testMserviceId, TaskId: taskId,
+		MemberId: memberId, Version: added.GetVersion() + 1, TaskHours: hours})
+	if (err != nil) || (stale.GetErrorCode() != 404) {
+		t.Errorf("stale hours: got %d %v, want 404", stale.GetErrorCode(), err)
+	}
+
+	recorded, err := s.AddTaskHours(ctx, &pb.AddTaskHoursRequest{MserviceId: testMserviceId, TaskId: taskId,
+		MemberId: memberId, Version: added.GetVersion(), TaskHours: hours})
+	checkResponse(t, "AddTaskHours")(recorded, err)
+
+	members, err := s.GetTeamMemberByTask(ctx, &pb.GetTeamMemberByTaskRequest{MserviceId: testMserviceId,
+		TaskId: taskId})
+	checkResponse(t, "GetTeamMemberByTask")(members, err)
+	if len(members.GetTeamMembers()) != 1 {
+		t.Fatalf("members of task: got %d, want 1", len(members.GetTeamMembers()))
+	}
+
+	member := members.GetTeamMembers()[0]
+	if member.GetTaskHours().StringFromDecimal() != "12.50" {
+		t.Errorf("task hours: got %s, want 12.50", member.GetTaskHours().StringFromDecimal())
+	}
+	if member.GetAssignmentVersion() != recorded.GetVersion() {
+		t.Errorf("assignment version: got %d, want %d", member.GetAssignmentVersion(), recorded.GetVersion())
+	}
+
+	removed, err := s.RemoveTeamMemberFromTask(ctx, &pb.RemoveTeamMemberFromTaskRequest{MserviceId: testMserviceId,
+		TaskId: taskId, MemberId: memberId, Version: member.GetAssignmentVersion()})
+	checkResponse(t, "RemoveTeamMemberFromTask")(removed, err)
+
+	members, err = s.GetTeamMemberByTask(ctx, &pb.GetTeamMemberByTaskRequest{MserviceId: testMserviceId,
+		TaskId: taskId})
+	checkResponse(t, "GetTeamMemberByTask")(members, err)
+	if len(members.GetTeamMembers()) != 0 {
+		t.Errorf("members of task after remove: got %d, want 0", len(members.GetTeamMembers()))
+	}
+
+	readded, err := s.AddTeamMemberToTask(ctx, &pb.AddTeamMemberToTaskRequest{MserviceId: testMserviceId,
+		TaskId: taskId, MemberId: memberId})
+	checkResponse(t, "AddTeamMemberToTask")(readded, err)
+	if readded.GetVersion() <= removed.GetVersion() {
+		t.Errorf("version of restored assignment: got %d, want more than %d", readded.GetVersion(),
+			removed.GetVersion())
+	}
+
+	// the hours recorded before the member was removed are kept
+	members, err = s.GetTeamMemberByTask(ctx, &pb.GetTeamMemberByTaskRequest{MserviceId: testMserviceId,
+		TaskId: taskId})
+	checkResponse(t, "GetTeamMemberByTask")(members, err)
+	if (len(members.GetTeamMembers()) != 1) ||
+		(members.GetTeamMembers()[0].GetTaskHours().StringFromDecimal() != "12.50") {
+		t.Errorf("members of task after restore: got %v, want one with 12.50 hours", members.GetTeamMembers())
+	}
+}
+
+func TestMemoryPersonsAndGrants(t *testing.T) {
+	s, _ := newMemoryService(t)
+	ctx := context.Background()
+
+	alpha := createTestProject(t, s, "alpha")
+	beta := createTestProject(t, s, "beta")
+	createTestMember(t, s, alpha, "ann", "ann@example.com")
+	createTestMember(t, s, beta, "ann", "Ann@Example.com")
+
+	persons, err := s.GetPersons(ctx, &pb.GetPersonsRequest{MserviceId: testMserviceId})
+	checkResponse(t, "GetPersons")(persons, err)
+	if len(persons.GetPersons()) != 1 {
+		t.Fatalf("persons: got %d, want 1", len(persons.GetPersons()))
+	}
+
+	projects, err := s.GetPersonProjects(ctx, &pb.GetPersonProjectsRequest{MserviceId: testMserviceId,
+		PersonId: persons.GetPersons()[0].GetPersonId()})
+	checkResponse(t, "GetPersonProjects")(projects, err)
+	if len(projects.GetProjects()) != 2 {
+		t.Errorf("projects of person: got %d, want 2", len(projects.GetProjects()))
+	}
+
+	grant, err := s.CreateProjectGrant(ctx, &pb.CreateProjectGrantRequest{MserviceId: testMserviceId,
+		ProjectId: alpha, UserId: 42, Access: "editor"})
+	checkResponse(t, "CreateProjectGrant")(grant, err)
+
+	dup, err := s.CreateProjectGrant(ctx, &pb.CreateProjectGrantRequest{MserviceId: testMserviceId,
+		ProjectId: alpha, UserId: 42, Access: "viewer"})
+	if (err != nil) || (dup.GetErrorCode() == 0) {
+		t.Errorf("duplicate grant accepted: %v %v", dup, err)
+	}
+
+	grants, err := s.GetProjectGrants(ctx, &pb.GetProjectGrantsRequest{MserviceId: testMserviceId, ProjectId: alpha})
+	checkResponse(t, "GetProjectGrants")(grants, err)
+	if (len(grants.GetGrants()) != 1) || (grants.GetGrants()[0].GetAccess() != "editor") {
+		t.Errorf("grants: got %v, want one editor grant", grants.GetGrants())
+	}
+}
+
+func TestMemoryWebhookDeliveries(t *testing.T) {
+	s, store := newMemoryService(t)
+	ctx := context.Background()
+
+	dispatcher := projhook.NewDispatcher()
+	dispatcher.SetLogger(log.NewNopLogger())
+	dispatcher.SetStore(store)
+	dispatcher.SetMaxAttempts(1)
+
+	s.SetWebhookDispatcher(dispatcher)
+	s.AddEventListener(dispatcher.HandleEvent)
+
+	local := &pb.CreateWebhookRequest{MserviceId: testMserviceId, Url: "http://127.0.0.1:8080/hook",
+		EventTypes: []string{projhook.EventTaskCreated}, IsActive: false}
+	refused, err := s.CreateWebhook(ctx, local)
+	if (err != nil) || (refused.GetErrorCode() != 510) {
+		t.Errorf("local webhook: got %d %v, want 510", refused.GetErrorCode(), err)
+	}
+
+	dispatcher.SetAllowLocal(true)
+	allowed, err := s.CreateWebhook(ctx, local)
+	checkResponse(t, "CreateWebhook")(allowed, err)
+	dispatcher.SetAllowLocal(false)
+	dispatcher.Start()
+
+	webhook, err := s.CreateWebhook(ctx, &pb.CreateWebhookRequest{MserviceId: testMserviceId,
+		Url: "https://hooks.example.invalid/mproject", EventTypes: []string{projhook.EventTaskCreated}, IsActive: true})
+	checkResponse(t, "CreateWebhook")(webhook, err)
+
+	projectId := createTestProject(t, s, "alpha")
+	createTestTask(t, s, projectId, "build", 0)
+
+	// the delivery is logged by the dispatcher goroutine
+	var deliveries *pb.GetWebhookDeliveriesResponse
+	for i := 0; i < 100; i++ {
+		deliveries, err = s.GetWebhookDeliveries(ctx, &pb.GetWebhookDeliveriesRequest{MserviceId: testMserviceId,
+			WebhookId: webhook.GetWebhookId()})
+		checkResponse(t, "GetWebhookDeliveries")(deliveries, err)
+		if len(deliveries.GetDeliveries()) > 0 {
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if len(deliveries.GetDeliveries()) != 1 {
+		t.Fatalf("deliveries: got %d, want 1", len(deliveries.GetDeliveries()))
+	}
+
+	if deliveries.GetDeliveries()[0].GetEventType() != projhook.EventTaskCreated {
+		t.Errorf("event type: got %s, want %s", deliveries.GetDeliveries()[0].GetEventType(),
+			projhook.EventTaskCreated)
+	}
+}
+
+func TestAddTaskHoursValidation(t *testing.T) {
+	s, _ := newMemoryService(t)
+	ctx := context.Background()
+
+	projectId := createTestProject(t, s, "alpha")
+	taskId := createTestTask(t, s, projectId, "build", 0)
+	memberId := createTestMember(t, s, projectId, "ann", "ann@example.com")
+
+	added, err := s.AddTeamMemberToTask(ctx, &pb.AddTeamMemberToTaskRequest{MserviceId: testMserviceId,
+		TaskId: taskId, MemberId: memberId})
+	checkResponse(t, "AddTeamMemberToTask")(added, err)
+
+	// negative hours correct earlier entries; self service callers are limited to positive hours by authorization
+	tests := []struct {
+		name  string
+		hours string
+		want  int32
+	}{
+		{"missing", "", 510},
+		{"positive", "2.25", 0},
+		{"negative", "-2.00", 0},
+		{"zero", "0.00", 0},
+	}
+
+	version := added.GetVersion()
+	for _, tt := range tests {
+		var hours *dml.Decimal
+		if tt.hours != "" {
+			// built directly, since DecimalFromString does not accept a sign
+			hours = &dml.Decimal{Plaintext: tt.hours}
+		}
+
+		resp, err := s.AddTaskHours(ctx, &pb.AddTaskHoursRequest{MserviceId: testMserviceId, TaskId: taskId,
+			MemberId: memberId, Version: version, TaskHours: hours})
+		if (err != nil) || (resp.GetErrorCode() != tt.want) {
+			t.Errorf("%s: got %d %v, want %d", tt.name, resp.GetErrorCode(), err, tt.want)
+		}
+
+		if resp.GetErrorCode() == 0 {
+			version = resp.GetVersion()
+		}
+	}
+}
+
+func TestCreateApiKeyUser(t *testing.T) {
+	s, _ := newMemoryService(t)
+	ctx := context.Background()
+
+	projectId := createTestProject(t, s, "alpha")
+	createTestMember(t, s, projectId, "ann", "ann@example.com")
+
+	persons, err := s.GetPersons(ctx, &pb.GetPersonsRequest{MserviceId: testMserviceId})
+	checkResponse(t, "GetPersons")(persons, err)
+
+	bound, err := s.SetPersonUser(ctx, &pb.SetPersonUserRequest{MserviceId: testMserviceId,
+		PersonId: persons.GetPersons()[0].GetPersonId(), Version: persons.GetPersons()[0].GetVersion(), UserId: 42})
+	checkResponse(t, "SetPersonUser")(bound, err)
+
+	tests := []struct {
+		name   string
+		userId int64
+		want   int32
+	}{
+		{"no user", 0, 0},
+		{"bound user", 42, 0},
+		{"unbound user", 43, 510},
+	}
+
+	for _, tt := range tests {
+		resp, err := s.CreateApiKey(ctx, &pb.CreateApiKeyRequest{MserviceId: testMserviceId, Name: tt.name,
+			Role: "projuser", UserId: tt.userId, Expires: dml.DateTimeFromTime(time.Now().Add(24 * time.Hour))})
+		if (err != nil) || (resp.GetErrorCode() != tt.want) {
+			t.Errorf("%s: got %d %v, want %d", tt.name, resp.GetErrorCode(), err, tt.want)
+		}
+	}
+}
+
+func TestTaskValidation(t *testing.T) {
+	s, _ := newMemoryService(t)
+	ctx := context.Background()
+
+	projectId := createTestProject(t, s, "alpha")
+	taskId := createTestTask(t, s, projectId, "build", 0)
+
+	start := dml.DateTimeFromString("2030-01-07")
+	end := dml.DateTimeFromString("2030-01-18")
+
+	tests := []struct {
+		name        string
+		taskName    string
+		description string
+		startDate   *dml.DateTime
+		endDate     *dml.DateTime
+		want        string
+	}{
+		{"bad name", "Build Task", "test task", start, end, "name invalid format"},
+		{"no description", "build", " ", start, end, "description missing"},
+		{"no start date", "build", "test task", nil, end, "start_date or end_date missing"},
+		{"no end date", "build", "test task", start, nil, "start_date or end_date missing"},
+	}
+
+	// the single and batch rpcs reject the same tasks with the same messages
+	for _, tt := range tests {
+		created, err := s.CreateTask(ctx, &pb.CreateTaskRequest{MserviceId: testMserviceId, ProjectId: projectId,
+			Name: tt.taskName, Description: tt.description, StatusId: 1, StartDate: tt.startDate, EndDate: tt.endDate})
+		if (err != nil) || (created.GetErrorCode() != 510) || (created.GetErrorMessage() != tt.want) {
+			t.Errorf("CreateTask %s: got %d %s, want 510 %s", tt.name, created.GetErrorCode(),
+				created.GetErrorMessage(), tt.want)
+		}
+
+		updated, err := s.UpdateTask(ctx, &pb.UpdateTaskRequest{MserviceId: testMserviceId, TaskId: taskId,
+			Version: 1, Name: tt.taskName, Description: tt.description, StatusId: 1, StartDate: tt.startDate,
+			EndDate: tt.endDate})
+		if (err != nil) || (updated.GetErrorCode() != 510) || (updated.GetErrorMessage() != tt.want) {
+			t.Errorf("UpdateTask %s: got %d %s, want 510 %s", tt.name, updated.GetErrorCode(),
+				updated.GetErrorMessage(), tt.want)
+		}
+
+		batch, err := s.BatchCreateTasks(ctx, &pb.BatchCreateTasksRequest{MserviceId: testMserviceId,
+			ProjectId: projectId, Tasks: []*pb.BatchTask{{TempId: 1, Name: tt.taskName, Description: tt.description,
+				StatusId: 1, StartDate: tt.startDate, EndDate: tt.endDate}}})
+		if (err != nil) || (len(batch.GetResults()) != 1) || (batch.GetResults()[0].GetErrorMessage() != tt.want) {
+			t.Errorf("BatchCreateTasks %s: got %v %v, want %s", tt.name, batch.GetResults(), err, tt.want)
+		}
+	}
+}
+
+func TestUpdateTaskEvent(t *testing.T) {
+	s, _ := newMemoryService(t)
+	ctx := context.Background()
+
+	checkResponse(t, "CreateStatusType")(s.CreateStatusType(ctx, &pb.CreateStatusTypeRequest{
+		MserviceId: testMserviceId, StatusId: 2, StatusName: "done", Description: "done"}))
+
+	projectId := createTestProject(t, s, "alpha")
+	taskId := createTestTask(t, s, projectId, "build", 0)
+
+	update := &pb.UpdateTaskRequest{MserviceId: testMserviceId, TaskId: taskId, Version: 1, Name: "build",
+		Description: "test task", StatusId: 2, StartDate: dml.DateTimeFromString("2030-01-07"),
+		EndDate: dml.DateTimeFromString("2030-01-18")}
+
+	updated, err := s.UpdateTask(ctx, update)
+	checkResponse(t, "UpdateTask")(updated, err)
+
+	event := s.events.history[len(s.events.history)-1]
+	if (event.GetAction() != ActionUpdate) || (event.GetStatusId() != 2) || (event.GetPreviousStatusId() != 1) {
+		t.Errorf("event: got %s status %d from %d, want update status 2 from 1", event.GetAction(),
+			event.GetStatusId(), event.GetPreviousStatusId())
+	}
+
+	// a stale update publishes nothing
+	published := len(s.events.history)
+	stale, err := s.UpdateTask(ctx, update)
+	if (err != nil) || (stale.GetErrorCode() != 404) {
+		t.Errorf("stale update: got %d %v, want 404", stale.GetErrorCode(), err)
+	}
+
+	if len(s.events.history) != published {
+		t.Errorf("events after stale update: got %d, want %d", len(s.events.history), published)
+	}
+}
@@ -0,0 +1,388 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projstore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/pgconn"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+// SQL differences between the supported database drivers. Statements in mproject are written with ?
+// placeholders and portable SQL, and rewritten by the dialect where the drivers differ.
+type Dialect struct {
+	// database driver, mysql, postgres or sqlite
+	Driver string
+	// name of the database/sql driver
+	sqlDriver string
+	// numbered $1, $2 placeholders instead of ?
+	numbered bool
+	// new ids from INSERT ... RETURNING instead of LastInsertId
+	returning bool
+	// CURRENT_TIMESTAMP instead of NOW()
+	noNow bool
+}
+
+// Get the dialect for a database driver.
+func NewDialect(driver string) (*Dialect, error) {
+	switch driver {
+	case "", "mysql":
+		return &Dialect{Driver: "mysql", sqlDriver: "mysql"}, nil
+	case "postgres":
+		return &Dialect{Driver: "postgres", sqlDriver: "pgx", numbered: true, returning: true}, nil
+	case "sqlite":
+		return &Dialect{Driver: "sqlite", sqlDriver: "sqlite", noNow: true}, nil
+	}
+
+	return nil, fmt.Errorf("unknown db_driver: %s", driver)
+}
+
+// Rewrite a statement with ? placeholders for the driver.
+func (d *Dialect) Rebind(sqlstring string) string {
+	if d.noNow {
+		sqlstring = strings.ReplaceAll(sqlstring, "NOW()", "CURRENT_TIMESTAMP")
+	}
+
+	if !d.numbered {
+		return sqlstring
+	}
+
+	var sb strings.Builder
+	n := 0
+	quoted := false
+	for _, c := range sqlstring {
+		if c == '\'' {
+			quoted = !quoted
+		}
+
+		if (c == '?') && !quoted {
+			n++
+			sb.WriteString("$" + strconv.Itoa(n))
+		} else {
+			sb.WriteRune(c)
+		}
+	}
+
+	return sb.String()
+}
+
+// Get the clause to update the row of an INSERT that conflicts with the key columns, setting the
+// comma separated assignments.
+func (d *Dialect) Upsert(key string, assignments string) string {
+	if d.Driver == "mysql" {
+		return " ON DUPLICATE KEY UPDATE " + assignments
+	}
+
+	return " ON CONFLICT (" + key + ") DO UPDATE SET " + assignments
+}
+
+// Get the value a column would have had in the row of an INSERT, for use in Upsert assignments.
+func (d *Dialect) Inserted(column string) string {
+	if d.Driver == "mysql" {
+		return "VALUES(" + column + ")"
+	}
+
+	return "EXCLUDED." + column
+}
+
+// Get the expression for the time a number of seconds before now, comparable with DATETIME columns set by NOW().
+func (d *Dialect) Ago(seconds int) string {
+	switch d.Driver {
+	case "postgres":
+		return "(NOW() - INTERVAL '" + strconv.Itoa(seconds) + " seconds')"
+	case "sqlite":
+		return "datetime('now', '-" + strconv.Itoa(seconds) + " seconds')"
+	}
+
+	return "(NOW() - INTERVAL " + strconv.Itoa(seconds) + " SECOND)"
+}
+
+// Get the expression for the time a number of seconds after now, comparable with DATETIME columns set by NOW().
+func (d *Dialect) Later(seconds int) string {
+	switch d.Driver {
+	case "postgres":
+		return "(NOW() + INTERVAL '" + strconv.Itoa(seconds) + " seconds')"
+	case "sqlite":
+		return "datetime('now', '+" + strconv.Itoa(seconds) + " seconds')"
+	}
+
+	return "(NOW() + INTERVAL " + strconv.Itoa(seconds) + " SECOND)"
+}
+
+// Check whether an error from the driver is a violation of a unique or primary key.
+func (d *Dialect) IsDuplicate(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	var pgErr *pgconn.PgError
+	var sqliteErr *sqlite.Error
+
+	switch {
+	case errors.As(err, &mysqlErr):
+		return mysqlErr.Number == 1062
+	case errors.As(err, &pgErr):
+		return pgErr.Code == "23505"
+	case errors.As(err, &sqliteErr):
+		return (sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_UNIQUE) ||
+			(sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY)
+	}
+
+	return false
+}
+
+// Get the clause that locks the rows a SELECT returns until the end of the transaction. sqlite has no row locks,
+// its write transactions lock the database as they begin.
+func (d *Dialect) ForUpdate() string {
+	if d.Driver == "sqlite" {
+		return ""
+	}
+
+	return " FOR UPDATE"
+}
+
+// Database connection whose statements are rewritten for its dialect, with an optional cache of prepared statements
+// and optional read replicas.
+type DB struct {
+	*sql.DB
+	Dialect *Dialect
+	// prepared statements kept for reuse, nil if disabled
+	stmts *stmtCache
+	// read replicas, and the one to try first for the next read
+	replicas    []*replica
+	nextReplica atomic.Uint64
+	// closed to stop the replica health checks
+	done chan struct{}
+}
+
+// Open a database connection for a driver.
+func OpenDB(driver string, dsn string) (*DB, error) {
+	dialect, err := NewDialect(driver)
+	if err != nil {
+		return nil, err
+	}
+
+	sqlDb, err := sql.Open(dialect.sqlDriver, dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DB{DB: sqlDb, Dialect: dialect}, nil
+}
+
+// Close the cached prepared statements, the read replicas and the database connection.
+func (db *DB) Close() error {
+	if db.done != nil {
+		close(db.done)
+	}
+
+	for _, r := range db.replicas {
+		r.db.Close()
+	}
+
+	if db.stmts != nil {
+		db.stmts.close()
+	}
+
+	return db.DB.Close()
+}
+
+func (db *DB) Prepare(query string) (*Stmt, error) {
+	return db.PrepareContext(context.Background(), query)
+}
+
+// Prepare a statement, from the statement cache if enabled. Closing a cached statement leaves it open for reuse.
+func (db *DB) PrepareContext(ctx context.Context, query string) (*Stmt, error) {
+	query = db.Dialect.Rebind(query)
+	if db.stmts != nil {
+		stmt, err := db.stmts.get(ctx, db.DB, query)
+		if (err != nil) || (stmt != nil) {
+			return stmt, err
+		}
+	}
+
+	stmt, err := db.DB.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Stmt{Stmt: stmt}, nil
+}
+
+func (db *DB) Exec(query string, args ...interface{}) (sql.Result, error) {
+	return db.DB.Exec(db.Dialect.Rebind(query), args...)
+}
+
+func (db *DB) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	return db.DB.ExecContext(ctx, db.Dialect.Rebind(query), args...)
+}
+
+func (db *DB) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return db.DB.Query(db.Dialect.Rebind(query), args...)
+}
+
+func (db *DB) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return db.DB.QueryContext(ctx, db.Dialect.Rebind(query), args...)
+}
+
+func (db *DB) QueryRow(query string, args ...interface{}) *sql.Row {
+	return db.DB.QueryRow(db.Dialect.Rebind(query), args...)
+}
+
+func (db *DB) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	return db.DB.QueryRowContext(ctx, db.Dialect.Rebind(query), args...)
+}
+
+// Begin a transaction whose statements are rewritten for the dialect.
+func (db *DB) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error) {
+	tx, err := db.DB.BeginTx(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Tx{Tx: tx, Dialect: db.Dialect, db: db}, nil
+}
+
+// Database transaction whose statements are rewritten for its dialect.
+type Tx struct {
+	*sql.Tx
+	Dialect *Dialect
+	// connection the transaction was begun on
+	db *DB
+}
+
+func (tx *Tx) Prepare(query string) (*Stmt, error) {
+	return tx.PrepareContext(context.Background(), query)
+}
+
+// Prepare a statement in the transaction, from the statement cache of its DB if enabled.
+func (tx *Tx) PrepareContext(ctx context.Context, query string) (*Stmt, error) {
+	query = tx.Dialect.Rebind(query)
+	if tx.db.stmts != nil {
+		shared, err := tx.db.stmts.get(ctx, tx.db.DB, query)
+		if err != nil {
+			return nil, err
+		}
+
+		// the transaction's copy of a cached statement is closed with the transaction
+		if shared != nil {
+			return &Stmt{Stmt: tx.Tx.StmtContext(ctx, shared.Stmt)}, nil
+		}
+	}
+
+	stmt, err := tx.Tx.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Stmt{Stmt: stmt}, nil
+}
+
+func (tx *Tx) Exec(query string, args ...interface{}) (sql.Result, error) {
+	return tx.Tx.Exec(tx.Dialect.Rebind(query), args...)
+}
+
+func (tx *Tx) ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error) {
+	return tx.Tx.ExecContext(ctx, tx.Dialect.Rebind(query), args...)
+}
+
+func (tx *Tx) Query(query string, args ...interface{}) (*sql.Rows, error) {
+	return tx.Tx.Query(tx.Dialect.Rebind(query), args...)
+}
+
+func (tx *Tx) QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error) {
+	return tx.Tx.QueryContext(ctx, tx.Dialect.Rebind(query), args...)
+}
+
+func (tx *Tx) QueryRow(query string, args ...interface{}) *sql.Row {
+	return tx.Tx.QueryRow(tx.Dialect.Rebind(query), args...)
+}
+
+func (tx *Tx) QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row {
+	return tx.Tx.QueryRowContext(ctx, tx.Dialect.Rebind(query), args...)
+}
+
+// Prepare an INSERT in the transaction into a table whose generated id column is idColumn.
+func (tx *Tx) PrepareInsertContext(ctx context.Context, query string, idColumn string) (*InsertStmt, error) {
+	if tx.Dialect.returning {
+		query = query + " RETURNING " + idColumn
+	}
+
+	stmt, err := tx.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &InsertStmt{Stmt: stmt, returning: tx.Dialect.returning}, nil
+}
+
+// Prepared INSERT into a table with a generated id column, reporting the new id from LastInsertId.
+type InsertStmt struct {
+	*Stmt
+	returning bool
+}
+
+// Prepare an INSERT into a table whose generated id column is idColumn.
+func (db *DB) PrepareInsert(query string, idColumn string) (*InsertStmt, error) {
+	return db.PrepareInsertContext(context.Background(), query, idColumn)
+}
+
+func (db *DB) PrepareInsertContext(ctx context.Context, query string, idColumn string) (*InsertStmt, error) {
+	if db.Dialect.returning {
+		query = query + " RETURNING " + idColumn
+	}
+
+	stmt, err := db.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &InsertStmt{Stmt: stmt, returning: db.Dialect.returning}, nil
+}
+
+func (s *InsertStmt) Exec(args ...interface{}) (sql.Result, error) {
+	return s.ExecContext(context.Background(), args...)
+}
+
+func (s *InsertStmt) ExecContext(ctx context.Context, args ...interface{}) (sql.Result, error) {
+	if !s.returning {
+		return s.Stmt.ExecContext(ctx, args...)
+	}
+
+	var id int64
+	err := s.Stmt.QueryRowContext(ctx, args...).Scan(&id)
+	if err != nil {
+		return nil, err
+	}
+
+	return insertResult(id), nil
+}
+
+// Result of an INSERT ... RETURNING of one row.
+type insertResult int64
+
+func (r insertResult) LastInsertId() (int64, error) {
+	return int64(r), nil
+}
+
+func (r insertResult) RowsAffected() (int64, error) {
+	return 1, nil
+}
@@ -0,0 +1,119 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projstore
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/gaterace/mproject/pkg/mserviceproject"
+)
+
+// Api keys in memory.
+type memApiKeys struct {
+	*memStore
+}
+
+func (s *memApiKeys) CreateApiKey(ctx context.Context, apiKey *pb.ApiKey, keyHash string) (int64, error) {
+	s.lock()
+	defer s.unlock()
+
+	for _, row := range s.apiKeys {
+		if row.keyHash == keyHash {
+			return 0, memDuplicate("tb_ApiKey", "chvKeyHash")
+		}
+	}
+
+	s.lastApiKeyId++
+	now := memNow()
+
+	s.apiKeys[s.lastApiKeyId] = &memApiKey{apiKey: &pb.ApiKey{
+		ApiKeyId:   s.lastApiKeyId,
+		Created:    now,
+		Modified:   now,
+		Version:    1,
+		MserviceId: apiKey.GetMserviceId(),
+		Name:       apiKey.GetName(),
+		KeyPrefix:  apiKey.GetKeyPrefix(),
+		Role:       apiKey.GetRole(),
+		Scopes:     append([]string(nil), apiKey.GetScopes()...),
+		UserId:     apiKey.GetUserId(),
+		Expires:    memDate(apiKey.GetExpires()),
+	}, keyHash: keyHash}
+
+	return s.lastApiKeyId, nil
+}
+
+func (s *memApiKeys) RevokeApiKey(ctx context.Context, apiKeyId int64, mserviceId int64, version int32) error {
+	s.lock()
+	defer s.unlock()
+
+	row, ok := s.apiKeys[apiKeyId]
+	if !ok || row.deleted || (row.apiKey.GetMserviceId() != mserviceId) {
+		return ErrNotFound
+	} else if row.apiKey.GetVersion() != version {
+		return ErrVersionConflict
+	}
+
+	row.deleted = true
+	row.apiKey.Version = version + 1
+
+	return nil
+}
+
+func (s *memApiKeys) GetApiKeys(ctx context.Context, mserviceId int64) ([]*pb.ApiKey, error) {
+	s.rlock()
+	defer s.runlock()
+
+	apiKeys := make([]*pb.ApiKey, 0)
+	for _, row := range s.apiKeys {
+		if !row.deleted && (row.apiKey.GetMserviceId() == mserviceId) {
+			apiKeys = append(apiKeys, proto.Clone(row.apiKey).(*pb.ApiKey))
+		}
+	}
+
+	sort.Slice(apiKeys, func(i, j int) bool { return apiKeys[i].GetApiKeyId() < apiKeys[j].GetApiKeyId() })
+
+	return apiKeys, nil
+}
+
+func (s *memApiKeys) GetApiKeyByHash(ctx context.Context, keyHash string) (*pb.ApiKey, bool, error) {
+	s.rlock()
+	defer s.runlock()
+
+	for _, row := range s.apiKeys {
+		if !row.deleted && (row.keyHash == keyHash) {
+			current := row.apiKey.GetExpires().TimeFromDateTime().After(time.Now())
+			return proto.Clone(row.apiKey).(*pb.ApiKey), current, nil
+		}
+	}
+
+	return nil, false, ErrNotFound
+}
+
+func (s *memApiKeys) TouchApiKey(ctx context.Context, apiKeyId int64) error {
+	s.lock()
+	defer s.unlock()
+
+	row, ok := s.apiKeys[apiKeyId]
+	if ok && ((row.apiKey.GetLastUsed() == nil) ||
+		(time.Since(row.apiKey.GetLastUsed().TimeFromDateTime()) >= apiKeyTouchSeconds*time.Second)) {
+		row.apiKey.LastUsed = memNow()
+	}
+
+	return nil
+}
@@ -0,0 +1,182 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projstore
+
+import (
+	"context"
+	"sort"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+	sdec "github.com/shopspring/decimal"
+
+	pb "github.com/gaterace/mproject/pkg/mserviceproject"
+)
+
+// Task assignments in memory.
+type memAssignments struct {
+	*memStore
+}
+
+// Helper to get an assignment that is not deleted and is at version. Must be called with the lock held.
+func (s *memAssignments) find(projectId int64, taskId int64, memberId int64, mserviceId int64,
+	version int32) (*memAssignment, error) {
+	row, ok := s.assignments[memAssignmentKey{projectId, taskId, memberId}]
+	if !ok || row.deleted || (row.t2m.GetMserviceId() != mserviceId) {
+		return nil, ErrNotFound
+	} else if row.t2m.GetVersion() != version {
+		return nil, ErrVersionConflict
+	}
+
+	return row, nil
+}
+
+// Helper to convert task hours to a DECIMAL(19,2) column value, failing as the database would for a number
+// it cannot read.
+func memTaskHours(taskHours *dml.Decimal) (sdec.Decimal, error) {
+	d, err := sdec.NewFromString(taskHours.StringFromDecimal())
+	if err != nil {
+		return d, &DbError{What: "Exec", Err: err}
+	}
+
+	return d.Round(2), nil
+}
+
+func (s *memAssignments) AddAssignment(ctx context.Context, projectId int64, taskId int64, memberId int64,
+	mserviceId int64) (int32, error) {
+	s.lock()
+	defer s.unlock()
+
+	key := memAssignmentKey{projectId, taskId, memberId}
+	now := memNow()
+
+	row, ok := s.assignments[key]
+	if !ok {
+		s.assignments[key] = &memAssignment{t2m: &pb.TaskToMember{
+			ProjectId:  projectId,
+			TaskId:     taskId,
+			MemberId:   memberId,
+			Created:    now,
+			Modified:   now,
+			Version:    1,
+			MserviceId: mserviceId,
+		}}
+
+		return 1, nil
+	}
+
+	// might have previously deleted, reuse with the task hours recorded before it was removed
+	if !row.deleted || (row.t2m.GetMserviceId() != mserviceId) {
+		return 0, ErrNotFound
+	}
+
+	row.deleted = false
+	row.t2m.Created = now
+	row.t2m.Modified = now
+	row.t2m.Version++
+
+	return row.t2m.GetVersion(), nil
+}
+
+func (s *memAssignments) RemoveAssignment(ctx context.Context, projectId int64, taskId int64, memberId int64,
+	mserviceId int64, version int32) error {
+	s.lock()
+	defer s.unlock()
+
+	row, err := s.find(projectId, taskId, memberId, mserviceId, version)
+	if err != nil {
+		return err
+	}
+
+	row.deleted = true
+	row.t2m.Version++
+
+	return nil
+}
+
+func (s *memAssignments) AddTaskHours(ctx context.Context, projectId int64, taskId int64, memberId int64,
+	mserviceId int64, version int32, taskHours *dml.Decimal) error {
+	hours, err := memTaskHours(taskHours)
+	if err != nil {
+		return err
+	}
+
+	s.lock()
+	defer s.unlock()
+
+	row, err := s.find(projectId, taskId, memberId, mserviceId, version)
+	if err != nil {
+		return err
+	}
+
+	row.t2m.Modified = memNow()
+	row.t2m.Version++
+	row.taskHours = row.taskHours.Add(hours)
+
+	return nil
+}
+
+func (s *memAssignments) SetTaskHours(ctx context.Context, projectId int64, taskId int64, memberId int64,
+	mserviceId int64, version int32, taskHours *dml.Decimal) error {
+	hours, err := memTaskHours(taskHours)
+	if err != nil {
+		return err
+	}
+
+	s.lock()
+	defer s.unlock()
+
+	row, err := s.find(projectId, taskId, memberId, mserviceId, version)
+	if err != nil {
+		return err
+	}
+
+	row.t2m.Modified = memNow()
+	row.t2m.Version++
+	row.taskHours = hours
+
+	return nil
+}
+
+func (s *memAssignments) GetAssignmentsByProject(ctx context.Context, projectId int64,
+	mserviceId int64) ([]*pb.TaskToMember, error) {
+	s.rlock()
+	defer s.runlock()
+
+	mbrtasks := make([]*pb.TaskToMember, 0)
+	for key, row := range s.assignments {
+		if row.deleted || (key.projectId != projectId) || (row.t2m.GetMserviceId() != mserviceId) {
+			continue
+		}
+
+		mbrtasks = append(mbrtasks, &pb.TaskToMember{
+			ProjectId:  row.t2m.GetProjectId(),
+			TaskId:     row.t2m.GetTaskId(),
+			MemberId:   row.t2m.GetMemberId(),
+			Created:    memDate(row.t2m.GetCreated()),
+			Modified:   memDate(row.t2m.GetModified()),
+			Version:    row.t2m.GetVersion(),
+			MserviceId: row.t2m.GetMserviceId(),
+			TaskHours:  memHours(row.taskHours),
+		})
+	}
+
+	sort.Slice(mbrtasks, func(i, j int) bool {
+		if mbrtasks[i].GetTaskId() != mbrtasks[j].GetTaskId() {
+			return mbrtasks[i].GetTaskId() < mbrtasks[j].GetTaskId()
+		}
+		return mbrtasks[i].GetMemberId() < mbrtasks[j].GetMemberId()
+	})
+
+	return mbrtasks, nil
+}
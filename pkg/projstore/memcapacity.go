@@ -0,0 +1,243 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projstore
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+	sdec "github.com/shopspring/decimal"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/gaterace/mproject/pkg/mserviceproject"
+)
+
+// Team member capacity, days off and assignment estimates in memory.
+type memCapacity struct {
+	*memStore
+}
+
+func (s *memCapacity) SetMemberCapacity(ctx context.Context, capacity *pb.MemberCapacity) error {
+	s.lock()
+	defer s.unlock()
+
+	hoursPerDay, err := memTaskHours(capacity.GetHoursPerDay())
+	if err != nil {
+		return err
+	}
+
+	row, ok := s.capacities[capacity.GetMemberId()]
+
+	if capacity.GetVersion() == 0 {
+		if ok {
+			return memDuplicate("tb_MemberCapacity", "PRIMARY")
+		}
+
+		now := memNow()
+		s.capacities[capacity.GetMemberId()] = &pb.MemberCapacity{
+			MemberId:          capacity.GetMemberId(),
+			Created:           now,
+			Modified:          now,
+			Version:           1,
+			MserviceId:        capacity.GetMserviceId(),
+			ProjectId:         capacity.GetProjectId(),
+			HoursPerDay:       memHours(hoursPerDay),
+			AllocationPercent: capacity.GetAllocationPercent(),
+		}
+
+		return nil
+	}
+
+	if !ok || (row.GetMserviceId() != capacity.GetMserviceId()) {
+		return ErrNotFound
+	} else if row.GetVersion() != capacity.GetVersion() {
+		return ErrVersionConflict
+	}
+
+	row.Modified = memNow()
+	row.Version = capacity.GetVersion() + 1
+	row.HoursPerDay = memHours(hoursPerDay)
+	row.AllocationPercent = capacity.GetAllocationPercent()
+
+	return nil
+}
+
+func (s *memCapacity) GetMemberCapacity(ctx context.Context, memberId int64, mserviceId int64) (*pb.MemberCapacity, error) {
+	s.rlock()
+	defer s.runlock()
+
+	var capacity *pb.MemberCapacity
+	row, ok := s.capacities[memberId]
+	if ok && (row.GetMserviceId() == mserviceId) {
+		capacity = proto.Clone(row).(*pb.MemberCapacity)
+		capacity.ProjectId = 0
+	} else {
+		// capacity not yet set, report the defaults with version 0
+		hoursPerDay, _ := dml.DecimalFromString(DefaultHoursPerDay)
+		capacity = &pb.MemberCapacity{MemberId: memberId, MserviceId: mserviceId, HoursPerDay: hoursPerDay,
+			AllocationPercent: DefaultAllocationPercent}
+	}
+
+	today := time.Now().Format(dbDateFormat)
+	keys := make([]string, 0)
+	for key, day := range s.daysOff {
+		if (key.memberId == memberId) && (day.mserviceId == mserviceId) && (key.dayOff >= today) {
+			keys = append(keys, key.dayOff)
+		}
+	}
+
+	sort.Strings(keys)
+
+	for _, dayOff := range keys {
+		day := s.daysOff[memDayOffKey{memberId, dayOff}].day
+		capacity.DaysOff = append(capacity.DaysOff, proto.Clone(day).(*pb.MemberDayOff))
+	}
+
+	return capacity, nil
+}
+
+func (s *memCapacity) AddDayOff(ctx context.Context, memberId int64, mserviceId int64, dayOff time.Time,
+	reason string) error {
+	s.lock()
+	defer s.unlock()
+
+	key := memDayOffKey{memberId, dayOff.Format(dbDateFormat)}
+	s.daysOff[key] = &memDayOff{day: &pb.MemberDayOff{MemberId: memberId, DayOff: dml.DateTimeFromString(key.dayOff),
+		Reason: reason}, mserviceId: mserviceId}
+
+	return nil
+}
+
+func (s *memCapacity) RemoveDayOff(ctx context.Context, memberId int64, mserviceId int64, dayOff time.Time) error {
+	s.lock()
+	defer s.unlock()
+
+	key := memDayOffKey{memberId, dayOff.Format(dbDateFormat)}
+	day, ok := s.daysOff[key]
+	if !ok || (day.mserviceId != mserviceId) {
+		return ErrNotFound
+	}
+
+	delete(s.daysOff, key)
+
+	return nil
+}
+
+func (s *memCapacity) SetAssignmentEstimate(ctx context.Context, taskId int64, memberId int64, mserviceId int64,
+	estimatedHours sdec.Decimal) error {
+	s.lock()
+	defer s.unlock()
+
+	for key, row := range s.assignments {
+		if !row.deleted && (key.taskId == taskId) && (key.memberId == memberId) &&
+			(row.t2m.GetMserviceId() == mserviceId) {
+			s.estimates[key] = estimatedHours.Round(2)
+			return nil
+		}
+	}
+
+	return ErrNotFound
+}
+
+func (s *memCapacity) GetCapacityData(ctx context.Context, projectId int64, mserviceId int64, start time.Time,
+	end time.Time) ([]*MemberCapacity, []*MemberAssignment, error) {
+	s.rlock()
+	defer s.runlock()
+
+	capacities := make([]*MemberCapacity, 0)
+	assignments := make([]*MemberAssignment, 0)
+	defaultHours, _ := sdec.NewFromString(DefaultHoursPerDay)
+
+	for _, row := range s.members {
+		if row.deleted || (row.member.GetProjectId() != projectId) || (row.member.GetMserviceId() != mserviceId) {
+			continue
+		}
+
+		c := &MemberCapacity{MemberId: row.member.GetMemberId(), Name: row.member.GetName(), HoursPerDay: defaultHours,
+			AllocationPercent: DefaultAllocationPercent}
+		if capacity, ok := s.capacities[c.MemberId]; ok {
+			c.HoursPerDay, _ = memTaskHours(capacity.GetHoursPerDay())
+			c.AllocationPercent = capacity.GetAllocationPercent()
+		}
+
+		capacities = append(capacities, c)
+	}
+
+	sort.Slice(capacities, func(i, j int) bool { return capacities[i].MemberId < capacities[j].MemberId })
+
+	first := start.Format(dbDateFormat)
+	last := end.Format(dbDateFormat)
+	for _, c := range capacities {
+		for key, day := range s.daysOff {
+			if (key.memberId == c.MemberId) && (day.mserviceId == mserviceId) && (key.dayOff >= first) &&
+				(key.dayOff <= last) {
+				c.DaysOff = append(c.DaysOff, parseDbDateTime(key.dayOff))
+			}
+		}
+	}
+
+	// the estimate for a team member on a task takes precedence over recorded task hours
+	for key, row := range s.assignments {
+		if row.deleted || (key.projectId != projectId) || (row.t2m.GetMserviceId() != mserviceId) {
+			continue
+		}
+
+		task, ok := s.tasks[key.taskId]
+		if !ok || task.deleted {
+			continue
+		}
+
+		a := &MemberAssignment{MemberId: key.memberId, TaskId: key.taskId,
+			StartDate: task.task.GetStartDate().TimeFromDateTime(), EndDate: task.task.GetEndDate().TimeFromDateTime(),
+			Hours: row.taskHours}
+		if a.EndDate.Before(start) || a.StartDate.After(end) {
+			continue
+		}
+
+		if estimate, ok := s.estimates[key]; ok {
+			a.Hours = estimate
+		}
+
+		assignments = append(assignments, a)
+	}
+
+	return capacities, assignments, nil
+}
+
+func (s *memCapacity) GetTaskSchedules(ctx context.Context, projectId int64, mserviceId int64) ([]*TaskSchedule, error) {
+	s.rlock()
+	defer s.runlock()
+
+	schedules := make([]*TaskSchedule, 0)
+	for _, row := range s.tasks {
+		if row.deleted || (row.task.GetProjectId() != projectId) || (row.task.GetMserviceId() != mserviceId) {
+			continue
+		}
+
+		schedules = append(schedules, &TaskSchedule{
+			TaskId:    row.task.GetTaskId(),
+			Name:      row.task.GetName(),
+			StartDate: row.task.GetStartDate().TimeFromDateTime(),
+			EndDate:   row.task.GetEndDate().TimeFromDateTime(),
+			Priority:  row.task.GetPriority(),
+			ParentId:  row.task.GetParentId(),
+		})
+	}
+
+	sort.Slice(schedules, func(i, j int) bool { return schedules[i].TaskId < schedules[j].TaskId })
+
+	return schedules, nil
+}
@@ -0,0 +1,173 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projstore
+
+import (
+	"context"
+	"sort"
+
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/gaterace/mproject/pkg/mserviceproject"
+)
+
+// Project grants in memory.
+type memGrants struct {
+	*memStore
+}
+
+// Helper to get a project grant that is not deleted. Must be called with the lock held.
+func (s *memGrants) find(grantId int64, mserviceId int64) *memGrant {
+	row, ok := s.grants[grantId]
+	if !ok || row.deleted || (row.grant.GetMserviceId() != mserviceId) {
+		return nil
+	}
+
+	return row
+}
+
+func (s *memGrants) CreateGrant(ctx context.Context, grant *pb.ProjectGrant) (int64, error) {
+	s.lock()
+	defer s.unlock()
+
+	s.lastGrantId++
+	now := memNow()
+
+	s.grants[s.lastGrantId] = &memGrant{grant: &pb.ProjectGrant{
+		GrantId:       s.lastGrantId,
+		Created:       now,
+		Modified:      now,
+		Version:       1,
+		MserviceId:    grant.GetMserviceId(),
+		ProjectId:     grant.GetProjectId(),
+		UserId:        grant.GetUserId(),
+		ProjectRoleId: grant.GetProjectRoleId(),
+		Access:        GrantAccessNames[GrantAccessLevels[grant.GetAccess()]],
+	}}
+
+	return s.lastGrantId, nil
+}
+
+func (s *memGrants) UpdateGrant(ctx context.Context, grant *pb.ProjectGrant) error {
+	s.lock()
+	defer s.unlock()
+
+	row := s.find(grant.GetGrantId(), grant.GetMserviceId())
+	if row == nil {
+		return ErrNotFound
+	} else if row.grant.GetVersion() != grant.GetVersion() {
+		return ErrVersionConflict
+	}
+
+	row.grant.Modified = memNow()
+	row.grant.Version = grant.GetVersion() + 1
+	row.grant.Access = GrantAccessNames[GrantAccessLevels[grant.GetAccess()]]
+
+	return nil
+}
+
+func (s *memGrants) DeleteGrant(ctx context.Context, grantId int64, mserviceId int64, version int32) error {
+	s.lock()
+	defer s.unlock()
+
+	row := s.find(grantId, mserviceId)
+	if row == nil {
+		return ErrNotFound
+	} else if row.grant.GetVersion() != version {
+		return ErrVersionConflict
+	}
+
+	row.deleted = true
+	row.grant.Version = version + 1
+
+	return nil
+}
+
+func (s *memGrants) GrantExists(ctx context.Context, projectId int64, mserviceId int64, userId int64,
+	projectRoleId int32) (bool, error) {
+	s.rlock()
+	defer s.runlock()
+
+	for _, row := range s.grants {
+		if !row.deleted && (row.grant.GetProjectId() == projectId) && (row.grant.GetMserviceId() == mserviceId) &&
+			(row.grant.GetUserId() == userId) && (row.grant.GetProjectRoleId() == projectRoleId) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (s *memGrants) GetGrantsByProject(ctx context.Context, projectId int64, mserviceId int64) ([]*pb.ProjectGrant, error) {
+	s.rlock()
+	defer s.runlock()
+
+	grants := make([]*pb.ProjectGrant, 0)
+	for _, row := range s.grants {
+		if !row.deleted && (row.grant.GetProjectId() == projectId) && (row.grant.GetMserviceId() == mserviceId) {
+			grants = append(grants, proto.Clone(row.grant).(*pb.ProjectGrant))
+		}
+	}
+
+	sort.Slice(grants, func(i, j int) bool { return grants[i].GetGrantId() < grants[j].GetGrantId() })
+
+	return grants, nil
+}
+
+func (s *memGrants) GetGrantProject(ctx context.Context, grantId int64, mserviceId int64) (int64, error) {
+	s.rlock()
+	defer s.runlock()
+
+	row := s.find(grantId, mserviceId)
+	if row == nil {
+		return 0, ErrNotFound
+	}
+
+	return row.grant.GetProjectId(), nil
+}
+
+func (s *memGrants) GetGrantedAccess(ctx context.Context, projectId int64, mserviceId int64, userId int64) (int32, error) {
+	s.rlock()
+	defer s.runlock()
+
+	// roles of the user as a team member of the project
+	roles := make(map[int32]bool)
+	for _, row := range s.members {
+		if row.deleted || (row.member.GetProjectId() != projectId) {
+			continue
+		}
+
+		person, ok := s.persons[row.member.GetPersonId()]
+		if ok && !person.deleted && (person.person.GetUserId() == userId) {
+			roles[row.member.GetProjectRoleId()] = true
+		}
+	}
+
+	var granted int32
+	for _, row := range s.grants {
+		if row.deleted || (row.grant.GetProjectId() != projectId) || (row.grant.GetMserviceId() != mserviceId) {
+			continue
+		}
+
+		roleId := row.grant.GetProjectRoleId()
+		if (row.grant.GetUserId() == userId) || ((roleId > 0) && roles[roleId]) {
+			level := GrantAccessLevels[row.grant.GetAccess()]
+			if level > granted {
+				granted = level
+			}
+		}
+	}
+
+	return granted, nil
+}
@@ -0,0 +1,255 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projstore
+
+import (
+	"context"
+	"sort"
+
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/gaterace/mproject/pkg/mserviceproject"
+)
+
+// Team members and their notification preference in memory.
+type memMembers struct {
+	*memStore
+}
+
+// Helper to check the unique key of tb_TeamMember for a person. Must be called with the lock held.
+func (s *memMembers) personTaken(personId int64, projectId int64) bool {
+	for _, row := range s.members {
+		if (row.member.GetPersonId() == personId) && (row.member.GetProjectId() == projectId) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Helper to get a team member that is not deleted. Must be called with the lock held.
+func (s *memMembers) find(memberId int64, mserviceId int64) *memMember {
+	row, ok := s.members[memberId]
+	if !ok || row.deleted || (row.member.GetMserviceId() != mserviceId) {
+		return nil
+	}
+
+	return row
+}
+
+// Helper to get a copy of a team member with its role name, or nil if it has no role type. Must be called with
+// the lock held.
+func (s *memMembers) get(row *memMember) *pb.TeamMember {
+	roleName, ok := s.roleName(row.member.GetProjectRoleId(), row.member.GetMserviceId())
+	if !ok {
+		return nil
+	}
+
+	member := proto.Clone(row.member).(*pb.TeamMember)
+	member.RoleName = roleName
+
+	return member
+}
+
+// Helper to sort team members by id.
+func sortMembers(members []*pb.TeamMember) {
+	sort.Slice(members, func(i, j int) bool { return members[i].GetMemberId() < members[j].GetMemberId() })
+}
+
+func (s *memMembers) CreateMember(ctx context.Context, member *pb.TeamMember) (int64, error) {
+	s.lock()
+	defer s.unlock()
+
+	if s.personTaken(member.GetPersonId(), member.GetProjectId()) {
+		return 0, memDuplicate("tb_TeamMember", "inbPersonId")
+	}
+
+	s.lastMemberId++
+	now := memNow()
+
+	s.members[s.lastMemberId] = &memMember{member: &pb.TeamMember{
+		MemberId:      s.lastMemberId,
+		Created:       now,
+		Modified:      now,
+		Version:       1,
+		MserviceId:    member.GetMserviceId(),
+		ProjectId:     member.GetProjectId(),
+		Name:          member.GetName(),
+		ProjectRoleId: member.GetProjectRoleId(),
+		Email:         member.GetEmail(),
+		PersonId:      member.GetPersonId(),
+	}}
+
+	return s.lastMemberId, nil
+}
+
+func (s *memMembers) UpdateMember(ctx context.Context, member *pb.TeamMember) error {
+	s.lock()
+	defer s.unlock()
+
+	row := s.find(member.GetMemberId(), member.GetMserviceId())
+	if row == nil {
+		return ErrNotFound
+	} else if row.member.GetVersion() != member.GetVersion() {
+		return ErrVersionConflict
+	}
+
+	row.member.Modified = memNow()
+	row.member.Version = member.GetVersion() + 1
+	row.member.Name = member.GetName()
+	row.member.ProjectRoleId = member.GetProjectRoleId()
+	row.member.Email = member.GetEmail()
+
+	return nil
+}
+
+func (s *memMembers) DeleteMember(ctx context.Context, memberId int64, mserviceId int64, version int32) error {
+	s.lock()
+	defer s.unlock()
+
+	row := s.find(memberId, mserviceId)
+	if row == nil {
+		return ErrNotFound
+	} else if row.member.GetVersion() != version {
+		return ErrVersionConflict
+	}
+
+	row.deleted = true
+	row.member.Version = version + 1
+
+	return nil
+}
+
+func (s *memMembers) GetMemberById(ctx context.Context, memberId int64, mserviceId int64) (*pb.TeamMember, error) {
+	s.rlock()
+	defer s.runlock()
+
+	row := s.find(memberId, mserviceId)
+	if row == nil {
+		return nil, ErrNotFound
+	}
+
+	member := s.get(row)
+	if member == nil {
+		return nil, ErrNotFound
+	}
+
+	return member, nil
+}
+
+func (s *memMembers) GetMembersByProject(ctx context.Context, projectId int64, mserviceId int64) ([]*pb.TeamMember, error) {
+	s.rlock()
+	defer s.runlock()
+
+	members := make([]*pb.TeamMember, 0)
+	for _, row := range s.members {
+		if row.deleted || (row.member.GetProjectId() != projectId) || (row.member.GetMserviceId() != mserviceId) {
+			continue
+		}
+
+		member := s.get(row)
+		if member != nil {
+			members = append(members, member)
+		}
+	}
+
+	sortMembers(members)
+
+	return members, nil
+}
+
+func (s *memMembers) GetMembersByTask(ctx context.Context, projectId int64, taskId int64,
+	mserviceId int64) ([]*pb.TeamMember, error) {
+	s.rlock()
+	defer s.runlock()
+
+	members := make([]*pb.TeamMember, 0)
+	for key, assignment := range s.assignments {
+		if assignment.deleted || (key.projectId != projectId) || (key.taskId != taskId) ||
+			(assignment.t2m.GetMserviceId() != mserviceId) {
+			continue
+		}
+
+		row, ok := s.members[key.memberId]
+		if !ok || row.deleted {
+			continue
+		}
+
+		member := s.get(row)
+		if member != nil {
+			member.TaskHours = memHours(assignment.taskHours)
+			member.AssignmentVersion = assignment.t2m.GetVersion()
+			members = append(members, member)
+		}
+	}
+
+	sortMembers(members)
+
+	return members, nil
+}
+
+func (s *memMembers) GetMemberProject(ctx context.Context, memberId int64, mserviceId int64) (int64, int64, error) {
+	s.rlock()
+	defer s.runlock()
+
+	row := s.find(memberId, mserviceId)
+	if row == nil {
+		return 0, 0, ErrNotFound
+	}
+
+	return row.member.GetProjectId(), row.member.GetPersonId(), nil
+}
+
+func (s *memMembers) MemberExists(ctx context.Context, memberId int64, projectId int64, mserviceId int64) (bool, error) {
+	s.rlock()
+	defer s.runlock()
+
+	row := s.find(memberId, mserviceId)
+
+	return (row != nil) && (row.member.GetProjectId() == projectId), nil
+}
+
+func (s *memMembers) PersonIsMember(ctx context.Context, projectId int64, personId int64, mserviceId int64) (bool, error) {
+	s.rlock()
+	defer s.runlock()
+
+	for _, row := range s.members {
+		if !row.deleted && (row.member.GetProjectId() == projectId) && (row.member.GetPersonId() == personId) &&
+			(row.member.GetMserviceId() == mserviceId) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (s *memMembers) SetMemberNotify(ctx context.Context, memberId int64, mserviceId int64, optOut bool) error {
+	s.lock()
+	defer s.unlock()
+
+	s.notify[memberId] = optOut
+
+	return nil
+}
+
+func (s *memMembers) GetMemberNotify(ctx context.Context, memberId int64, mserviceId int64) (bool, error) {
+	s.rlock()
+	defer s.runlock()
+
+	if s.find(memberId, mserviceId) == nil {
+		return false, ErrNotFound
+	}
+
+	return s.notify[memberId], nil
+}
@@ -0,0 +1,216 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projstore
+
+import (
+	"context"
+	"sort"
+	"time"
+)
+
+// Email notifications of team members in memory.
+type memNotifications struct {
+	*memStore
+}
+
+// Helper to get the assigned members who have not opted out, with task details, for the assignments accepted by
+// match. Must be called with the lock held.
+func (s *memNotifications) getRecipients(match func(row *memAssignment, task *memTask) bool) []*Recipient {
+	recipients := make([]*Recipient, 0)
+
+	for _, row := range s.assignments {
+		if row.deleted {
+			continue
+		}
+
+		task, ok := s.tasks[row.t2m.GetTaskId()]
+		if !ok || task.deleted {
+			continue
+		}
+
+		project, ok := s.projects[task.task.GetProjectId()]
+		if !ok || project.deleted {
+			continue
+		}
+
+		member, ok := s.members[row.t2m.GetMemberId()]
+		if !ok || member.deleted || (member.member.GetEmail() == "") || s.notify[member.member.GetMemberId()] {
+			continue
+		}
+
+		if !match(row, task) {
+			continue
+		}
+
+		var statusName string
+		statusType, ok := s.statusTypes[memTypeKey{task.task.GetMserviceId(), task.task.GetStatusId()}]
+		if ok && !statusType.deleted {
+			statusName = statusType.statusType.GetStatusName()
+		}
+
+		recipients = append(recipients, &Recipient{
+			MserviceId:      row.t2m.GetMserviceId(),
+			ProjectId:       project.project.GetProjectId(),
+			ProjectName:     project.project.GetName(),
+			TaskId:          task.task.GetTaskId(),
+			TaskName:        task.task.GetName(),
+			TaskDescription: task.task.GetDescription(),
+			StatusName:      statusName,
+			EndDate:         task.task.GetEndDate().TimeFromDateTime(),
+			MemberId:        member.member.GetMemberId(),
+			MemberName:      member.member.GetName(),
+			Email:           member.member.GetEmail(),
+		})
+	}
+
+	sort.Slice(recipients, func(i, j int) bool {
+		if recipients[i].TaskId != recipients[j].TaskId {
+			return recipients[i].TaskId < recipients[j].TaskId
+		}
+		return recipients[i].MemberId < recipients[j].MemberId
+	})
+
+	return recipients
+}
+
+func (s *memNotifications) GetTaskRecipients(ctx context.Context, taskId int64, mserviceId int64,
+	memberId int64) ([]*Recipient, error) {
+	s.rlock()
+	defer s.runlock()
+
+	return s.getRecipients(func(row *memAssignment, task *memTask) bool {
+		return (row.t2m.GetTaskId() == taskId) && (row.t2m.GetMserviceId() == mserviceId) &&
+			((memberId == 0) || (row.t2m.GetMemberId() == memberId))
+	}), nil
+}
+
+func (s *memNotifications) GetDueRecipients(ctx context.Context, start time.Time, end time.Time,
+	kind string) ([]*Recipient, error) {
+	s.rlock()
+	defer s.runlock()
+
+	recipients := s.getRecipients(func(row *memAssignment, task *memTask) bool {
+		endDate := task.task.GetEndDate().TimeFromDateTime()
+		if endDate.Before(start) || endDate.After(end) {
+			return false
+		}
+
+		_, reminder := s.reminder(row.t2m.GetMemberId(), task, kind)
+		return (reminder == nil) || (!reminder.sent && !reminder.nextAttempt.IsZero() &&
+			!reminder.nextAttempt.After(time.Now()))
+	})
+
+	for _, recipient := range recipients {
+		id, reminder := s.reminder(recipient.MemberId, s.tasks[recipient.TaskId], kind)
+		if reminder != nil {
+			recipient.NotificationId = id
+			recipient.Attempts = reminder.attempts
+		}
+	}
+
+	return recipients, nil
+}
+
+func (s *memNotifications) LogNotification(ctx context.Context, notification *Notification) error {
+	s.lock()
+	defer s.unlock()
+
+	s.sent = append(s.sent, &memNotification{notification: *notification, created: time.Now().Truncate(time.Second),
+		sent: notification.LastError == "", attempts: 1})
+
+	return nil
+}
+
+func (s *memNotifications) ClaimReminder(ctx context.Context, notification *Notification, notificationId int64,
+	holdSeconds int) (int64, error) {
+	s.lock()
+	defer s.unlock()
+
+	if notificationId != 0 {
+		reminder := s.notification(notificationId)
+		if (reminder == nil) || (reminder.notification.MserviceId != notification.MserviceId) || reminder.sent ||
+			reminder.nextAttempt.IsZero() || reminder.nextAttempt.After(time.Now()) {
+			return 0, ErrNotFound
+		}
+
+		claimed := *reminder
+		claimed.nextAttempt = memLater(holdSeconds)
+		s.sent[notificationId-1] = &claimed
+
+		return notificationId, nil
+	}
+
+	task, ok := s.tasks[notification.TaskId]
+	if !ok || (task.task.GetMserviceId() != notification.MserviceId) {
+		return 0, ErrNotFound
+	}
+
+	if _, reminder := s.reminder(notification.MemberId, task, notification.Kind); reminder != nil {
+		return 0, ErrNotFound
+	}
+
+	s.sent = append(s.sent, &memNotification{notification: *notification, created: time.Now().Truncate(time.Second),
+		taskModified: task.task.GetModified().TimeFromDateTime(), nextAttempt: memLater(holdSeconds)})
+
+	return int64(len(s.sent)), nil
+}
+
+func (s *memNotifications) RecordReminder(ctx context.Context, notificationId int64, lastError string,
+	retrySeconds int) error {
+	s.lock()
+	defer s.unlock()
+
+	reminder := s.notification(notificationId)
+	if reminder == nil {
+		return ErrNotFound
+	}
+
+	// rows are replaced rather than changed, as a snapshot shares them
+	recorded := *reminder
+	recorded.notification.LastError = lastError
+	recorded.sent = lastError == ""
+	recorded.attempts++
+	recorded.nextAttempt = time.Time{}
+	if retrySeconds > 0 {
+		recorded.nextAttempt = memLater(retrySeconds)
+	}
+
+	s.sent[notificationId-1] = &recorded
+
+	return nil
+}
+
+// Helper to get a logged notification by its id, its position in the log, or nil if not found. Must be called with
+// the lock held.
+func (s *memNotifications) notification(notificationId int64) *memNotification {
+	if (notificationId < 1) || (notificationId > int64(len(s.sent))) {
+		return nil
+	}
+
+	return s.sent[notificationId-1]
+}
+
+// Helper to get the reminder of a kind logged for a team member's task as last modified, with its id, or nil if
+// none. Must be called with the lock held.
+func (s *memNotifications) reminder(memberId int64, task *memTask, kind string) (int64, *memNotification) {
+	modified := task.task.GetModified().TimeFromDateTime()
+	for i, row := range s.sent {
+		if (row.notification.MemberId == memberId) && (row.notification.TaskId == task.task.GetTaskId()) &&
+			(row.notification.Kind == kind) && !row.taskModified.IsZero() && row.taskModified.Equal(modified) {
+			return int64(i + 1), row
+		}
+	}
+
+	return 0, nil
+}
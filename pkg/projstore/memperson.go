@@ -0,0 +1,388 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projstore
+
+import (
+	"context"
+	"sort"
+
+	sdec "github.com/shopspring/decimal"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/gaterace/mproject/pkg/mserviceproject"
+)
+
+// Persons in memory.
+type memPersons struct {
+	*memStore
+}
+
+// Helper to check the unique keys of tb_Person for an email and a bound user id. Must be called with the lock held.
+func (s *memPersons) duplicate(email string, userId int64, mserviceId int64, personId int64) error {
+	for id, row := range s.persons {
+		if (id == personId) || (row.person.GetMserviceId() != mserviceId) {
+			continue
+		}
+
+		if row.person.GetEmail() == email {
+			return memDuplicate("tb_Person", "chvEmail")
+		}
+
+		if (userId != 0) && (row.person.GetUserId() == userId) {
+			return memDuplicate("tb_Person", "inbUserId")
+		}
+	}
+
+	return nil
+}
+
+// Helper to get a person, which if not deleted is true must not be deleted. Must be called with the lock held.
+func (s *memPersons) find(personId int64, mserviceId int64, notDeleted bool) *memPerson {
+	row, ok := s.persons[personId]
+	if !ok || (notDeleted && row.deleted) || (row.person.GetMserviceId() != mserviceId) {
+		return nil
+	}
+
+	return row
+}
+
+// Helper to get a copy of a person with its deleted flag. Must be called with the lock held.
+func (s *memPersons) get(row *memPerson) *pb.Person {
+	person := proto.Clone(row.person).(*pb.Person)
+	person.IsDeleted = row.deleted
+
+	return person
+}
+
+func (s *memPersons) CreatePerson(ctx context.Context, person *pb.Person) (int64, error) {
+	s.lock()
+	defer s.unlock()
+
+	err := s.duplicate(person.GetEmail(), 0, person.GetMserviceId(), 0)
+	if err != nil {
+		return 0, err
+	}
+
+	s.lastPersonId++
+	now := memNow()
+
+	s.persons[s.lastPersonId] = &memPerson{person: &pb.Person{
+		PersonId:   s.lastPersonId,
+		Created:    now,
+		Modified:   now,
+		Version:    1,
+		MserviceId: person.GetMserviceId(),
+		Name:       person.GetName(),
+		Email:      person.GetEmail(),
+	}}
+
+	return s.lastPersonId, nil
+}
+
+func (s *memPersons) RestorePerson(ctx context.Context, personId int64, mserviceId int64, version int32,
+	name string) error {
+	s.lock()
+	defer s.unlock()
+
+	row := s.find(personId, mserviceId, false)
+	if (row == nil) || !row.deleted {
+		return ErrNotFound
+	} else if row.person.GetVersion() != version {
+		return ErrVersionConflict
+	}
+
+	row.deleted = false
+	row.person.Modified = memNow()
+	row.person.Version = version + 1
+	row.person.Name = name
+
+	return nil
+}
+
+func (s *memPersons) UpdatePerson(ctx context.Context, person *pb.Person) error {
+	s.lock()
+	defer s.unlock()
+
+	row := s.find(person.GetPersonId(), person.GetMserviceId(), true)
+	if row == nil {
+		return ErrNotFound
+	} else if row.person.GetVersion() != person.GetVersion() {
+		return ErrVersionConflict
+	}
+
+	err := s.duplicate(person.GetEmail(), 0, person.GetMserviceId(), person.GetPersonId())
+	if err != nil {
+		return err
+	}
+
+	row.person.Modified = memNow()
+	row.person.Version = person.GetVersion() + 1
+	row.person.Name = person.GetName()
+	row.person.Email = person.GetEmail()
+
+	return nil
+}
+
+func (s *memPersons) DeletePerson(ctx context.Context, personId int64, mserviceId int64, version int32) error {
+	s.lock()
+	defer s.unlock()
+
+	row := s.find(personId, mserviceId, true)
+	if row == nil {
+		return ErrNotFound
+	} else if row.person.GetVersion() != version {
+		return ErrVersionConflict
+	}
+
+	row.deleted = true
+	row.person.Deleted = memNow()
+	row.person.Version = version + 1
+
+	return nil
+}
+
+func (s *memPersons) SetPersonUser(ctx context.Context, personId int64, mserviceId int64, version int32,
+	userId int64) error {
+	s.lock()
+	defer s.unlock()
+
+	row := s.find(personId, mserviceId, true)
+	if row == nil {
+		return ErrNotFound
+	} else if row.person.GetVersion() != version {
+		return ErrVersionConflict
+	}
+
+	err := s.duplicate("", userId, mserviceId, personId)
+	if err != nil {
+		return err
+	}
+
+	row.person.Modified = memNow()
+	row.person.Version = version + 1
+	row.person.UserId = userId
+
+	return nil
+}
+
+func (s *memPersons) GetPersonById(ctx context.Context, personId int64, mserviceId int64) (*pb.Person, error) {
+	s.rlock()
+	defer s.runlock()
+
+	row := s.find(personId, mserviceId, true)
+	if row == nil {
+		return nil, ErrNotFound
+	}
+
+	return s.get(row), nil
+}
+
+func (s *memPersons) GetPersonByEmail(ctx context.Context, email string, mserviceId int64) (*pb.Person, error) {
+	s.rlock()
+	defer s.runlock()
+
+	for _, row := range s.persons {
+		if (row.person.GetEmail() == email) && (row.person.GetMserviceId() == mserviceId) {
+			return s.get(row), nil
+		}
+	}
+
+	return nil, ErrNotFound
+}
+
+func (s *memPersons) GetPersonByUser(ctx context.Context, userId int64, mserviceId int64) (*pb.Person, error) {
+	s.rlock()
+	defer s.runlock()
+
+	for _, row := range s.persons {
+		if !row.deleted && (userId != 0) && (row.person.GetUserId() == userId) &&
+			(row.person.GetMserviceId() == mserviceId) {
+			return s.get(row), nil
+		}
+	}
+
+	return nil, ErrNotFound
+}
+
+func (s *memPersons) GetPersons(ctx context.Context, mserviceId int64) ([]*pb.Person, error) {
+	s.rlock()
+	defer s.runlock()
+
+	persons := make([]*pb.Person, 0)
+	for _, row := range s.persons {
+		if !row.deleted && (row.person.GetMserviceId() == mserviceId) {
+			persons = append(persons, s.get(row))
+		}
+	}
+
+	sort.Slice(persons, func(i, j int) bool { return persons[i].GetName() < persons[j].GetName() })
+
+	return persons, nil
+}
+
+// Helper to get the team members of a person that are not deleted, by id. Must be called with the lock held.
+func (s *memPersons) personMembers(personId int64, mserviceId int64) []*memMember {
+	members := make([]*memMember, 0)
+	for _, row := range s.members {
+		if !row.deleted && (row.member.GetPersonId() == personId) && (row.member.GetMserviceId() == mserviceId) {
+			members = append(members, row)
+		}
+	}
+
+	sort.Slice(members, func(i, j int) bool {
+		return members[i].member.GetMemberId() < members[j].member.GetMemberId()
+	})
+
+	return members
+}
+
+func (s *memPersons) CountPersonMembers(ctx context.Context, personId int64, mserviceId int64) (int, error) {
+	s.rlock()
+	defer s.runlock()
+
+	return len(s.personMembers(personId, mserviceId)), nil
+}
+
+func (s *memPersons) GetPersonProjects(ctx context.Context, personId int64, mserviceId int64) ([]*pb.PersonProject, error) {
+	s.rlock()
+	defer s.runlock()
+
+	projects := make([]*pb.PersonProject, 0)
+	for _, row := range s.personMembers(personId, mserviceId) {
+		project, ok := s.projects[row.member.GetProjectId()]
+		if !ok || project.deleted {
+			continue
+		}
+
+		hours := sdec.Zero
+		for key, assignment := range s.assignments {
+			if !assignment.deleted && (key.memberId == row.member.GetMemberId()) {
+				hours = hours.Add(assignment.taskHours)
+			}
+		}
+
+		roleName, _ := s.roleName(row.member.GetProjectRoleId(), mserviceId)
+
+		projects = append(projects, &pb.PersonProject{
+			MemberId:      row.member.GetMemberId(),
+			ProjectId:     row.member.GetProjectId(),
+			ProjectName:   project.project.GetName(),
+			ProjectRoleId: row.member.GetProjectRoleId(),
+			RoleName:      roleName,
+			TaskHours:     memHours(hours),
+		})
+	}
+
+	sort.SliceStable(projects, func(i, j int) bool { return projects[i].GetProjectName() < projects[j].GetProjectName() })
+
+	return projects, nil
+}
+
+func (s *memPersons) GetPersonTasks(ctx context.Context, personId int64, mserviceId int64) ([]*pb.PersonTask, error) {
+	s.rlock()
+	defer s.runlock()
+
+	tasks := make([]*pb.PersonTask, 0)
+	for _, row := range s.personMembers(personId, mserviceId) {
+		for key, assignment := range s.assignments {
+			if assignment.deleted || (key.memberId != row.member.GetMemberId()) {
+				continue
+			}
+
+			task, ok := s.tasks[key.taskId]
+			if !ok || task.deleted {
+				continue
+			}
+
+			project, ok := s.projects[task.task.GetProjectId()]
+			if !ok || project.deleted {
+				continue
+			}
+
+			statusName, _ := s.statusName(task.task.GetStatusId(), task.task.GetMserviceId())
+
+			tasks = append(tasks, &pb.PersonTask{
+				MemberId:    row.member.GetMemberId(),
+				ProjectId:   task.task.GetProjectId(),
+				ProjectName: project.project.GetName(),
+				TaskId:      task.task.GetTaskId(),
+				TaskName:    task.task.GetName(),
+				StatusId:    task.task.GetStatusId(),
+				StatusName:  statusName,
+				StartDate:   memDate(task.task.GetStartDate()),
+				EndDate:     memDate(task.task.GetEndDate()),
+				TaskHours:   memHours(assignment.taskHours),
+			})
+		}
+	}
+
+	sort.Slice(tasks, func(i, j int) bool {
+		ei := tasks[i].GetEndDate().TimeFromDateTime()
+		ej := tasks[j].GetEndDate().TimeFromDateTime()
+		if !ei.Equal(ej) {
+			return ei.Before(ej)
+		}
+		return tasks[i].GetTaskId() < tasks[j].GetTaskId()
+	})
+
+	return tasks, nil
+}
+
+func (s *memPersons) SyncPersonMembers(ctx context.Context, personId int64, mserviceId int64) error {
+	s.lock()
+	defer s.unlock()
+
+	person := s.find(personId, mserviceId, false)
+	if person == nil {
+		return nil
+	}
+
+	for _, row := range s.personMembers(personId, mserviceId) {
+		if (row.member.GetName() == person.person.GetName()) && (row.member.GetEmail() == person.person.GetEmail()) {
+			continue
+		}
+
+		row.member.Modified = memNow()
+		row.member.Name = person.person.GetName()
+		row.member.Email = person.person.GetEmail()
+	}
+
+	return nil
+}
+
+func (s *memPersons) UpdatePersonFromMember(ctx context.Context, memberId int64, personId int64, mserviceId int64) error {
+	s.lock()
+	defer s.unlock()
+
+	person := s.find(personId, mserviceId, false)
+	member, ok := s.members[memberId]
+	if (person == nil) || !ok || (member.member.GetPersonId() != personId) {
+		return nil
+	}
+
+	if (member.member.GetName() == person.person.GetName()) && (member.member.GetEmail() == person.person.GetEmail()) {
+		return nil
+	}
+
+	err := s.duplicate(member.member.GetEmail(), 0, mserviceId, personId)
+	if err != nil {
+		return err
+	}
+
+	person.person.Modified = memNow()
+	person.person.Name = member.member.GetName()
+	person.person.Email = member.member.GetEmail()
+
+	return nil
+}
@@ -0,0 +1,194 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projstore
+
+import (
+	"context"
+	"sort"
+
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/gaterace/mproject/pkg/mserviceproject"
+)
+
+// Projects in memory.
+type memProjects struct {
+	*memStore
+}
+
+// Helper to check the unique key of tb_Project for a project name. Must be called with the lock held.
+func (s *memProjects) nameTaken(name string, mserviceId int64, projectId int64) bool {
+	for id, row := range s.projects {
+		if (id != projectId) && (row.project.GetName() == name) && (row.project.GetMserviceId() == mserviceId) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Helper to get a project that is not deleted. Must be called with the lock held.
+func (s *memProjects) find(projectId int64, mserviceId int64) *memProject {
+	row, ok := s.projects[projectId]
+	if !ok || row.deleted || (row.project.GetMserviceId() != mserviceId) {
+		return nil
+	}
+
+	return row
+}
+
+// Helper to get a copy of a project with its status name, or nil if it has no status type. Must be called with
+// the lock held.
+func (s *memProjects) get(row *memProject) *pb.Project {
+	statusName, ok := s.statusName(row.project.GetStatusId(), row.project.GetMserviceId())
+	if !ok {
+		return nil
+	}
+
+	project := proto.Clone(row.project).(*pb.Project)
+	project.StatusName = statusName
+
+	return project
+}
+
+func (s *memProjects) CreateProject(ctx context.Context, project *pb.Project) (int64, error) {
+	s.lock()
+	defer s.unlock()
+
+	if s.nameTaken(project.GetName(), project.GetMserviceId(), 0) {
+		return 0, memDuplicate("tb_Project", "chvName")
+	}
+
+	s.lastProjectId++
+	now := memNow()
+
+	s.projects[s.lastProjectId] = &memProject{project: &pb.Project{
+		ProjectId:   s.lastProjectId,
+		Created:     now,
+		Modified:    now,
+		Version:     1,
+		MserviceId:  project.GetMserviceId(),
+		Name:        project.GetName(),
+		Description: project.GetDescription(),
+		StatusId:    project.GetStatusId(),
+		StartDate:   memDate(project.GetStartDate()),
+		EndDate:     memDate(project.GetEndDate()),
+	}}
+
+	return s.lastProjectId, nil
+}
+
+func (s *memProjects) UpdateProject(ctx context.Context, project *pb.Project) error {
+	s.lock()
+	defer s.unlock()
+
+	row := s.find(project.GetProjectId(), project.GetMserviceId())
+	if row == nil {
+		return ErrNotFound
+	} else if row.project.GetVersion() != project.GetVersion() {
+		return ErrVersionConflict
+	}
+
+	if s.nameTaken(project.GetName(), project.GetMserviceId(), project.GetProjectId()) {
+		return memDuplicate("tb_Project", "chvName")
+	}
+
+	row.project.Modified = memNow()
+	row.project.Version = project.GetVersion() + 1
+	row.project.Name = project.GetName()
+	row.project.Description = project.GetDescription()
+	row.project.StatusId = project.GetStatusId()
+	row.project.StartDate = memDate(project.GetStartDate())
+	row.project.EndDate = memDate(project.GetEndDate())
+
+	return nil
+}
+
+func (s *memProjects) DeleteProject(ctx context.Context, projectId int64, mserviceId int64, version int32) error {
+	s.lock()
+	defer s.unlock()
+
+	row := s.find(projectId, mserviceId)
+	if row == nil {
+		return ErrNotFound
+	} else if row.project.GetVersion() != version {
+		return ErrVersionConflict
+	}
+
+	row.deleted = true
+	row.project.Version = version + 1
+
+	return nil
+}
+
+func (s *memProjects) GetProjectById(ctx context.Context, projectId int64, mserviceId int64) (*pb.Project, error) {
+	s.rlock()
+	defer s.runlock()
+
+	row := s.find(projectId, mserviceId)
+	if row == nil {
+		return nil, ErrNotFound
+	}
+
+	project := s.get(row)
+	if project == nil {
+		return nil, ErrNotFound
+	}
+
+	return project, nil
+}
+
+func (s *memProjects) GetProjectByName(ctx context.Context, name string, mserviceId int64) (*pb.Project, error) {
+	s.rlock()
+	defer s.runlock()
+
+	for _, row := range s.projects {
+		if !row.deleted && (row.project.GetName() == name) && (row.project.GetMserviceId() == mserviceId) {
+			project := s.get(row)
+			if project != nil {
+				return project, nil
+			}
+		}
+	}
+
+	return nil, ErrNotFound
+}
+
+func (s *memProjects) GetProjectNames(ctx context.Context, mserviceId int64) ([]string, error) {
+	s.rlock()
+	defer s.runlock()
+
+	projectIds := make([]int64, 0)
+	for id, row := range s.projects {
+		if !row.deleted && (row.project.GetMserviceId() == mserviceId) {
+			projectIds = append(projectIds, id)
+		}
+	}
+
+	sort.Slice(projectIds, func(i, j int) bool { return projectIds[i] < projectIds[j] })
+
+	names := make([]string, 0, len(projectIds))
+	for _, id := range projectIds {
+		names = append(names, s.projects[id].project.GetName())
+	}
+
+	return names, nil
+}
+
+func (s *memProjects) ProjectExists(ctx context.Context, projectId int64, mserviceId int64) (bool, error) {
+	s.rlock()
+	defer s.runlock()
+
+	return s.find(projectId, mserviceId) != nil, nil
+}
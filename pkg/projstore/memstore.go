@@ -0,0 +1,473 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projstore
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+	sdec "github.com/shopspring/decimal"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/gaterace/mproject/pkg/mserviceproject"
+)
+
+// Store in memory, for tests and demos. Rows are kept with their deleted flag and version as in the mproject
+// database, so deletes, version checks and unique keys behave as with the sql store. Nothing is persisted.
+type memStore struct {
+	*memData
+	// in a WithTx function, which holds the lock for the whole transaction
+	inTx bool
+}
+
+// Tables of a memStore, shared with the Stores of its transactions.
+type memData struct {
+	mu sync.RWMutex
+
+	lastProjectId  int64
+	lastTaskId     int64
+	lastMemberId   int64
+	lastPersonId   int64
+	lastGrantId    int64
+	lastApiKeyId   int64
+	lastWebhookId  int64
+	lastDeliveryId int64
+
+	projects    map[int64]*memProject
+	tasks       map[int64]*memTask
+	members     map[int64]*memMember
+	statusTypes map[memTypeKey]*memStatusType
+	roleTypes   map[memTypeKey]*memRoleType
+	assignments map[memAssignmentKey]*memAssignment
+	notify      map[int64]bool
+	persons     map[int64]*memPerson
+	grants      map[int64]*memGrant
+	apiKeys     map[int64]*memApiKey
+	webhooks    map[int64]*memWebhook
+	deliveries  map[int64]*memDelivery
+	capacities  map[int64]*pb.MemberCapacity
+	daysOff     map[memDayOffKey]*memDayOff
+	estimates   map[memAssignmentKey]sdec.Decimal
+	sent        []*memNotification
+}
+
+// Row of tb_Project.
+type memProject struct {
+	project *pb.Project
+	deleted bool
+}
+
+// Row of tb_Task.
+type memTask struct {
+	task    *pb.Task
+	deleted bool
+}
+
+// Row of tb_TeamMember.
+type memMember struct {
+	member  *pb.TeamMember
+	deleted bool
+}
+
+// Row of tb_StatusType.
+type memStatusType struct {
+	statusType *pb.StatusType
+	deleted    bool
+}
+
+// Row of tb_ProjectRoleType.
+type memRoleType struct {
+	roleType *pb.ProjectRoleType
+	deleted  bool
+}
+
+// Row of tb_TaskToMember.
+type memAssignment struct {
+	t2m       *pb.TaskToMember
+	taskHours sdec.Decimal
+	deleted   bool
+}
+
+// Row of tb_Person.
+type memPerson struct {
+	person  *pb.Person
+	deleted bool
+}
+
+// Row of tb_ProjectGrant.
+type memGrant struct {
+	grant   *pb.ProjectGrant
+	deleted bool
+}
+
+// Row of tb_ApiKey.
+type memApiKey struct {
+	apiKey  *pb.ApiKey
+	keyHash string
+	deleted bool
+}
+
+// Row of tb_Webhook.
+type memWebhook struct {
+	webhook *pb.Webhook
+	secret  string
+	deleted bool
+}
+
+// Row of tb_WebhookDelivery, with a zero nextAttempt for a null dtmNextAttempt.
+type memDelivery struct {
+	delivery    *pb.WebhookDelivery
+	nextAttempt time.Time
+}
+
+// Row of tb_MemberDayOff.
+type memDayOff struct {
+	day        *pb.MemberDayOff
+	mserviceId int64
+}
+
+// Primary key of tb_MemberDayOff, with the day as YYYY-MM-DD.
+type memDayOffKey struct {
+	memberId int64
+	dayOff   string
+}
+
+// Row of tb_Notification.
+type memNotification struct {
+	notification Notification
+	created      time.Time
+	sent         bool
+	attempts     int32
+	taskModified time.Time
+	nextAttempt  time.Time
+}
+
+// Primary key of tb_StatusType and tb_ProjectRoleType.
+type memTypeKey struct {
+	mserviceId int64
+	typeId     int32
+}
+
+// Primary key of tb_TaskToMember.
+type memAssignmentKey struct {
+	projectId int64
+	taskId    int64
+	memberId  int64
+}
+
+// Get a new empty Store in memory.
+func NewMemoryStore() Store {
+	return &memStore{memData: &memData{
+		projects:    make(map[int64]*memProject),
+		tasks:       make(map[int64]*memTask),
+		members:     make(map[int64]*memMember),
+		statusTypes: make(map[memTypeKey]*memStatusType),
+		roleTypes:   make(map[memTypeKey]*memRoleType),
+		assignments: make(map[memAssignmentKey]*memAssignment),
+		notify:      make(map[int64]bool),
+		persons:     make(map[int64]*memPerson),
+		grants:      make(map[int64]*memGrant),
+		apiKeys:     make(map[int64]*memApiKey),
+		webhooks:    make(map[int64]*memWebhook),
+		deliveries:  make(map[int64]*memDelivery),
+		capacities:  make(map[int64]*pb.MemberCapacity),
+		daysOff:     make(map[memDayOffKey]*memDayOff),
+		estimates:   make(map[memAssignmentKey]sdec.Decimal),
+	}}
+}
+
+func (s *memStore) Projects() ProjectRepository {
+	return &memProjects{s}
+}
+
+func (s *memStore) Tasks() TaskRepository {
+	return &memTasks{s}
+}
+
+func (s *memStore) Members() MemberRepository {
+	return &memMembers{s}
+}
+
+func (s *memStore) Types() TypeRepository {
+	return &memTypes{s}
+}
+
+func (s *memStore) Assignments() AssignmentRepository {
+	return &memAssignments{s}
+}
+
+func (s *memStore) Persons() PersonRepository {
+	return &memPersons{s}
+}
+
+func (s *memStore) Grants() GrantRepository {
+	return &memGrants{s}
+}
+
+func (s *memStore) ApiKeys() ApiKeyRepository {
+	return &memApiKeys{s}
+}
+
+func (s *memStore) Webhooks() WebhookRepository {
+	return &memWebhooks{s}
+}
+
+func (s *memStore) Capacity() CapacityRepository {
+	return &memCapacity{s}
+}
+
+func (s *memStore) Notifications() NotificationRepository {
+	return &memNotifications{s}
+}
+
+// Transactions hold the write lock until they end, so they are serializable, and restore a copy of the tables
+// taken as they began on rollback.
+func (s *memStore) WithTx(ctx context.Context, fn func(tx Store) error) error {
+	if s.inTx {
+		return fn(s)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	saved := s.memData.clone()
+
+	err := fn(&memStore{memData: s.memData, inTx: true})
+	if err != nil {
+		s.memData.restore(saved)
+	}
+
+	return err
+}
+
+// Helper to take the write lock, unless held by the transaction.
+func (s *memStore) lock() {
+	if !s.inTx {
+		s.mu.Lock()
+	}
+}
+
+// Helper to release the write lock, unless held by the transaction.
+func (s *memStore) unlock() {
+	if !s.inTx {
+		s.mu.Unlock()
+	}
+}
+
+// Helper to take the read lock, unless the transaction holds the write lock.
+func (s *memStore) rlock() {
+	if !s.inTx {
+		s.mu.RLock()
+	}
+}
+
+// Helper to release the read lock, unless the transaction holds the write lock.
+func (s *memStore) runlock() {
+	if !s.inTx {
+		s.mu.RUnlock()
+	}
+}
+
+// Helper to copy the tables, with copies of their rows. Must be called with the lock held.
+func (d *memData) clone() *memData {
+	c := &memData{
+		lastProjectId:  d.lastProjectId,
+		lastTaskId:     d.lastTaskId,
+		lastMemberId:   d.lastMemberId,
+		lastPersonId:   d.lastPersonId,
+		lastGrantId:    d.lastGrantId,
+		lastApiKeyId:   d.lastApiKeyId,
+		lastWebhookId:  d.lastWebhookId,
+		lastDeliveryId: d.lastDeliveryId,
+		projects:       make(map[int64]*memProject, len(d.projects)),
+		tasks:          make(map[int64]*memTask, len(d.tasks)),
+		members:        make(map[int64]*memMember, len(d.members)),
+		statusTypes:    make(map[memTypeKey]*memStatusType, len(d.statusTypes)),
+		roleTypes:      make(map[memTypeKey]*memRoleType, len(d.roleTypes)),
+		assignments:    make(map[memAssignmentKey]*memAssignment, len(d.assignments)),
+		notify:         make(map[int64]bool, len(d.notify)),
+		persons:        make(map[int64]*memPerson, len(d.persons)),
+		grants:         make(map[int64]*memGrant, len(d.grants)),
+		apiKeys:        make(map[int64]*memApiKey, len(d.apiKeys)),
+		webhooks:       make(map[int64]*memWebhook, len(d.webhooks)),
+		deliveries:     make(map[int64]*memDelivery, len(d.deliveries)),
+		capacities:     make(map[int64]*pb.MemberCapacity, len(d.capacities)),
+		daysOff:        make(map[memDayOffKey]*memDayOff, len(d.daysOff)),
+		estimates:      make(map[memAssignmentKey]sdec.Decimal, len(d.estimates)),
+		sent:           append([]*memNotification(nil), d.sent...),
+	}
+
+	for id, row := range d.projects {
+		c.projects[id] = &memProject{project: proto.Clone(row.project).(*pb.Project), deleted: row.deleted}
+	}
+
+	for id, row := range d.tasks {
+		c.tasks[id] = &memTask{task: proto.Clone(row.task).(*pb.Task), deleted: row.deleted}
+	}
+
+	for id, row := range d.members {
+		c.members[id] = &memMember{member: proto.Clone(row.member).(*pb.TeamMember), deleted: row.deleted}
+	}
+
+	for key, row := range d.statusTypes {
+		c.statusTypes[key] = &memStatusType{statusType: proto.Clone(row.statusType).(*pb.StatusType),
+			deleted: row.deleted}
+	}
+
+	for key, row := range d.roleTypes {
+		c.roleTypes[key] = &memRoleType{roleType: proto.Clone(row.roleType).(*pb.ProjectRoleType),
+			deleted: row.deleted}
+	}
+
+	for key, row := range d.assignments {
+		c.assignments[key] = &memAssignment{t2m: proto.Clone(row.t2m).(*pb.TaskToMember), taskHours: row.taskHours,
+			deleted: row.deleted}
+	}
+
+	for id, optOut := range d.notify {
+		c.notify[id] = optOut
+	}
+
+	for id, row := range d.persons {
+		c.persons[id] = &memPerson{person: proto.Clone(row.person).(*pb.Person), deleted: row.deleted}
+	}
+
+	for id, row := range d.grants {
+		c.grants[id] = &memGrant{grant: proto.Clone(row.grant).(*pb.ProjectGrant), deleted: row.deleted}
+	}
+
+	for id, row := range d.apiKeys {
+		c.apiKeys[id] = &memApiKey{apiKey: proto.Clone(row.apiKey).(*pb.ApiKey), keyHash: row.keyHash,
+			deleted: row.deleted}
+	}
+
+	for id, row := range d.webhooks {
+		c.webhooks[id] = &memWebhook{webhook: proto.Clone(row.webhook).(*pb.Webhook), secret: row.secret,
+			deleted: row.deleted}
+	}
+
+	for id, row := range d.deliveries {
+		c.deliveries[id] = &memDelivery{delivery: proto.Clone(row.delivery).(*pb.WebhookDelivery),
+			nextAttempt: row.nextAttempt}
+	}
+
+	for id, capacity := range d.capacities {
+		c.capacities[id] = proto.Clone(capacity).(*pb.MemberCapacity)
+	}
+
+	for key, day := range d.daysOff {
+		c.daysOff[key] = &memDayOff{day: proto.Clone(day.day).(*pb.MemberDayOff), mserviceId: day.mserviceId}
+	}
+
+	for key, hours := range d.estimates {
+		c.estimates[key] = hours
+	}
+
+	return c
+}
+
+// Helper to put back tables copied with clone. Must be called with the lock held.
+func (d *memData) restore(saved *memData) {
+	d.lastProjectId = saved.lastProjectId
+	d.lastTaskId = saved.lastTaskId
+	d.lastMemberId = saved.lastMemberId
+	d.lastPersonId = saved.lastPersonId
+	d.lastGrantId = saved.lastGrantId
+	d.lastApiKeyId = saved.lastApiKeyId
+	d.lastWebhookId = saved.lastWebhookId
+	d.lastDeliveryId = saved.lastDeliveryId
+	d.projects = saved.projects
+	d.tasks = saved.tasks
+	d.members = saved.members
+	d.statusTypes = saved.statusTypes
+	d.roleTypes = saved.roleTypes
+	d.assignments = saved.assignments
+	d.notify = saved.notify
+	d.persons = saved.persons
+	d.grants = saved.grants
+	d.apiKeys = saved.apiKeys
+	d.webhooks = saved.webhooks
+	d.deliveries = saved.deliveries
+	d.capacities = saved.capacities
+	d.daysOff = saved.daysOff
+	d.estimates = saved.estimates
+	d.sent = saved.sent
+}
+
+// Helper to get the current time as stored in a DATETIME column.
+func memNow() *dml.DateTime {
+	return dml.DateTimeFromTime(time.Now())
+}
+
+// Helper to get the time a number of seconds from now.
+func memLater(seconds int) time.Time {
+	return time.Now().Add(time.Duration(seconds) * time.Second)
+}
+
+// Helper to copy a date as stored in a DATETIME column, to the second.
+func memDate(d *dml.DateTime) *dml.DateTime {
+	return dml.DateTimeFromTime(d.TimeFromDateTime())
+}
+
+// Helper to get the error for a row that violates a unique key, as the database would fail the statement.
+func memDuplicate(table string, key string) error {
+	return &DbError{What: "Duplicate", Err: fmt.Errorf("duplicate entry for key %s.%s", table, key)}
+}
+
+// Helper to get the name of a status type joined on its id, as tb_StatusType is joined by intStatusId, preferring
+// the status type of the account. Must be called with the lock held.
+func (s *memStore) statusName(statusId int32, mserviceId int64) (string, bool) {
+	row, ok := s.statusTypes[memTypeKey{mserviceId, statusId}]
+	if !ok {
+		for key, other := range s.statusTypes {
+			if (key.typeId == statusId) && ((row == nil) || (key.mserviceId < row.statusType.GetMserviceId())) {
+				row = other
+			}
+		}
+	}
+
+	if row == nil {
+		return "", false
+	}
+
+	return row.statusType.GetStatusName(), true
+}
+
+// Helper to get the name of a project role type joined on its id, as tb_ProjectRoleType is joined by
+// intProjectRoleId, preferring the role type of the account. Must be called with the lock held.
+func (s *memStore) roleName(projectRoleId int32, mserviceId int64) (string, bool) {
+	row, ok := s.roleTypes[memTypeKey{mserviceId, projectRoleId}]
+	if !ok {
+		for key, other := range s.roleTypes {
+			if (key.typeId == projectRoleId) && ((row == nil) || (key.mserviceId < row.roleType.GetMserviceId())) {
+				row = other
+			}
+		}
+	}
+
+	if row == nil {
+		return "", false
+	}
+
+	return row.roleType.GetRoleName(), true
+}
+
+// Helper to get the task hours of an assignment as read from a DECIMAL(19,2) column.
+func memHours(d sdec.Decimal) *dml.Decimal {
+	hours, _ := dml.DecimalFromString(d.StringFixed(2))
+	return hours
+}
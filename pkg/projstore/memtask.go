@@ -0,0 +1,240 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projstore
+
+import (
+	"context"
+	"sort"
+
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/gaterace/mproject/pkg/mserviceproject"
+)
+
+// Tasks in memory.
+type memTasks struct {
+	*memStore
+}
+
+// Helper to check the unique key of tb_Task for a task name. Must be called with the lock held.
+func (s *memTasks) nameTaken(name string, projectId int64, taskId int64) bool {
+	for id, row := range s.tasks {
+		if (id != taskId) && (row.task.GetName() == name) && (row.task.GetProjectId() == projectId) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Helper to get a task that is not deleted. Must be called with the lock held.
+func (s *memTasks) find(taskId int64, mserviceId int64) *memTask {
+	row, ok := s.tasks[taskId]
+	if !ok || row.deleted || (row.task.GetMserviceId() != mserviceId) {
+		return nil
+	}
+
+	return row
+}
+
+// Helper to get a copy of a task with its status name, or nil if it has no status type. Must be called with the
+// lock held.
+func (s *memTasks) get(row *memTask) *pb.Task {
+	statusName, ok := s.statusName(row.task.GetStatusId(), row.task.GetMserviceId())
+	if !ok {
+		return nil
+	}
+
+	task := proto.Clone(row.task).(*pb.Task)
+	task.StatusName = statusName
+
+	return task
+}
+
+func (s *memTasks) CreateTask(ctx context.Context, task *pb.Task) (int64, error) {
+	s.lock()
+	defer s.unlock()
+
+	if s.nameTaken(task.GetName(), task.GetProjectId(), 0) {
+		return 0, memDuplicate("tb_Task", "chvName")
+	}
+
+	s.lastTaskId++
+	now := memNow()
+
+	s.tasks[s.lastTaskId] = &memTask{task: &pb.Task{
+		TaskId:      s.lastTaskId,
+		Created:     now,
+		Modified:    now,
+		Version:     1,
+		MserviceId:  task.GetMserviceId(),
+		ProjectId:   task.GetProjectId(),
+		Name:        task.GetName(),
+		Description: task.GetDescription(),
+		StatusId:    task.GetStatusId(),
+		StartDate:   memDate(task.GetStartDate()),
+		EndDate:     memDate(task.GetEndDate()),
+		Priority:    task.GetPriority(),
+		ParentId:    task.GetParentId(),
+		Position:    task.GetPosition(),
+	}}
+
+	return s.lastTaskId, nil
+}
+
+func (s *memTasks) UpdateTask(ctx context.Context, task *pb.Task) error {
+	s.lock()
+	defer s.unlock()
+
+	row := s.find(task.GetTaskId(), task.GetMserviceId())
+	if row == nil {
+		return ErrNotFound
+	} else if row.task.GetVersion() != task.GetVersion() {
+		return ErrVersionConflict
+	}
+
+	if s.nameTaken(task.GetName(), row.task.GetProjectId(), task.GetTaskId()) {
+		return memDuplicate("tb_Task", "chvName")
+	}
+
+	row.task.Modified = memNow()
+	row.task.Version = task.GetVersion() + 1
+	row.task.Name = task.GetName()
+	row.task.Description = task.GetDescription()
+	row.task.StatusId = task.GetStatusId()
+	row.task.StartDate = memDate(task.GetStartDate())
+	row.task.EndDate = memDate(task.GetEndDate())
+	row.task.Priority = task.GetPriority()
+	row.task.Position = task.GetPosition()
+
+	return nil
+}
+
+func (s *memTasks) DeleteTask(ctx context.Context, taskId int64, mserviceId int64, version int32) error {
+	s.lock()
+	defer s.unlock()
+
+	row := s.find(taskId, mserviceId)
+	if row == nil {
+		return ErrNotFound
+	} else if row.task.GetVersion() != version {
+		return ErrVersionConflict
+	}
+
+	row.deleted = true
+	row.task.Version = version + 1
+
+	return nil
+}
+
+func (s *memTasks) TouchTask(ctx context.Context, taskId int64, mserviceId int64, version int32) error {
+	s.lock()
+	defer s.unlock()
+
+	row := s.find(taskId, mserviceId)
+	if row == nil {
+		return ErrNotFound
+	} else if row.task.GetVersion() != version {
+		return ErrVersionConflict
+	}
+
+	row.task.Modified = memNow()
+	row.task.Version = version + 1
+
+	return nil
+}
+
+func (s *memTasks) SetTaskPosition(ctx context.Context, taskId int64, parentId int64, mserviceId int64,
+	position int32) error {
+	s.lock()
+	defer s.unlock()
+
+	row := s.find(taskId, mserviceId)
+	if (row == nil) || (row.task.GetParentId() != parentId) {
+		return ErrNotFound
+	}
+
+	row.task.Modified = memNow()
+	row.task.Version++
+	row.task.Position = position
+
+	return nil
+}
+
+func (s *memTasks) GetTaskById(ctx context.Context, taskId int64, mserviceId int64) (*pb.Task, error) {
+	s.rlock()
+	defer s.runlock()
+
+	row := s.find(taskId, mserviceId)
+	if row == nil {
+		return nil, ErrNotFound
+	}
+
+	task := s.get(row)
+	if task == nil {
+		return nil, ErrNotFound
+	}
+
+	return task, nil
+}
+
+func (s *memTasks) GetTasksByProject(ctx context.Context, projectId int64, mserviceId int64) ([]*pb.Task, error) {
+	s.rlock()
+	defer s.runlock()
+
+	tasks := make([]*pb.Task, 0)
+	for _, row := range s.tasks {
+		if row.deleted || (row.task.GetProjectId() != projectId) || (row.task.GetMserviceId() != mserviceId) {
+			continue
+		}
+
+		task := s.get(row)
+		if task != nil {
+			tasks = append(tasks, task)
+		}
+	}
+
+	sort.Slice(tasks, func(i, j int) bool {
+		if tasks[i].GetParentId() != tasks[j].GetParentId() {
+			return tasks[i].GetParentId() < tasks[j].GetParentId()
+		}
+		if tasks[i].GetPosition() != tasks[j].GetPosition() {
+			return tasks[i].GetPosition() < tasks[j].GetPosition()
+		}
+		return tasks[i].GetTaskId() < tasks[j].GetTaskId()
+	})
+
+	return tasks, nil
+}
+
+func (s *memTasks) GetTaskProject(ctx context.Context, taskId int64, mserviceId int64) (int64, int32, error) {
+	s.rlock()
+	defer s.runlock()
+
+	row := s.find(taskId, mserviceId)
+	if row == nil {
+		return 0, 0, ErrNotFound
+	}
+
+	return row.task.GetProjectId(), row.task.GetStatusId(), nil
+}
+
+func (s *memTasks) TaskExists(ctx context.Context, taskId int64, projectId int64) (bool, error) {
+	s.rlock()
+	defer s.runlock()
+
+	row, ok := s.tasks[taskId]
+
+	return ok && !row.deleted && (row.task.GetProjectId() == projectId), nil
+}
@@ -0,0 +1,242 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projstore
+
+import (
+	"context"
+	"sort"
+
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/gaterace/mproject/pkg/mserviceproject"
+)
+
+// Status types and project role types in memory.
+type memTypes struct {
+	*memStore
+}
+
+func (s *memTypes) CreateStatusType(ctx context.Context, statusType *pb.StatusType) error {
+	s.lock()
+	defer s.unlock()
+
+	key := memTypeKey{statusType.GetMserviceId(), statusType.GetStatusId()}
+	if _, ok := s.statusTypes[key]; ok {
+		return memDuplicate("tb_StatusType", "PRIMARY")
+	}
+
+	if s.statusNameTaken(statusType.GetStatusName(), key) {
+		return memDuplicate("tb_StatusType", "chvStatusName")
+	}
+
+	now := memNow()
+	s.statusTypes[key] = &memStatusType{statusType: &pb.StatusType{
+		StatusId:    statusType.GetStatusId(),
+		Created:     now,
+		Modified:    now,
+		Version:     1,
+		MserviceId:  statusType.GetMserviceId(),
+		StatusName:  statusType.GetStatusName(),
+		Description: statusType.GetDescription(),
+	}}
+
+	return nil
+}
+
+func (s *memTypes) UpdateStatusType(ctx context.Context, statusType *pb.StatusType) error {
+	s.lock()
+	defer s.unlock()
+
+	key := memTypeKey{statusType.GetMserviceId(), statusType.GetStatusId()}
+	row, ok := s.statusTypes[key]
+	if !ok || row.deleted {
+		return ErrNotFound
+	} else if row.statusType.GetVersion() != statusType.GetVersion() {
+		return ErrVersionConflict
+	}
+
+	if s.statusNameTaken(statusType.GetStatusName(), key) {
+		return memDuplicate("tb_StatusType", "chvStatusName")
+	}
+
+	row.statusType.Modified = memNow()
+	row.statusType.Version = statusType.GetVersion() + 1
+	row.statusType.StatusName = statusType.GetStatusName()
+	row.statusType.Description = statusType.GetDescription()
+
+	return nil
+}
+
+func (s *memTypes) DeleteStatusType(ctx context.Context, statusId int32, mserviceId int64, version int32) error {
+	s.lock()
+	defer s.unlock()
+
+	row, ok := s.statusTypes[memTypeKey{mserviceId, statusId}]
+	if !ok || row.deleted {
+		return ErrNotFound
+	} else if row.statusType.GetVersion() != version {
+		return ErrVersionConflict
+	}
+
+	row.deleted = true
+	row.statusType.Version = version + 1
+
+	return nil
+}
+
+func (s *memTypes) GetStatusType(ctx context.Context, statusId int32, mserviceId int64) (*pb.StatusType, error) {
+	s.rlock()
+	defer s.runlock()
+
+	row, ok := s.statusTypes[memTypeKey{mserviceId, statusId}]
+	if !ok || row.deleted {
+		return nil, ErrNotFound
+	}
+
+	return proto.Clone(row.statusType).(*pb.StatusType), nil
+}
+
+func (s *memTypes) GetStatusTypes(ctx context.Context, mserviceId int64) ([]*pb.StatusType, error) {
+	s.rlock()
+	defer s.runlock()
+
+	statusTypes := make([]*pb.StatusType, 0)
+	for key, row := range s.statusTypes {
+		if !row.deleted && (key.mserviceId == mserviceId) {
+			statusTypes = append(statusTypes, proto.Clone(row.statusType).(*pb.StatusType))
+		}
+	}
+
+	sort.Slice(statusTypes, func(i, j int) bool { return statusTypes[i].GetStatusId() < statusTypes[j].GetStatusId() })
+
+	return statusTypes, nil
+}
+
+func (s *memTypes) CreateRoleType(ctx context.Context, roleType *pb.ProjectRoleType) error {
+	s.lock()
+	defer s.unlock()
+
+	key := memTypeKey{roleType.GetMserviceId(), roleType.GetProjectRoleId()}
+	if _, ok := s.roleTypes[key]; ok {
+		return memDuplicate("tb_ProjectRoleType", "PRIMARY")
+	}
+
+	if s.roleNameTaken(roleType.GetRoleName(), key) {
+		return memDuplicate("tb_ProjectRoleType", "chvRoleName")
+	}
+
+	now := memNow()
+	s.roleTypes[key] = &memRoleType{roleType: &pb.ProjectRoleType{
+		ProjectRoleId: roleType.GetProjectRoleId(),
+		Created:       now,
+		Modified:      now,
+		Version:       1,
+		MserviceId:    roleType.GetMserviceId(),
+		RoleName:      roleType.GetRoleName(),
+		Description:   roleType.GetDescription(),
+	}}
+
+	return nil
+}
+
+func (s *memTypes) UpdateRoleType(ctx context.Context, roleType *pb.ProjectRoleType) error {
+	s.lock()
+	defer s.unlock()
+
+	key := memTypeKey{roleType.GetMserviceId(), roleType.GetProjectRoleId()}
+	row, ok := s.roleTypes[key]
+	if !ok || row.deleted {
+		return ErrNotFound
+	} else if row.roleType.GetVersion() != roleType.GetVersion() {
+		return ErrVersionConflict
+	}
+
+	if s.roleNameTaken(roleType.GetRoleName(), key) {
+		return memDuplicate("tb_ProjectRoleType", "chvRoleName")
+	}
+
+	row.roleType.Modified = memNow()
+	row.roleType.Version = roleType.GetVersion() + 1
+	row.roleType.RoleName = roleType.GetRoleName()
+	row.roleType.Description = roleType.GetDescription()
+
+	return nil
+}
+
+func (s *memTypes) DeleteRoleType(ctx context.Context, projectRoleId int32, mserviceId int64, version int32) error {
+	s.lock()
+	defer s.unlock()
+
+	row, ok := s.roleTypes[memTypeKey{mserviceId, projectRoleId}]
+	if !ok || row.deleted {
+		return ErrNotFound
+	} else if row.roleType.GetVersion() != version {
+		return ErrVersionConflict
+	}
+
+	row.deleted = true
+	row.roleType.Version = version + 1
+
+	return nil
+}
+
+func (s *memTypes) GetRoleType(ctx context.Context, projectRoleId int32, mserviceId int64) (*pb.ProjectRoleType, error) {
+	s.rlock()
+	defer s.runlock()
+
+	row, ok := s.roleTypes[memTypeKey{mserviceId, projectRoleId}]
+	if !ok || row.deleted {
+		return nil, ErrNotFound
+	}
+
+	return proto.Clone(row.roleType).(*pb.ProjectRoleType), nil
+}
+
+func (s *memTypes) GetRoleTypes(ctx context.Context, mserviceId int64) ([]*pb.ProjectRoleType, error) {
+	s.rlock()
+	defer s.runlock()
+
+	roles := make([]*pb.ProjectRoleType, 0)
+	for key, row := range s.roleTypes {
+		if !row.deleted && (key.mserviceId == mserviceId) {
+			roles = append(roles, proto.Clone(row.roleType).(*pb.ProjectRoleType))
+		}
+	}
+
+	sort.Slice(roles, func(i, j int) bool { return roles[i].GetProjectRoleId() < roles[j].GetProjectRoleId() })
+
+	return roles, nil
+}
+
+// Helper to check the unique key of tb_StatusType for a status name. Must be called with the lock held.
+func (s *memTypes) statusNameTaken(name string, key memTypeKey) bool {
+	for other, row := range s.statusTypes {
+		if (other != key) && (other.mserviceId == key.mserviceId) && (row.statusType.GetStatusName() == name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Helper to check the unique key of tb_ProjectRoleType for a role name. Must be called with the lock held.
+func (s *memTypes) roleNameTaken(name string, key memTypeKey) bool {
+	for other, row := range s.roleTypes {
+		if (other != key) && (other.mserviceId == key.mserviceId) && (row.roleType.GetRoleName() == name) {
+			return true
+		}
+	}
+
+	return false
+}
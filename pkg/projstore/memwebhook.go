@@ -0,0 +1,280 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projstore
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/gaterace/mproject/pkg/mserviceproject"
+)
+
+// Webhooks and their delivery log in memory.
+type memWebhooks struct {
+	*memStore
+}
+
+// Helper to get a webhook that is not deleted. Must be called with the lock held.
+func (s *memWebhooks) find(webhookId int64, mserviceId int64) *memWebhook {
+	row, ok := s.webhooks[webhookId]
+	if !ok || row.deleted || (row.webhook.GetMserviceId() != mserviceId) {
+		return nil
+	}
+
+	return row
+}
+
+func (s *memWebhooks) CreateWebhook(ctx context.Context, webhook *pb.Webhook, secret string) (int64, error) {
+	s.lock()
+	defer s.unlock()
+
+	s.lastWebhookId++
+	now := memNow()
+
+	s.webhooks[s.lastWebhookId] = &memWebhook{webhook: &pb.Webhook{
+		WebhookId:  s.lastWebhookId,
+		Created:    now,
+		Modified:   now,
+		Deleted:    now,
+		Version:    1,
+		MserviceId: webhook.GetMserviceId(),
+		Url:        webhook.GetUrl(),
+		EventTypes: append([]string(nil), webhook.GetEventTypes()...),
+		IsActive:   webhook.GetIsActive(),
+	}, secret: secret}
+
+	return s.lastWebhookId, nil
+}
+
+func (s *memWebhooks) UpdateWebhook(ctx context.Context, webhook *pb.Webhook) error {
+	s.lock()
+	defer s.unlock()
+
+	row := s.find(webhook.GetWebhookId(), webhook.GetMserviceId())
+	if row == nil {
+		return ErrNotFound
+	} else if row.webhook.GetVersion() != webhook.GetVersion() {
+		return ErrVersionConflict
+	}
+
+	row.webhook.Modified = memNow()
+	row.webhook.Version = webhook.GetVersion() + 1
+	row.webhook.Url = webhook.GetUrl()
+	row.webhook.EventTypes = append([]string(nil), webhook.GetEventTypes()...)
+	row.webhook.IsActive = webhook.GetIsActive()
+
+	return nil
+}
+
+func (s *memWebhooks) DeleteWebhook(ctx context.Context, webhookId int64, mserviceId int64, version int32) error {
+	s.lock()
+	defer s.unlock()
+
+	row := s.find(webhookId, mserviceId)
+	if row == nil {
+		return ErrNotFound
+	} else if row.webhook.GetVersion() != version {
+		return ErrVersionConflict
+	}
+
+	row.deleted = true
+	row.webhook.Deleted = memNow()
+	row.webhook.Version = version + 1
+
+	return nil
+}
+
+func (s *memWebhooks) GetWebhooks(ctx context.Context, mserviceId int64) ([]*pb.Webhook, error) {
+	s.rlock()
+	defer s.runlock()
+
+	webhooks := make([]*pb.Webhook, 0)
+	for _, row := range s.webhooks {
+		if !row.deleted && (row.webhook.GetMserviceId() == mserviceId) {
+			webhooks = append(webhooks, proto.Clone(row.webhook).(*pb.Webhook))
+		}
+	}
+
+	sort.Slice(webhooks, func(i, j int) bool { return webhooks[i].GetWebhookId() < webhooks[j].GetWebhookId() })
+
+	return webhooks, nil
+}
+
+func (s *memWebhooks) GetDeliveries(ctx context.Context, webhookId int64, mserviceId int64,
+	limit int32) ([]*pb.WebhookDelivery, error) {
+	s.rlock()
+	defer s.runlock()
+
+	deliveries := make([]*pb.WebhookDelivery, 0)
+	for _, row := range s.deliveries {
+		if (row.delivery.GetWebhookId() == webhookId) && (row.delivery.GetMserviceId() == mserviceId) {
+			deliveries = append(deliveries, proto.Clone(row.delivery).(*pb.WebhookDelivery))
+		}
+	}
+
+	sort.Slice(deliveries, func(i, j int) bool {
+		return deliveries[i].GetDeliveryId() > deliveries[j].GetDeliveryId()
+	})
+
+	if len(deliveries) > int(limit) {
+		deliveries = deliveries[:limit]
+	}
+
+	return deliveries, nil
+}
+
+func (s *memWebhooks) GetSubscribedWebhooks(ctx context.Context, mserviceId int64, eventType string) ([]int64, error) {
+	s.rlock()
+	defer s.runlock()
+
+	webhookIds := make([]int64, 0)
+	for id, row := range s.webhooks {
+		if row.deleted || !row.webhook.GetIsActive() || (row.webhook.GetMserviceId() != mserviceId) {
+			continue
+		}
+
+		for _, t := range row.webhook.GetEventTypes() {
+			if t == eventType {
+				webhookIds = append(webhookIds, id)
+				break
+			}
+		}
+	}
+
+	sort.Slice(webhookIds, func(i, j int) bool { return webhookIds[i] < webhookIds[j] })
+
+	return webhookIds, nil
+}
+
+func (s *memWebhooks) CreateDelivery(ctx context.Context, delivery *pb.WebhookDelivery, holdSeconds int) (int64, error) {
+	s.lock()
+	defer s.unlock()
+
+	s.lastDeliveryId++
+	now := memNow()
+
+	s.deliveries[s.lastDeliveryId] = &memDelivery{delivery: &pb.WebhookDelivery{
+		DeliveryId: s.lastDeliveryId,
+		Created:    now,
+		Modified:   now,
+		MserviceId: delivery.GetMserviceId(),
+		WebhookId:  delivery.GetWebhookId(),
+		EventType:  delivery.GetEventType(),
+		Sequence:   delivery.GetSequence(),
+		Payload:    delivery.GetPayload(),
+	}, nextAttempt: memLater(holdSeconds)}
+
+	return s.lastDeliveryId, nil
+}
+
+func (s *memWebhooks) GetDelivery(ctx context.Context, deliveryId int64,
+	mserviceId int64) (*pb.WebhookDelivery, string, string, error) {
+	s.rlock()
+	defer s.runlock()
+
+	delivery, ok := s.deliveries[deliveryId]
+	if !ok || (delivery.delivery.GetMserviceId() != mserviceId) {
+		return nil, "", "", ErrNotFound
+	}
+
+	row, ok := s.webhooks[delivery.delivery.GetWebhookId()]
+	if !ok || row.deleted {
+		return nil, "", "", ErrNotFound
+	}
+
+	return proto.Clone(delivery.delivery).(*pb.WebhookDelivery), row.webhook.GetUrl(), row.secret, nil
+}
+
+func (s *memWebhooks) GetDueDeliveries(ctx context.Context, limit int32) ([]*pb.WebhookDelivery, error) {
+	s.rlock()
+	defer s.runlock()
+
+	now := time.Now()
+	due := make([]*memDelivery, 0)
+	for _, delivery := range s.deliveries {
+		row, ok := s.webhooks[delivery.delivery.GetWebhookId()]
+		if ok && !row.deleted && !delivery.nextAttempt.IsZero() && !delivery.nextAttempt.After(now) {
+			due = append(due, delivery)
+		}
+	}
+
+	sort.Slice(due, func(i, j int) bool { return due[i].nextAttempt.Before(due[j].nextAttempt) })
+
+	if len(due) > int(limit) {
+		due = due[:limit]
+	}
+
+	deliveries := make([]*pb.WebhookDelivery, 0, len(due))
+	for _, delivery := range due {
+		deliveries = append(deliveries, proto.Clone(delivery.delivery).(*pb.WebhookDelivery))
+	}
+
+	return deliveries, nil
+}
+
+func (s *memWebhooks) ClaimDelivery(ctx context.Context, deliveryId int64, mserviceId int64, holdSeconds int) error {
+	s.lock()
+	defer s.unlock()
+
+	delivery, ok := s.deliveries[deliveryId]
+	if !ok || (delivery.delivery.GetMserviceId() != mserviceId) || delivery.nextAttempt.IsZero() ||
+		delivery.nextAttempt.After(time.Now()) {
+		return ErrNotFound
+	}
+
+	delivery.nextAttempt = memLater(holdSeconds)
+
+	return nil
+}
+
+func (s *memWebhooks) RetryDelivery(ctx context.Context, deliveryId int64, mserviceId int64, holdSeconds int) error {
+	s.lock()
+	defer s.unlock()
+
+	delivery, ok := s.deliveries[deliveryId]
+	if !ok || (delivery.delivery.GetMserviceId() != mserviceId) {
+		return ErrNotFound
+	}
+
+	delivery.nextAttempt = memLater(holdSeconds)
+
+	return nil
+}
+
+func (s *memWebhooks) RecordAttempt(ctx context.Context, deliveryId int64, mserviceId int64, responseCode int32,
+	lastError string, retrySeconds int) error {
+	s.lock()
+	defer s.unlock()
+
+	delivery, ok := s.deliveries[deliveryId]
+	if !ok || (delivery.delivery.GetMserviceId() != mserviceId) {
+		return ErrNotFound
+	}
+
+	delivery.delivery.Modified = memNow()
+	delivery.delivery.Attempts++
+	delivery.delivery.ResponseCode = responseCode
+	delivery.delivery.LastError = lastError
+	delivery.delivery.IsDelivered = lastError == ""
+
+	delivery.nextAttempt = time.Time{}
+	if retrySeconds > 0 {
+		delivery.nextAttempt = memLater(retrySeconds)
+	}
+
+	return nil
+}
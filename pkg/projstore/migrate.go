@@ -0,0 +1,439 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projstore
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+	"io/fs"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Numbered schema migrations for each driver, in migrations/<driver>/<version>_<name>.up.sql, with the
+// statements to revert each in the matching .down.sql.
+//
+//go:embed migrations
+var migrationFiles embed.FS
+
+// A numbered schema migration.
+type Migration struct {
+	Version int
+	Name    string
+	up      string
+	down    string
+}
+
+// A schema migration and when it was applied, if it has been.
+type MigrationState struct {
+	*Migration
+	Applied   bool
+	AppliedAt string
+}
+
+// Get the schema migrations of the driver, by version.
+func (d *Dialect) Migrations() ([]*Migration, error) {
+	dir := "migrations/" + d.Driver
+	entries, err := fs.ReadDir(migrationFiles, dir)
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int]*Migration)
+	for _, entry := range entries {
+		fileName := entry.Name()
+		direction := ""
+		if strings.HasSuffix(fileName, ".up.sql") {
+			direction = "up"
+		} else if strings.HasSuffix(fileName, ".down.sql") {
+			direction = "down"
+		} else {
+			continue
+		}
+
+		base := strings.TrimSuffix(fileName, "."+direction+".sql")
+		pos := strings.Index(base, "_")
+		if pos < 0 {
+			return nil, fmt.Errorf("invalid migration file name: %s", fileName)
+		}
+
+		version, err := strconv.Atoi(base[:pos])
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration file name: %s", fileName)
+		}
+
+		b, err := fs.ReadFile(migrationFiles, dir+"/"+fileName)
+		if err != nil {
+			return nil, err
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: base[pos+1:]}
+			byVersion[version] = m
+		}
+
+		if direction == "up" {
+			m.up = string(b)
+		} else {
+			m.down = string(b)
+		}
+	}
+
+	migrations := make([]*Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if (m.up == "") || (m.down == "") {
+			return nil, fmt.Errorf("migration %d %s needs both an up and a down file", m.Version, m.Name)
+		}
+
+		migrations = append(migrations, m)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+
+	return migrations, nil
+}
+
+// Apply the migrations that have not been applied yet, in order, returning those applied. Each migration is
+// applied in a transaction with its tb_SchemaVersion row; mysql commits DDL statements as it runs them, so a
+// failed mysql migration may be left partly applied. Servers starting together take turns, each applying
+// only what the ones before it have not.
+func (db *DB) MigrateUp() ([]*Migration, error) {
+	migrations, err := db.Dialect.Migrations()
+	if err != nil {
+		return nil, err
+	}
+
+	done := make([]*Migration, 0)
+	err = db.withMigrationLock(func() error {
+		applied, err := db.appliedMigrations()
+		if err != nil {
+			return err
+		}
+
+		for _, m := range migrations {
+			if _, ok := applied[m.Version]; ok {
+				continue
+			}
+
+			err = db.migrate(m, m.up, `INSERT INTO tb_SchemaVersion (intVersion, dtmApplied, chvName) VALUES (?, NOW(), ?)`,
+				m.Version, m.Name)
+			if err != nil {
+				return err
+			}
+
+			done = append(done, m)
+		}
+
+		return nil
+	})
+
+	return done, err
+}
+
+// Revert the latest applied migration, returning it, or nil if no migration has been applied.
+func (db *DB) MigrateDown() (*Migration, error) {
+	migrations, err := db.Dialect.Migrations()
+	if err != nil {
+		return nil, err
+	}
+
+	var reverted *Migration
+	err = db.withMigrationLock(func() error {
+		applied, err := db.appliedMigrations()
+		if err != nil {
+			return err
+		}
+
+		latest := 0
+		for version := range applied {
+			if version > latest {
+				latest = version
+			}
+		}
+
+		if latest == 0 {
+			return nil
+		}
+
+		for _, m := range migrations {
+			if m.Version == latest {
+				err = db.migrate(m, m.down, `DELETE FROM tb_SchemaVersion WHERE intVersion = ?`, m.Version)
+				if err != nil {
+					return err
+				}
+
+				reverted = m
+				return nil
+			}
+		}
+
+		return fmt.Errorf("migration %d was applied by a newer projserver", latest)
+	})
+
+	return reverted, err
+}
+
+// Get the schema migrations of the driver with whether each has been applied, followed by any applied
+// migrations this projserver does not know of.
+func (db *DB) MigrationStatus() ([]*MigrationState, error) {
+	migrations, err := db.Dialect.Migrations()
+	if err != nil {
+		return nil, err
+	}
+
+	applied, err := db.appliedMigrations()
+	if err != nil {
+		return nil, err
+	}
+
+	states := make([]*MigrationState, 0, len(migrations))
+	known := make(map[int]bool)
+	for _, m := range migrations {
+		appliedAt, ok := applied[m.Version]
+		states = append(states, &MigrationState{Migration: m, Applied: ok, AppliedAt: appliedAt})
+		known[m.Version] = true
+	}
+
+	unknown := make([]*MigrationState, 0)
+	for version, appliedAt := range applied {
+		if !known[version] {
+			unknown = append(unknown, &MigrationState{Migration: &Migration{Version: version}, Applied: true,
+				AppliedAt: appliedAt})
+		}
+	}
+
+	sort.Slice(unknown, func(i, j int) bool { return unknown[i].Version < unknown[j].Version })
+
+	return append(states, unknown...), nil
+}
+
+// Name of the advisory lock that servers migrating the same database take turns holding.
+const migrationLockName = "mproject_migrate"
+
+// Helper to run fn holding the migration lock of the database, an advisory lock held by a connection of its
+// own. sqlite runs in a single process, so needs no lock.
+func (db *DB) withMigrationLock(fn func() error) error {
+	var lockString, unlockString string
+	switch db.Dialect.Driver {
+	case "mysql":
+		lockString = `SELECT GET_LOCK(?, 600)`
+		unlockString = `SELECT RELEASE_LOCK(?)`
+	case "postgres":
+		lockString = `SELECT pg_advisory_lock(hashtext($1))::text`
+		unlockString = `SELECT pg_advisory_unlock(hashtext($1))::text`
+	default:
+		return fn()
+	}
+
+	ctx := context.Background()
+	conn, err := db.DB.Conn(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer conn.Close()
+
+	var result sql.NullString
+	err = conn.QueryRowContext(ctx, lockString, migrationLockName).Scan(&result)
+	if err != nil {
+		return err
+	}
+
+	// GET_LOCK gives 1 when locked, 0 on timeout; pg_advisory_lock waits and gives an empty result
+	if (db.Dialect.Driver == "mysql") && (result.String != "1") {
+		return fmt.Errorf("timed out waiting for the migration lock")
+	}
+
+	defer conn.ExecContext(ctx, unlockString, migrationLockName)
+
+	return fn()
+}
+
+// Helper to get the applied migration versions with when each was applied, creating tb_SchemaVersion if
+// this is the first use of migrations.
+func (db *DB) appliedMigrations() (map[int]string, error) {
+	dateTimeType := "DATETIME"
+	if db.Dialect.Driver == "postgres" {
+		dateTimeType = "TIMESTAMP"
+	}
+
+	sqlstring := `CREATE TABLE IF NOT EXISTS tb_SchemaVersion (intVersion INT NOT NULL, dtmApplied ` + dateTimeType +
+		` NOT NULL, chvName VARCHAR(64) NOT NULL, PRIMARY KEY (intVersion))`
+
+	_, err := db.Exec(sqlstring)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := db.Query(`SELECT intVersion, dtmApplied FROM tb_SchemaVersion`)
+	if err != nil {
+		return nil, err
+	}
+
+	defer rows.Close()
+
+	applied := make(map[int]string)
+	for rows.Next() {
+		var version int
+		var appliedAt string
+		err = rows.Scan(&version, &appliedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		// YYYY-MM-DD HH:MM:SS, whether the driver returns it as is or in RFC 3339
+		if len(appliedAt) > 19 {
+			appliedAt = appliedAt[:19]
+		}
+
+		applied[version] = strings.Replace(appliedAt, "T", " ", 1)
+	}
+
+	return applied, rows.Err()
+}
+
+// Helper to run the statements of a migration and the update of tb_SchemaVersion in a transaction.
+func (db *DB) migrate(m *Migration, statements string, sqlstring string, args ...interface{}) error {
+	tx, err := db.BeginTx(context.Background(), nil)
+	if err != nil {
+		return err
+	}
+
+	err = execStatements(tx, statements)
+	if err == nil {
+		_, err = tx.Exec(sqlstring, args...)
+	}
+
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("migration %d %s: %v", m.Version, m.Name, err)
+	}
+
+	return tx.Commit()
+}
+
+// Helper to run the semicolon separated statements of a migration file.
+func execStatements(tx *Tx, statements string) error {
+	for _, statement := range splitStatements(statements) {
+		_, err := tx.Exec(statement)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Helper to split a migration file into its statements at the semicolons outside of quoted strings,
+// quoted identifiers, dollar quoted strings and comments, dropping the comments and empty statements.
+func splitStatements(statements string) []string {
+	result := make([]string, 0)
+	var sb strings.Builder
+
+	flush := func() {
+		statement := strings.TrimSpace(sb.String())
+		if statement != "" {
+			result = append(result, statement)
+		}
+		sb.Reset()
+	}
+
+	n := len(statements)
+	for i := 0; i < n; i++ {
+		c := statements[i]
+		switch {
+		case (c == '-') && strings.HasPrefix(statements[i:], "--"):
+			// line comment, up to the end of the line
+			end := strings.IndexByte(statements[i:], '\n')
+			if end < 0 {
+				i = n
+			} else {
+				i += end - 1
+			}
+		case (c == '/') && strings.HasPrefix(statements[i:], "/*"):
+			end := strings.Index(statements[i+2:], "*/")
+			if end < 0 {
+				i = n
+			} else {
+				i += end + 3
+			}
+			sb.WriteByte(' ')
+		case (c == '\'') || (c == '"') || (c == '`'):
+			// quoted, with a doubled quote or a backslash escaping a quote
+			j := i + 1
+			for j < n {
+				if (statements[j] == '\\') && (c == '\'') {
+					j += 2
+					continue
+				}
+				if statements[j] == c {
+					if (j+1 < n) && (statements[j+1] == c) {
+						j += 2
+						continue
+					}
+					break
+				}
+				j++
+			}
+			if j >= n {
+				j = n - 1
+			}
+			sb.WriteString(statements[i : j+1])
+			i = j
+		case c == '$':
+			// postgres dollar quoted string, $$...$$ or $tag$...$tag$
+			tag := dollarTag(statements[i:])
+			if tag == "" {
+				sb.WriteByte(c)
+				break
+			}
+			end := strings.Index(statements[i+len(tag):], tag)
+			if end < 0 {
+				sb.WriteString(statements[i:])
+				i = n
+				break
+			}
+			j := i + len(tag) + end + len(tag)
+			sb.WriteString(statements[i:j])
+			i = j - 1
+		case c == ';':
+			flush()
+		default:
+			sb.WriteByte(c)
+		}
+	}
+
+	flush()
+
+	return result
+}
+
+// Helper to get the opening tag of a dollar quoted string at the start of s, or "" if there is none.
+func dollarTag(s string) string {
+	for j := 1; j < len(s); j++ {
+		c := s[j]
+		if c == '$' {
+			return s[:j+1]
+		}
+		if !((c == '_') || ((c >= 'a') && (c <= 'z')) || ((c >= 'A') && (c <= 'Z')) || ((j > 1) && (c >= '0') && (c <= '9'))) {
+			return ""
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,8 @@
+-- 1: the tables of the first mproject release.
+
+DROP TABLE IF EXISTS tb_TeamMember;
+DROP TABLE IF EXISTS tb_TaskToMember;
+DROP TABLE IF EXISTS tb_Task;
+DROP TABLE IF EXISTS tb_StatusType;
+DROP TABLE IF EXISTS tb_ProjectRoleType;
+DROP TABLE IF EXISTS tb_Project;
@@ -0,0 +1,70 @@
+-- 2: outbound webhooks and their delivery log.
+
+-- MService project webhook registration
+CREATE TABLE tb_Webhook
+(
+
+    -- webhook identifier
+    inbWebhookId BIGINT AUTO_INCREMENT NOT NULL,
+    -- creation date
+    dtmCreated DATETIME NOT NULL,
+    -- modification date
+    dtmModified DATETIME NOT NULL,
+    -- deletion date
+    dtmDeleted DATETIME NOT NULL,
+    -- has record been deleted?
+    bitIsDeleted BOOL NOT NULL,
+    -- version of this record
+    intVersion INT NOT NULL,
+    -- mservice account id
+    inbMserviceId BIGINT NOT NULL,
+    -- url receiving the webhook POST
+    chvUrl VARCHAR(255) NOT NULL,
+    -- HMAC signing secret
+    chvSecret VARCHAR(64) NOT NULL,
+    -- comma separated subscribed event types
+    chvEventTypes VARCHAR(255) NOT NULL,
+    -- is webhook delivery enabled?
+    bitIsActive BOOL NOT NULL,
+
+
+    PRIMARY KEY (inbWebhookId),
+    INDEX (inbMserviceId)
+) ENGINE=InnoDB;
+
+-- MService project webhook delivery log entry
+CREATE TABLE tb_WebhookDelivery
+(
+
+    -- webhook delivery identifier
+    inbDeliveryId BIGINT AUTO_INCREMENT NOT NULL,
+    -- creation date
+    dtmCreated DATETIME NOT NULL,
+    -- modification date
+    dtmModified DATETIME NOT NULL,
+    -- mservice account id
+    inbMserviceId BIGINT NOT NULL,
+    -- webhook identifier
+    inbWebhookId BIGINT NOT NULL,
+    -- webhook event type
+    chvEventType VARCHAR(32) NOT NULL,
+    -- event sequence number
+    inbSequence BIGINT NOT NULL,
+    -- JSON payload sent to the webhook url
+    txtPayload TEXT NOT NULL,
+    -- number of delivery attempts
+    intAttempts INT NOT NULL,
+    -- HTTP status code of the last attempt
+    intResponseCode INT NOT NULL,
+    -- error from the last attempt
+    chvLastError VARCHAR(255) NOT NULL,
+    -- has payload been delivered?
+    bitIsDelivered BOOL NOT NULL,
+    -- time of the next attempt, null once delivered or given up
+    dtmNextAttempt DATETIME NULL,
+
+
+    PRIMARY KEY (inbDeliveryId),
+    INDEX (inbMserviceId,inbWebhookId),
+    INDEX (dtmNextAttempt)
+) ENGINE=InnoDB;
@@ -0,0 +1,4 @@
+-- 3: email notification preferences and log.
+
+DROP TABLE tb_Notification;
+DROP TABLE tb_MemberNotify;
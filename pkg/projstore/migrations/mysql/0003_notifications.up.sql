@@ -0,0 +1,50 @@
+-- 3: email notification preferences and log.
+
+-- MService team member notification preference
+CREATE TABLE tb_MemberNotify
+(
+
+    -- team member id
+    inbMemberId BIGINT NOT NULL,
+    -- creation date
+    dtmCreated DATETIME NOT NULL,
+    -- modification date
+    dtmModified DATETIME NOT NULL,
+    -- mservice account id
+    inbMserviceId BIGINT NOT NULL,
+    -- has team member opted out of email notifications?
+    bitOptOut BOOL NOT NULL,
+
+
+    PRIMARY KEY (inbMemberId)
+) ENGINE=InnoDB;
+
+-- MService project email notification log
+CREATE TABLE tb_Notification
+(
+
+    -- notification identifier
+    inbNotificationId BIGINT AUTO_INCREMENT NOT NULL,
+    -- creation date
+    dtmCreated DATETIME NOT NULL,
+    -- mservice account id
+    inbMserviceId BIGINT NOT NULL,
+    -- team member id
+    inbMemberId BIGINT NOT NULL,
+    -- task identifier
+    inbTaskId BIGINT NOT NULL,
+    -- notification kind
+    chvKind VARCHAR(32) NOT NULL,
+    -- recipient email address
+    chvEmail VARCHAR(255) NOT NULL,
+    -- message subject
+    chvSubject VARCHAR(255) NOT NULL,
+    -- was the message accepted by the smtp relay?
+    bitIsSent BOOL NOT NULL,
+    -- last send error
+    chvLastError VARCHAR(255) NOT NULL,
+
+
+    PRIMARY KEY (inbNotificationId),
+    INDEX (inbMemberId,inbTaskId,chvKind)
+) ENGINE=InnoDB;
@@ -0,0 +1,5 @@
+-- 5: the account person directory.
+
+ALTER TABLE tb_TeamMember DROP INDEX inbProjectId, ADD UNIQUE (inbProjectId,chvName);
+ALTER TABLE tb_TeamMember DROP COLUMN inbPersonId;
+DROP TABLE tb_Person;
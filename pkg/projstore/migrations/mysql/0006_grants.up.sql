@@ -1,13 +1,11 @@
-use mproject;
+-- 6: per-project access grants.
 
-DROP TABLE IF EXISTS tb_TeamMember;
-
--- MService project team member
-CREATE TABLE tb_TeamMember
+-- MService project access grant to a user or project role
+CREATE TABLE tb_ProjectGrant
 (
 
-    -- team member id
-    inbMemberId BIGINT AUTO_INCREMENT NOT NULL,
+    -- grant identifier
+    inbGrantId BIGINT AUTO_INCREMENT NOT NULL,
     -- creation date
     dtmCreated DATETIME NOT NULL,
     -- modification date
@@ -22,15 +20,14 @@ CREATE TABLE tb_TeamMember
     inbMserviceId BIGINT NOT NULL,
     -- project identifier
     inbProjectId BIGINT NOT NULL,
-    -- entity name
-    chvName VARCHAR(32) NOT NULL,
-    -- role id of this team member
+    -- MService user id granted access, or 0
+    inbUserId BIGINT NOT NULL,
+    -- project role id granted access, or 0
     intProjectRoleId INT NOT NULL,
-    -- email address of team member
-    chvEmail VARCHAR(255) NOT NULL,
+    -- access granted, 1 viewer, 2 editor, 3 owner
+    intAccessLevel INT NOT NULL,
 
 
-    PRIMARY KEY (inbMemberId),
-    UNIQUE (inbProjectId,chvName)
+    PRIMARY KEY (inbGrantId),
+    INDEX (inbProjectId)
 ) ENGINE=InnoDB;
-
@@ -0,0 +1,3 @@
+-- 8: version of task assignments.
+
+ALTER TABLE tb_TaskToMember DROP COLUMN intVersion;
@@ -0,0 +1,4 @@
+-- 9: claims of due date reminders.
+
+ALTER TABLE tb_Notification DROP INDEX ux_tb_Notification_reminder, DROP COLUMN dtmTaskModified,
+    DROP COLUMN intAttempts, DROP COLUMN dtmNextAttempt;
@@ -0,0 +1,193 @@
+-- 1: the tables of the first mproject release, as the former sql/tb_*.sql scripts created them. IF NOT EXISTS lets a
+-- database created with those scripts adopt migrations at this version.
+
+-- MService project entity
+CREATE TABLE IF NOT EXISTS tb_Project
+(
+
+    -- project identifier
+    inbProjectId BIGSERIAL NOT NULL,
+    -- creation date
+    dtmCreated TIMESTAMP NOT NULL,
+    -- modification date
+    dtmModified TIMESTAMP NOT NULL,
+    -- deletion date
+    dtmDeleted TIMESTAMP NOT NULL,
+    -- has record been deleted?
+    bitIsDeleted BOOLEAN NOT NULL,
+    -- version of this record
+    intVersion INT NOT NULL,
+    -- mservice account id
+    inbMserviceId BIGINT NOT NULL,
+    -- entity name
+    chvName VARCHAR(32) NOT NULL,
+    -- entity description
+    chvDescription VARCHAR(255) NOT NULL,
+    -- status identifier
+    intStatusId INT NOT NULL,
+    -- project start date
+    dtmStartDate TIMESTAMP NOT NULL,
+    -- project end date
+    dtmEndDate TIMESTAMP NOT NULL,
+
+
+    PRIMARY KEY (inbProjectId),
+    UNIQUE (inbMserviceId,chvName)
+);
+
+-- MService project role type
+CREATE TABLE IF NOT EXISTS tb_ProjectRoleType
+(
+
+    -- role id of this team member
+    intProjectRoleId INT NOT NULL,
+    -- creation date
+    dtmCreated TIMESTAMP NOT NULL,
+    -- modification date
+    dtmModified TIMESTAMP NOT NULL,
+    -- deletion date
+    dtmDeleted TIMESTAMP NOT NULL,
+    -- has record been deleted?
+    bitIsDeleted BOOLEAN NOT NULL,
+    -- version of this record
+    intVersion INT NOT NULL,
+    -- mservice account id
+    inbMserviceId BIGINT NOT NULL,
+    -- role name of this team member
+    chvRoleName VARCHAR(32) NOT NULL,
+    -- entity description
+    chvDescription VARCHAR(255) NOT NULL,
+
+
+    PRIMARY KEY (inbMserviceId,intProjectRoleId),
+    UNIQUE (inbMserviceId,chvRoleName)
+);
+
+-- MService project status type
+CREATE TABLE IF NOT EXISTS tb_StatusType
+(
+
+    -- status identifier
+    intStatusId INT NOT NULL,
+    -- creation date
+    dtmCreated TIMESTAMP NOT NULL,
+    -- modification date
+    dtmModified TIMESTAMP NOT NULL,
+    -- deletion date
+    dtmDeleted TIMESTAMP NOT NULL,
+    -- has record been deleted?
+    bitIsDeleted BOOLEAN NOT NULL,
+    -- version of this record
+    intVersion INT NOT NULL,
+    -- mservice account id
+    inbMserviceId BIGINT NOT NULL,
+    -- status name
+    chvStatusName VARCHAR(32) NOT NULL,
+    -- entity description
+    chvDescription VARCHAR(255) NOT NULL,
+
+
+    PRIMARY KEY (inbMserviceId,intStatusId),
+    UNIQUE (inbMserviceId,chvStatusName)
+);
+
+-- MService project task
+CREATE TABLE IF NOT EXISTS tb_Task
+(
+
+    -- task identifier
+    inbTaskId BIGSERIAL NOT NULL,
+    -- creation date
+    dtmCreated TIMESTAMP NOT NULL,
+    -- modification date
+    dtmModified TIMESTAMP NOT NULL,
+    -- deletion date
+    dtmDeleted TIMESTAMP NOT NULL,
+    -- has record been deleted?
+    bitIsDeleted BOOLEAN NOT NULL,
+    -- version of this record
+    intVersion INT NOT NULL,
+    -- mservice account id
+    inbMserviceId BIGINT NOT NULL,
+    -- project identifier
+    inbProjectId BIGINT NOT NULL,
+    -- entity name
+    chvName VARCHAR(32) NOT NULL,
+    -- entity description
+    chvDescription VARCHAR(255) NOT NULL,
+    -- status identifier
+    intStatusId INT NOT NULL,
+    -- project start date
+    dtmStartDate TIMESTAMP NOT NULL,
+    -- project end date
+    dtmEndDate TIMESTAMP NOT NULL,
+    -- task priority, 0 low to 9 high
+    intPriority INT NOT NULL,
+    -- parent task id
+    inbParentId BIGINT NOT NULL,
+    -- sibling position
+    intPosition INT NOT NULL,
+
+
+    PRIMARY KEY (inbTaskId),
+    UNIQUE (inbProjectId,chvName)
+);
+
+-- MService map team member to task
+CREATE TABLE IF NOT EXISTS tb_TaskToMember
+(
+
+    -- project identifier
+    inbProjectId BIGINT NOT NULL,
+    -- task identifier
+    inbTaskId BIGINT NOT NULL,
+    -- team member id
+    inbMemberId BIGINT NOT NULL,
+    -- creation date
+    dtmCreated TIMESTAMP NOT NULL,
+    -- modification date
+    dtmModified TIMESTAMP NOT NULL,
+    -- deletion date
+    dtmDeleted TIMESTAMP NOT NULL,
+    -- has record been deleted?
+    bitIsDeleted BOOLEAN NOT NULL,
+    -- mservice account id
+    inbMserviceId BIGINT NOT NULL,
+    -- hours allocated to task by team member
+    decTaskHours DECIMAL(19,2) NOT NULL,
+
+
+    PRIMARY KEY (inbProjectId,inbTaskId,inbMemberId)
+);
+
+-- MService project team member
+CREATE TABLE IF NOT EXISTS tb_TeamMember
+(
+
+    -- team member id
+    inbMemberId BIGSERIAL NOT NULL,
+    -- creation date
+    dtmCreated TIMESTAMP NOT NULL,
+    -- modification date
+    dtmModified TIMESTAMP NOT NULL,
+    -- deletion date
+    dtmDeleted TIMESTAMP NOT NULL,
+    -- has record been deleted?
+    bitIsDeleted BOOLEAN NOT NULL,
+    -- version of this record
+    intVersion INT NOT NULL,
+    -- mservice account id
+    inbMserviceId BIGINT NOT NULL,
+    -- project identifier
+    inbProjectId BIGINT NOT NULL,
+    -- entity name
+    chvName VARCHAR(32) NOT NULL,
+    -- role id of this team member
+    intProjectRoleId INT NOT NULL,
+    -- email address of team member
+    chvEmail VARCHAR(255) NOT NULL,
+
+
+    PRIMARY KEY (inbMemberId),
+    UNIQUE (inbProjectId,chvName)
+);
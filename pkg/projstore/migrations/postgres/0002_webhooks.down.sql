@@ -0,0 +1,4 @@
+-- 2: outbound webhooks and their delivery log.
+
+DROP TABLE tb_WebhookDelivery;
+DROP TABLE tb_Webhook;
@@ -0,0 +1,51 @@
+-- 3: email notification preferences and log.
+
+-- MService team member notification preference
+CREATE TABLE tb_MemberNotify
+(
+
+    -- team member id
+    inbMemberId BIGINT NOT NULL,
+    -- creation date
+    dtmCreated TIMESTAMP NOT NULL,
+    -- modification date
+    dtmModified TIMESTAMP NOT NULL,
+    -- mservice account id
+    inbMserviceId BIGINT NOT NULL,
+    -- has team member opted out of email notifications?
+    bitOptOut BOOLEAN NOT NULL,
+
+
+    PRIMARY KEY (inbMemberId)
+);
+
+-- MService project email notification log
+CREATE TABLE tb_Notification
+(
+
+    -- notification identifier
+    inbNotificationId BIGSERIAL NOT NULL,
+    -- creation date
+    dtmCreated TIMESTAMP NOT NULL,
+    -- mservice account id
+    inbMserviceId BIGINT NOT NULL,
+    -- team member id
+    inbMemberId BIGINT NOT NULL,
+    -- task identifier
+    inbTaskId BIGINT NOT NULL,
+    -- notification kind
+    chvKind VARCHAR(32) NOT NULL,
+    -- recipient email address
+    chvEmail VARCHAR(255) NOT NULL,
+    -- message subject
+    chvSubject VARCHAR(255) NOT NULL,
+    -- was the message accepted by the smtp relay?
+    bitIsSent BOOLEAN NOT NULL,
+    -- last send error
+    chvLastError VARCHAR(255) NOT NULL,
+
+
+    PRIMARY KEY (inbNotificationId)
+);
+
+CREATE INDEX tb_Notification_inbMemberId_inbTaskId_chvKind_idx ON tb_Notification (inbMemberId,inbTaskId,chvKind);
@@ -0,0 +1,4 @@
+-- 5: the account person directory.
+
+ALTER TABLE tb_TeamMember DROP COLUMN inbPersonId, ADD UNIQUE (inbProjectId,chvName);
+DROP TABLE tb_Person;
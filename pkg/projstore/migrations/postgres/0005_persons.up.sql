@@ -0,0 +1,67 @@
+-- 5: the account person directory. Each team member is linked to the person with its email address in the
+-- account, creating a person for each distinct address; team members without an email are given a placeholder
+-- address, so each becomes a separate person. A person is a team member of a project at most once, so the team
+-- members of a project sharing an address other than the most recent one are given placeholder addresses too.
+
+-- MService account person
+CREATE TABLE tb_Person
+(
+
+    -- person identifier
+    inbPersonId BIGSERIAL NOT NULL,
+    -- creation date
+    dtmCreated TIMESTAMP NOT NULL,
+    -- modification date
+    dtmModified TIMESTAMP NOT NULL,
+    -- deletion date
+    dtmDeleted TIMESTAMP NOT NULL,
+    -- has record been deleted?
+    bitIsDeleted BOOLEAN NOT NULL,
+    -- version of this record
+    intVersion INT NOT NULL,
+    -- mservice account id
+    inbMserviceId BIGINT NOT NULL,
+    -- entity name
+    chvName VARCHAR(32) NOT NULL,
+    -- email address of person
+    chvEmail VARCHAR(255) NOT NULL,
+    -- MService user id bound to the person
+    inbUserId BIGINT NULL,
+
+
+    PRIMARY KEY (inbPersonId),
+    UNIQUE (inbMserviceId,chvEmail),
+    UNIQUE (inbMserviceId,inbUserId)
+);
+
+ALTER TABLE tb_TeamMember ADD COLUMN inbPersonId BIGINT NOT NULL DEFAULT 0;
+
+CREATE INDEX tb_TeamMember_inbPersonId_idx ON tb_TeamMember (inbPersonId);
+
+UPDATE tb_TeamMember SET chvEmail = 'member' || inbMemberId || '@mproject.invalid' WHERE TRIM(chvEmail) = '';
+
+UPDATE tb_TeamMember SET chvEmail = LOWER(TRIM(chvEmail));
+
+UPDATE tb_TeamMember SET chvEmail = 'member' || inbMemberId || '@mproject.invalid'
+WHERE inbMemberId IN (SELECT d.inbMemberId FROM (SELECT m.inbMemberId FROM tb_TeamMember AS m
+    JOIN tb_TeamMember AS m2 ON m2.inbProjectId = m.inbProjectId AND m2.chvEmail = m.chvEmail
+    AND (m2.bitIsDeleted < m.bitIsDeleted OR (m2.bitIsDeleted = m.bitIsDeleted AND m2.inbMemberId > m.inbMemberId)))
+    AS d);
+
+-- one person per distinct email in an account, named from the most recent team member
+INSERT INTO tb_Person (dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId, chvName, chvEmail)
+SELECT MIN(m.dtmCreated), NOW(), NOW(), FALSE, 1, m.inbMserviceId,
+    (SELECT m2.chvName FROM tb_TeamMember AS m2 WHERE m2.inbMserviceId = m.inbMserviceId AND m2.chvEmail = m.chvEmail
+    ORDER BY m2.bitIsDeleted, m2.inbMemberId DESC LIMIT 1),
+    m.chvEmail
+FROM tb_TeamMember AS m
+GROUP BY m.inbMserviceId, m.chvEmail;
+
+UPDATE tb_TeamMember AS m SET inbPersonId = p.inbPersonId
+FROM tb_Person AS p WHERE p.inbMserviceId = m.inbMserviceId AND p.chvEmail = m.chvEmail;
+
+ALTER TABLE tb_TeamMember ALTER COLUMN inbPersonId DROP DEFAULT;
+
+-- team members are unique by person rather than by name
+ALTER TABLE tb_TeamMember DROP CONSTRAINT tb_teammember_inbprojectid_chvname_key,
+    ADD UNIQUE (inbProjectId,inbPersonId);
@@ -0,0 +1,34 @@
+-- 6: per-project access grants.
+
+-- MService project access grant to a user or project role
+CREATE TABLE tb_ProjectGrant
+(
+
+    -- grant identifier
+    inbGrantId BIGSERIAL NOT NULL,
+    -- creation date
+    dtmCreated TIMESTAMP NOT NULL,
+    -- modification date
+    dtmModified TIMESTAMP NOT NULL,
+    -- deletion date
+    dtmDeleted TIMESTAMP NOT NULL,
+    -- has record been deleted?
+    bitIsDeleted BOOLEAN NOT NULL,
+    -- version of this record
+    intVersion INT NOT NULL,
+    -- mservice account id
+    inbMserviceId BIGINT NOT NULL,
+    -- project identifier
+    inbProjectId BIGINT NOT NULL,
+    -- MService user id granted access, or 0
+    inbUserId BIGINT NOT NULL,
+    -- project role id granted access, or 0
+    intProjectRoleId INT NOT NULL,
+    -- access granted, 1 viewer, 2 editor, 3 owner
+    intAccessLevel INT NOT NULL,
+
+
+    PRIMARY KEY (inbGrantId)
+);
+
+CREATE INDEX tb_ProjectGrant_inbProjectId_idx ON tb_ProjectGrant (inbProjectId);
@@ -0,0 +1,43 @@
+-- 7: account scoped api keys for automation.
+
+-- MService project api key for automation
+CREATE TABLE tb_ApiKey
+(
+
+    -- api key identifier
+    inbApiKeyId BIGSERIAL NOT NULL,
+    -- creation date
+    dtmCreated TIMESTAMP NOT NULL,
+    -- modification date
+    dtmModified TIMESTAMP NOT NULL,
+    -- deletion date
+    dtmDeleted TIMESTAMP NOT NULL,
+    -- has record been deleted?
+    bitIsDeleted BOOLEAN NOT NULL,
+    -- version of this record
+    intVersion INT NOT NULL,
+    -- mservice account id
+    inbMserviceId BIGINT NOT NULL,
+    -- api key name
+    chvName VARCHAR(255) NOT NULL,
+    -- first characters of the key, to recognize it
+    chvKeyPrefix VARCHAR(16) NOT NULL,
+    -- hex SHA-256 hash of the key
+    chvKeyHash CHAR(64) NOT NULL,
+    -- projsvc claim value of the key, eg projrw
+    chvRole VARCHAR(32) NOT NULL,
+    -- comma separated method names or patterns the key may call, or empty for all
+    chvScopes VARCHAR(1000) NOT NULL,
+    -- MService user id the key acts as, or 0
+    inbUserId BIGINT NOT NULL,
+    -- expiration date
+    dtmExpires TIMESTAMP NOT NULL,
+    -- date of last use
+    dtmLastUsed TIMESTAMP NULL,
+
+
+    PRIMARY KEY (inbApiKeyId),
+    UNIQUE (chvKeyHash)
+);
+
+CREATE INDEX tb_ApiKey_inbMserviceId_idx ON tb_ApiKey (inbMserviceId);
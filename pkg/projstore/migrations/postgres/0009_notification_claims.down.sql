@@ -0,0 +1,6 @@
+-- 9: claims of due date reminders.
+
+DROP INDEX tb_Notification_reminder_key;
+ALTER TABLE tb_Notification DROP COLUMN dtmTaskModified;
+ALTER TABLE tb_Notification DROP COLUMN intAttempts;
+ALTER TABLE tb_Notification DROP COLUMN dtmNextAttempt;
@@ -0,0 +1,17 @@
+-- 9: claims of due date reminders, so that a reminder is sent by one server only, and retries of failed sends.
+
+-- modification date of the task a reminder is for, null for event notifications
+ALTER TABLE tb_Notification ADD COLUMN dtmTaskModified TIMESTAMP NULL;
+-- number of attempts to send the notification
+ALTER TABLE tb_Notification ADD COLUMN intAttempts INT NOT NULL DEFAULT 1;
+-- next attempt to send a failed reminder, or while a server holds it, null if none
+ALTER TABLE tb_Notification ADD COLUMN dtmNextAttempt TIMESTAMP NULL;
+
+-- reminders already sent count for the task as it was last modified, so they are not sent again
+UPDATE tb_Notification SET dtmTaskModified = (SELECT t.dtmModified FROM tb_Task AS t WHERE t.inbTaskId = tb_Notification.inbTaskId)
+WHERE inbNotificationId IN (SELECT m.inbNotificationId FROM (SELECT MAX(n.inbNotificationId) AS inbNotificationId
+    FROM tb_Notification AS n JOIN tb_Task AS t ON t.inbTaskId = n.inbTaskId
+    WHERE n.chvKind = 'task_due' AND n.bitIsSent = TRUE AND n.dtmCreated >= t.dtmModified
+    GROUP BY n.inbMemberId, n.inbTaskId) AS m);
+
+CREATE UNIQUE INDEX tb_Notification_reminder_key ON tb_Notification (inbMemberId,inbTaskId,chvKind,dtmTaskModified);
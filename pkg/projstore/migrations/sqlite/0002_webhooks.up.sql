@@ -0,0 +1,66 @@
+-- 2: outbound webhooks and their delivery log.
+
+-- MService project webhook registration
+CREATE TABLE tb_Webhook
+(
+
+    -- webhook identifier
+    inbWebhookId INTEGER PRIMARY KEY AUTOINCREMENT NOT NULL,
+    -- creation date
+    dtmCreated DATETIME NOT NULL,
+    -- modification date
+    dtmModified DATETIME NOT NULL,
+    -- deletion date
+    dtmDeleted DATETIME NOT NULL,
+    -- has record been deleted?
+    bitIsDeleted BOOLEAN NOT NULL,
+    -- version of this record
+    intVersion INT NOT NULL,
+    -- mservice account id
+    inbMserviceId BIGINT NOT NULL,
+    -- url receiving the webhook POST
+    chvUrl VARCHAR(255) NOT NULL,
+    -- HMAC signing secret
+    chvSecret VARCHAR(64) NOT NULL,
+    -- comma separated subscribed event types
+    chvEventTypes VARCHAR(255) NOT NULL,
+    -- is webhook delivery enabled?
+    bitIsActive BOOLEAN NOT NULL
+);
+
+CREATE INDEX ix_tb_Webhook_inbMserviceId ON tb_Webhook (inbMserviceId);
+
+-- MService project webhook delivery log entry
+CREATE TABLE tb_WebhookDelivery
+(
+
+    -- webhook delivery identifier
+    inbDeliveryId INTEGER PRIMARY KEY AUTOINCREMENT NOT NULL,
+    -- creation date
+    dtmCreated DATETIME NOT NULL,
+    -- modification date
+    dtmModified DATETIME NOT NULL,
+    -- mservice account id
+    inbMserviceId BIGINT NOT NULL,
+    -- webhook identifier
+    inbWebhookId BIGINT NOT NULL,
+    -- webhook event type
+    chvEventType VARCHAR(32) NOT NULL,
+    -- event sequence number
+    inbSequence BIGINT NOT NULL,
+    -- JSON payload sent to the webhook url
+    txtPayload TEXT NOT NULL,
+    -- number of delivery attempts
+    intAttempts INT NOT NULL,
+    -- HTTP status code of the last attempt
+    intResponseCode INT NOT NULL,
+    -- error from the last attempt
+    chvLastError VARCHAR(255) NOT NULL,
+    -- has payload been delivered?
+    bitIsDelivered BOOLEAN NOT NULL,
+    -- time of the next attempt, null once delivered or given up
+    dtmNextAttempt DATETIME NULL
+);
+
+CREATE INDEX ix_tb_WebhookDelivery_inbMserviceId_inbWebhookId ON tb_WebhookDelivery (inbMserviceId,inbWebhookId);
+CREATE INDEX ix_tb_WebhookDelivery_dtmNextAttempt ON tb_WebhookDelivery (dtmNextAttempt);
@@ -0,0 +1,48 @@
+-- 3: email notification preferences and log.
+
+-- MService team member notification preference
+CREATE TABLE tb_MemberNotify
+(
+
+    -- team member id
+    inbMemberId BIGINT NOT NULL,
+    -- creation date
+    dtmCreated DATETIME NOT NULL,
+    -- modification date
+    dtmModified DATETIME NOT NULL,
+    -- mservice account id
+    inbMserviceId BIGINT NOT NULL,
+    -- has team member opted out of email notifications?
+    bitOptOut BOOLEAN NOT NULL,
+
+
+    PRIMARY KEY (inbMemberId)
+);
+
+-- MService project email notification log
+CREATE TABLE tb_Notification
+(
+
+    -- notification identifier
+    inbNotificationId INTEGER PRIMARY KEY AUTOINCREMENT NOT NULL,
+    -- creation date
+    dtmCreated DATETIME NOT NULL,
+    -- mservice account id
+    inbMserviceId BIGINT NOT NULL,
+    -- team member id
+    inbMemberId BIGINT NOT NULL,
+    -- task identifier
+    inbTaskId BIGINT NOT NULL,
+    -- notification kind
+    chvKind VARCHAR(32) NOT NULL,
+    -- recipient email address
+    chvEmail VARCHAR(255) NOT NULL,
+    -- message subject
+    chvSubject VARCHAR(255) NOT NULL,
+    -- was the message accepted by the smtp relay?
+    bitIsSent BOOLEAN NOT NULL,
+    -- last send error
+    chvLastError VARCHAR(255) NOT NULL
+);
+
+CREATE INDEX ix_tb_Notification_inbMemberId_inbTaskId_chvKind ON tb_Notification (inbMemberId,inbTaskId,chvKind);
@@ -0,0 +1,5 @@
+-- 4: member capacity, days off and assignment estimates.
+
+DROP TABLE tb_AssignmentEstimate;
+DROP TABLE tb_MemberDayOff;
+DROP TABLE tb_MemberCapacity;
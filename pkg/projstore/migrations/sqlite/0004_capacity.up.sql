@@ -0,0 +1,68 @@
+-- 4: member capacity, days off and assignment estimates.
+
+-- MService team member working capacity
+CREATE TABLE tb_MemberCapacity
+(
+
+    -- team member id
+    inbMemberId BIGINT NOT NULL,
+    -- creation date
+    dtmCreated DATETIME NOT NULL,
+    -- modification date
+    dtmModified DATETIME NOT NULL,
+    -- version of this record
+    intVersion INT NOT NULL,
+    -- mservice account id
+    inbMserviceId BIGINT NOT NULL,
+    -- project identifier
+    inbProjectId BIGINT NOT NULL,
+    -- working hours per day
+    decHoursPerDay DECIMAL(19,2) NOT NULL,
+    -- percent of working time allocated to the project
+    intAllocationPercent INT NOT NULL,
+
+
+    PRIMARY KEY (inbMemberId)
+);
+
+-- MService team member day off
+CREATE TABLE tb_MemberDayOff
+(
+
+    -- team member id
+    inbMemberId BIGINT NOT NULL,
+    -- day not available
+    dtmDayOff DATE NOT NULL,
+    -- creation date
+    dtmCreated DATETIME NOT NULL,
+    -- mservice account id
+    inbMserviceId BIGINT NOT NULL,
+    -- reason for day off
+    chvReason VARCHAR(255) NOT NULL,
+
+
+    PRIMARY KEY (inbMemberId,dtmDayOff)
+);
+
+-- MService estimated hours for a team member on a task
+CREATE TABLE tb_AssignmentEstimate
+(
+
+    -- project identifier
+    inbProjectId BIGINT NOT NULL,
+    -- task identifier
+    inbTaskId BIGINT NOT NULL,
+    -- team member id
+    inbMemberId BIGINT NOT NULL,
+    -- creation date
+    dtmCreated DATETIME NOT NULL,
+    -- modification date
+    dtmModified DATETIME NOT NULL,
+    -- mservice account id
+    inbMserviceId BIGINT NOT NULL,
+    -- estimated hours for the team member on the task
+    decEstimatedHours DECIMAL(19,2) NOT NULL,
+
+
+    PRIMARY KEY (inbProjectId,inbTaskId,inbMemberId)
+);
@@ -0,0 +1,43 @@
+-- 5: the account person directory.
+
+CREATE TABLE tb_TeamMember_old
+(
+
+    -- team member id
+    inbMemberId INTEGER PRIMARY KEY AUTOINCREMENT NOT NULL,
+    -- creation date
+    dtmCreated DATETIME NOT NULL,
+    -- modification date
+    dtmModified DATETIME NOT NULL,
+    -- deletion date
+    dtmDeleted DATETIME NOT NULL,
+    -- has record been deleted?
+    bitIsDeleted BOOLEAN NOT NULL,
+    -- version of this record
+    intVersion INT NOT NULL,
+    -- mservice account id
+    inbMserviceId BIGINT NOT NULL,
+    -- project identifier
+    inbProjectId BIGINT NOT NULL,
+    -- entity name
+    chvName VARCHAR(32) NOT NULL,
+    -- role id of this team member
+    intProjectRoleId INT NOT NULL,
+    -- email address of team member
+    chvEmail VARCHAR(255) NOT NULL,
+
+
+    UNIQUE (inbProjectId,chvName)
+);
+
+INSERT INTO tb_TeamMember_old (inbMemberId, dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion,
+    inbMserviceId, inbProjectId, chvName, intProjectRoleId, chvEmail)
+SELECT inbMemberId, dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion,
+    inbMserviceId, inbProjectId, chvName, intProjectRoleId, chvEmail
+FROM tb_TeamMember;
+
+DROP TABLE tb_TeamMember;
+
+ALTER TABLE tb_TeamMember_old RENAME TO tb_TeamMember;
+
+DROP TABLE tb_Person;
@@ -0,0 +1,103 @@
+-- 5: the account person directory. Each team member is linked to the person with its email address in the
+-- account, creating a person for each distinct address; team members without an email are given a placeholder
+-- address, so each becomes a separate person. A person is a team member of a project at most once, so the team
+-- members of a project sharing an address other than the most recent one are given placeholder addresses too.
+
+-- MService account person
+CREATE TABLE tb_Person
+(
+
+    -- person identifier
+    inbPersonId INTEGER PRIMARY KEY AUTOINCREMENT NOT NULL,
+    -- creation date
+    dtmCreated DATETIME NOT NULL,
+    -- modification date
+    dtmModified DATETIME NOT NULL,
+    -- deletion date
+    dtmDeleted DATETIME NOT NULL,
+    -- has record been deleted?
+    bitIsDeleted BOOLEAN NOT NULL,
+    -- version of this record
+    intVersion INT NOT NULL,
+    -- mservice account id
+    inbMserviceId BIGINT NOT NULL,
+    -- entity name
+    chvName VARCHAR(32) NOT NULL,
+    -- email address of person
+    chvEmail VARCHAR(255) NOT NULL,
+    -- MService user id bound to the person
+    inbUserId BIGINT NULL,
+
+
+    UNIQUE (inbMserviceId,chvEmail),
+    UNIQUE (inbMserviceId,inbUserId)
+);
+
+ALTER TABLE tb_TeamMember ADD COLUMN inbPersonId BIGINT NOT NULL DEFAULT 0;
+
+UPDATE tb_TeamMember SET chvEmail = 'member' || inbMemberId || '@mproject.invalid' WHERE TRIM(chvEmail) = '';
+
+UPDATE tb_TeamMember SET chvEmail = LOWER(TRIM(chvEmail));
+
+UPDATE tb_TeamMember SET chvEmail = 'member' || inbMemberId || '@mproject.invalid'
+WHERE inbMemberId IN (SELECT d.inbMemberId FROM (SELECT m.inbMemberId FROM tb_TeamMember AS m
+    JOIN tb_TeamMember AS m2 ON m2.inbProjectId = m.inbProjectId AND m2.chvEmail = m.chvEmail
+    AND (m2.bitIsDeleted < m.bitIsDeleted OR (m2.bitIsDeleted = m.bitIsDeleted AND m2.inbMemberId > m.inbMemberId)))
+    AS d);
+
+-- one person per distinct email in an account, named from the most recent team member
+INSERT INTO tb_Person (dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId, chvName, chvEmail)
+SELECT MIN(m.dtmCreated), CURRENT_TIMESTAMP, CURRENT_TIMESTAMP, FALSE, 1, m.inbMserviceId,
+    (SELECT m2.chvName FROM tb_TeamMember AS m2 WHERE m2.inbMserviceId = m.inbMserviceId AND m2.chvEmail = m.chvEmail
+    ORDER BY m2.bitIsDeleted, m2.inbMemberId DESC LIMIT 1),
+    m.chvEmail
+FROM tb_TeamMember AS m
+GROUP BY m.inbMserviceId, m.chvEmail;
+
+UPDATE tb_TeamMember SET inbPersonId = (SELECT p.inbPersonId FROM tb_Person AS p
+WHERE p.inbMserviceId = tb_TeamMember.inbMserviceId AND p.chvEmail = tb_TeamMember.chvEmail);
+
+-- team members are unique by person rather than by name; sqlite cannot change a unique key, so the table is rebuilt
+CREATE TABLE tb_TeamMember_new
+(
+
+    -- team member id
+    inbMemberId INTEGER PRIMARY KEY AUTOINCREMENT NOT NULL,
+    -- creation date
+    dtmCreated DATETIME NOT NULL,
+    -- modification date
+    dtmModified DATETIME NOT NULL,
+    -- deletion date
+    dtmDeleted DATETIME NOT NULL,
+    -- has record been deleted?
+    bitIsDeleted BOOLEAN NOT NULL,
+    -- version of this record
+    intVersion INT NOT NULL,
+    -- mservice account id
+    inbMserviceId BIGINT NOT NULL,
+    -- project identifier
+    inbProjectId BIGINT NOT NULL,
+    -- entity name
+    chvName VARCHAR(32) NOT NULL,
+    -- role id of this team member
+    intProjectRoleId INT NOT NULL,
+    -- email address of team member
+    chvEmail VARCHAR(255) NOT NULL,
+    -- person of the team member
+    inbPersonId BIGINT NOT NULL,
+
+
+    UNIQUE (inbProjectId,inbPersonId)
+);
+
+INSERT INTO tb_TeamMember_new (inbMemberId, dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion,
+    inbMserviceId, inbProjectId, chvName, intProjectRoleId, chvEmail, inbPersonId)
+SELECT inbMemberId, dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion,
+    inbMserviceId, inbProjectId, chvName, intProjectRoleId, chvEmail, inbPersonId
+FROM tb_TeamMember;
+
+DROP TABLE tb_TeamMember;
+
+ALTER TABLE tb_TeamMember_new RENAME TO tb_TeamMember;
+
+CREATE INDEX ix_tb_TeamMember_inbPersonId ON tb_TeamMember (inbPersonId);
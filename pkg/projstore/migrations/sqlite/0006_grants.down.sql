@@ -0,0 +1,3 @@
+-- 6: per-project access grants.
+
+DROP TABLE tb_ProjectGrant;
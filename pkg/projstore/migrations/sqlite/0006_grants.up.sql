@@ -0,0 +1,31 @@
+-- 6: per-project access grants.
+
+-- MService project access grant to a user or project role
+CREATE TABLE tb_ProjectGrant
+(
+
+    -- grant identifier
+    inbGrantId INTEGER PRIMARY KEY AUTOINCREMENT NOT NULL,
+    -- creation date
+    dtmCreated DATETIME NOT NULL,
+    -- modification date
+    dtmModified DATETIME NOT NULL,
+    -- deletion date
+    dtmDeleted DATETIME NOT NULL,
+    -- has record been deleted?
+    bitIsDeleted BOOLEAN NOT NULL,
+    -- version of this record
+    intVersion INT NOT NULL,
+    -- mservice account id
+    inbMserviceId BIGINT NOT NULL,
+    -- project identifier
+    inbProjectId BIGINT NOT NULL,
+    -- MService user id granted access, or 0
+    inbUserId BIGINT NOT NULL,
+    -- project role id granted access, or 0
+    intProjectRoleId INT NOT NULL,
+    -- access granted, 1 viewer, 2 editor, 3 owner
+    intAccessLevel INT NOT NULL
+);
+
+CREATE INDEX ix_tb_ProjectGrant_inbProjectId ON tb_ProjectGrant (inbProjectId);
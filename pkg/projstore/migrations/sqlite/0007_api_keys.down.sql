@@ -0,0 +1,3 @@
+-- 7: account scoped api keys for automation.
+
+DROP TABLE tb_ApiKey;
@@ -0,0 +1,3 @@
+-- 8: version of task assignments, for optimistic concurrency as on the other tables.
+
+ALTER TABLE tb_TaskToMember ADD COLUMN intVersion INT NOT NULL DEFAULT 1;
@@ -0,0 +1,6 @@
+-- 9: claims of due date reminders.
+
+DROP INDEX ux_tb_Notification_reminder;
+ALTER TABLE tb_Notification DROP COLUMN dtmTaskModified;
+ALTER TABLE tb_Notification DROP COLUMN intAttempts;
+ALTER TABLE tb_Notification DROP COLUMN dtmNextAttempt;
@@ -0,0 +1,408 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package projstore provides the storage of projects, tasks, team members, types, task assignments, persons,
+// grants, api keys, webhooks and capacity for the MServiceProject gRPC service.
+package projstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+	sdec "github.com/shopspring/decimal"
+
+	pb "github.com/gaterace/mproject/pkg/mserviceproject"
+)
+
+// Returned when an entity does not exist or is deleted.
+var ErrNotFound = errors.New("not found")
+
+// Returned when an entity exists but does not have the expected version, as another update came first.
+var ErrVersionConflict = errors.New("version conflict")
+
+// Error from the underlying database, with the operation that failed: Prepare, Query, QueryRow, Scan, Exec,
+// Begin or Commit, or Duplicate for an insert or update that violates a unique key.
+type DbError struct {
+	What string
+	Err  error
+}
+
+func (e *DbError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *DbError) Unwrap() error {
+	return e.Err
+}
+
+// Get a dml.Decimal from a DECIMAL(19,2) column scanned as a string. Drivers without a decimal type, such as
+// sqlite, return the number without its trailing zeros, which dml.DecimalFromString rejects.
+func DecimalFromDb(s string) (*dml.Decimal, error) {
+	d, err := sdec.NewFromString(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return dml.DecimalFromString(d.StringFixed(2))
+}
+
+// Storage of projects.
+type ProjectRepository interface {
+	// create a project, returning the new project id
+	CreateProject(ctx context.Context, project *pb.Project) (int64, error)
+	// update a project having project.Version, which becomes project.Version + 1
+	UpdateProject(ctx context.Context, project *pb.Project) error
+	// delete a project having the version, which becomes version + 1
+	DeleteProject(ctx context.Context, projectId int64, mserviceId int64, version int32) error
+	// get a project with its status name by id
+	GetProjectById(ctx context.Context, projectId int64, mserviceId int64) (*pb.Project, error)
+	// get a project with its status name by name
+	GetProjectByName(ctx context.Context, name string, mserviceId int64) (*pb.Project, error)
+	// get the names of all projects for an mservice id
+	GetProjectNames(ctx context.Context, mserviceId int64) ([]string, error)
+	// check that a project exists for an mservice id, locking it until the end of a transaction
+	ProjectExists(ctx context.Context, projectId int64, mserviceId int64) (bool, error)
+}
+
+// Storage of tasks.
+type TaskRepository interface {
+	// create a task, returning the new task id
+	CreateTask(ctx context.Context, task *pb.Task) (int64, error)
+	// update a task having task.Version, which becomes task.Version + 1
+	UpdateTask(ctx context.Context, task *pb.Task) error
+	// delete a task having the version, which becomes version + 1
+	DeleteTask(ctx context.Context, taskId int64, mserviceId int64, version int32) error
+	// bump the version of a task having the version, without other changes
+	TouchTask(ctx context.Context, taskId int64, mserviceId int64, version int32) error
+	// set the position of a child task under its parent, bumping the child version
+	SetTaskPosition(ctx context.Context, taskId int64, parentId int64, mserviceId int64, position int32) error
+	// get a task with its status name by id
+	GetTaskById(ctx context.Context, taskId int64, mserviceId int64) (*pb.Task, error)
+	// get the tasks of a project, ordered by parent id and position
+	GetTasksByProject(ctx context.Context, projectId int64, mserviceId int64) ([]*pb.Task, error)
+	// get the project id and status id of a task, locking it until the end of a transaction
+	GetTaskProject(ctx context.Context, taskId int64, mserviceId int64) (int64, int32, error)
+	// check that a task exists in a project, locking it until the end of a transaction
+	TaskExists(ctx context.Context, taskId int64, projectId int64) (bool, error)
+}
+
+// Storage of team members and their notification preference.
+type MemberRepository interface {
+	// create a team member, returning the new member id
+	CreateMember(ctx context.Context, member *pb.TeamMember) (int64, error)
+	// update a team member having member.Version, which becomes member.Version + 1
+	UpdateMember(ctx context.Context, member *pb.TeamMember) error
+	// delete a team member having the version, which becomes version + 1
+	DeleteMember(ctx context.Context, memberId int64, mserviceId int64, version int32) error
+	// get a team member with its role name by id
+	GetMemberById(ctx context.Context, memberId int64, mserviceId int64) (*pb.TeamMember, error)
+	// get the team members of a project
+	GetMembersByProject(ctx context.Context, projectId int64, mserviceId int64) ([]*pb.TeamMember, error)
+	// get the team members assigned to a task, with their task hours
+	GetMembersByTask(ctx context.Context, projectId int64, taskId int64, mserviceId int64) ([]*pb.TeamMember, error)
+	// get the project id and person id of a team member, locking it until the end of a transaction
+	GetMemberProject(ctx context.Context, memberId int64, mserviceId int64) (int64, int64, error)
+	// check that a team member exists in a project, locking it until the end of a transaction
+	MemberExists(ctx context.Context, memberId int64, projectId int64, mserviceId int64) (bool, error)
+	// check whether a person is already a team member of a project
+	PersonIsMember(ctx context.Context, projectId int64, personId int64, mserviceId int64) (bool, error)
+	// set whether a team member opts out of email notifications
+	SetMemberNotify(ctx context.Context, memberId int64, mserviceId int64, optOut bool) error
+	// get whether a team member opts out of email notifications
+	GetMemberNotify(ctx context.Context, memberId int64, mserviceId int64) (bool, error)
+}
+
+// Storage of status types and project role types.
+type TypeRepository interface {
+	CreateStatusType(ctx context.Context, statusType *pb.StatusType) error
+	UpdateStatusType(ctx context.Context, statusType *pb.StatusType) error
+	DeleteStatusType(ctx context.Context, statusId int32, mserviceId int64, version int32) error
+	GetStatusType(ctx context.Context, statusId int32, mserviceId int64) (*pb.StatusType, error)
+	GetStatusTypes(ctx context.Context, mserviceId int64) ([]*pb.StatusType, error)
+
+	CreateRoleType(ctx context.Context, roleType *pb.ProjectRoleType) error
+	UpdateRoleType(ctx context.Context, roleType *pb.ProjectRoleType) error
+	DeleteRoleType(ctx context.Context, projectRoleId int32, mserviceId int64, version int32) error
+	GetRoleType(ctx context.Context, projectRoleId int32, mserviceId int64) (*pb.ProjectRoleType, error)
+	GetRoleTypes(ctx context.Context, mserviceId int64) ([]*pb.ProjectRoleType, error)
+}
+
+// Storage of task to team member assignments.
+type AssignmentRepository interface {
+	// assign a team member to a task with no hours, or restore a removed assignment with its task hours,
+	// returning its version
+	AddAssignment(ctx context.Context, projectId int64, taskId int64, memberId int64, mserviceId int64) (int32, error)
+	// remove a team member from a task, keeping its task hours, if the assignment is at version
+	RemoveAssignment(ctx context.Context, projectId int64, taskId int64, memberId int64, mserviceId int64,
+		version int32) error
+	// add to the task hours of an assignment, if the assignment is at version
+	AddTaskHours(ctx context.Context, projectId int64, taskId int64, memberId int64, mserviceId int64,
+		version int32, taskHours *dml.Decimal) error
+	// replace the task hours of an assignment, if the assignment is at version
+	SetTaskHours(ctx context.Context, projectId int64, taskId int64, memberId int64, mserviceId int64,
+		version int32, taskHours *dml.Decimal) error
+	// get the assignments of a project
+	GetAssignmentsByProject(ctx context.Context, projectId int64, mserviceId int64) ([]*pb.TaskToMember, error)
+}
+
+// Storage of the persons of an account directory, with their team memberships across projects.
+type PersonRepository interface {
+	// create a person, returning the new person id
+	CreatePerson(ctx context.Context, person *pb.Person) (int64, error)
+	// restore a deleted person having the version under a new name, the version becoming version + 1
+	RestorePerson(ctx context.Context, personId int64, mserviceId int64, version int32, name string) error
+	// update the name and email of a person having person.Version, which becomes person.Version + 1
+	UpdatePerson(ctx context.Context, person *pb.Person) error
+	// delete a person having the version, which becomes version + 1
+	DeletePerson(ctx context.Context, personId int64, mserviceId int64, version int32) error
+	// bind a person having the version to an MService user id, or unbind it with 0
+	SetPersonUser(ctx context.Context, personId int64, mserviceId int64, version int32, userId int64) error
+	// get a person by id
+	GetPersonById(ctx context.Context, personId int64, mserviceId int64) (*pb.Person, error)
+	// get the person with an email address, including a deleted person
+	GetPersonByEmail(ctx context.Context, email string, mserviceId int64) (*pb.Person, error)
+	// get the person bound to an MService user id
+	GetPersonByUser(ctx context.Context, userId int64, mserviceId int64) (*pb.Person, error)
+	// get the persons of an account, ordered by name
+	GetPersons(ctx context.Context, mserviceId int64) ([]*pb.Person, error)
+	// count the team members of a person
+	CountPersonMembers(ctx context.Context, personId int64, mserviceId int64) (int, error)
+	// get the project memberships of a person with their task hours, ordered by project name
+	GetPersonProjects(ctx context.Context, personId int64, mserviceId int64) ([]*pb.PersonProject, error)
+	// get the task assignments of a person across projects, ordered by end date
+	GetPersonTasks(ctx context.Context, personId int64, mserviceId int64) ([]*pb.PersonTask, error)
+	// copy the name and email of a person to their team members, leaving their versions unchanged
+	SyncPersonMembers(ctx context.Context, personId int64, mserviceId int64) error
+	// copy the name and email of a team member to its person, leaving its version unchanged
+	UpdatePersonFromMember(ctx context.Context, memberId int64, personId int64, mserviceId int64) error
+}
+
+// Access levels of a project grant by name, as stored in tb_ProjectGrant.intAccessLevel.
+var GrantAccessLevels = map[string]int32{
+	"viewer": 1,
+	"editor": 2,
+	"owner":  3,
+}
+
+// Names of the access levels of a project grant.
+var GrantAccessNames = map[int32]string{
+	1: "viewer",
+	2: "editor",
+	3: "owner",
+}
+
+// Storage of project grants, with grant.Access one of the GrantAccessLevels.
+type GrantRepository interface {
+	// create a grant, returning the new grant id
+	CreateGrant(ctx context.Context, grant *pb.ProjectGrant) (int64, error)
+	// update the access of a grant having grant.Version, which becomes grant.Version + 1
+	UpdateGrant(ctx context.Context, grant *pb.ProjectGrant) error
+	// delete a grant having the version, which becomes version + 1
+	DeleteGrant(ctx context.Context, grantId int64, mserviceId int64, version int32) error
+	// check whether a project already has a grant to a user id or project role id
+	GrantExists(ctx context.Context, projectId int64, mserviceId int64, userId int64, projectRoleId int32) (bool, error)
+	// get the grants of a project
+	GetGrantsByProject(ctx context.Context, projectId int64, mserviceId int64) ([]*pb.ProjectGrant, error)
+	// get the project id of a grant
+	GetGrantProject(ctx context.Context, grantId int64, mserviceId int64) (int64, error)
+	// get the highest access level granted on a project to a user id, directly or to the project role of a
+	// team member bound to the user id, 0 if none
+	GetGrantedAccess(ctx context.Context, projectId int64, mserviceId int64, userId int64) (int32, error)
+}
+
+// Storage of api keys, of which only the hash is kept.
+type ApiKeyRepository interface {
+	// create an api key with the hash of the key, returning the new api key id
+	CreateApiKey(ctx context.Context, apiKey *pb.ApiKey, keyHash string) (int64, error)
+	// revoke an api key having the version, which becomes version + 1
+	RevokeApiKey(ctx context.Context, apiKeyId int64, mserviceId int64, version int32) error
+	// get the api keys of an account
+	GetApiKeys(ctx context.Context, mserviceId int64) ([]*pb.ApiKey, error)
+	// get the api key with the hash of a key, and whether it has not yet expired
+	GetApiKeyByHash(ctx context.Context, keyHash string) (*pb.ApiKey, bool, error)
+	// record that an api key has just been used, unless its last use was recorded within the last minute
+	TouchApiKey(ctx context.Context, apiKeyId int64) error
+}
+
+// Seconds between the recorded uses of an api key, so that a busy key does not write on every request.
+const apiKeyTouchSeconds = 60
+
+// Storage of webhooks and their delivery log.
+type WebhookRepository interface {
+	// create a webhook with its signing secret, returning the new webhook id
+	CreateWebhook(ctx context.Context, webhook *pb.Webhook, secret string) (int64, error)
+	// update a webhook having webhook.Version, which becomes webhook.Version + 1
+	UpdateWebhook(ctx context.Context, webhook *pb.Webhook) error
+	// delete a webhook having the version, which becomes version + 1
+	DeleteWebhook(ctx context.Context, webhookId int64, mserviceId int64, version int32) error
+	// get the webhooks of an account
+	GetWebhooks(ctx context.Context, mserviceId int64) ([]*pb.Webhook, error)
+	// get the most recent deliveries of a webhook, newest first
+	GetDeliveries(ctx context.Context, webhookId int64, mserviceId int64, limit int32) ([]*pb.WebhookDelivery, error)
+	// get the ids of the active webhooks of an account subscribed to an event type
+	GetSubscribedWebhooks(ctx context.Context, mserviceId int64, eventType string) ([]int64, error)
+	// log a pending delivery of an event to a webhook, held for its first attempt for holdSeconds, returning the new
+	// delivery id
+	CreateDelivery(ctx context.Context, delivery *pb.WebhookDelivery, holdSeconds int) (int64, error)
+	// get a logged delivery with the url and secret of its webhook, which must not be deleted
+	GetDelivery(ctx context.Context, deliveryId int64, mserviceId int64) (*pb.WebhookDelivery, string, string, error)
+	// get the deliveries to webhooks that are not deleted whose next attempt is due, oldest first
+	GetDueDeliveries(ctx context.Context, limit int32) ([]*pb.WebhookDelivery, error)
+	// hold a delivery whose next attempt is due for holdSeconds, so that no other server attempts it; ErrNotFound if
+	// it is not due
+	ClaimDelivery(ctx context.Context, deliveryId int64, mserviceId int64, holdSeconds int) error
+	// hold a delivery for holdSeconds for another attempt, whether or not one is due
+	RetryDelivery(ctx context.Context, deliveryId int64, mserviceId int64, holdSeconds int) error
+	// record the outcome of an attempt at a delivery, with the next attempt in retrySeconds, or none if 0
+	RecordAttempt(ctx context.Context, deliveryId int64, mserviceId int64, responseCode int32, lastError string,
+		retrySeconds int) error
+}
+
+// Hours per day and allocation of a team member without a tb_MemberCapacity record.
+const (
+	DefaultHoursPerDay       = "8.00"
+	DefaultAllocationPercent = 100
+)
+
+// Capacity of a team member of a project, with the days off in the period asked for.
+type MemberCapacity struct {
+	MemberId          int64
+	Name              string
+	HoursPerDay       sdec.Decimal
+	AllocationPercent int32
+	DaysOff           []time.Time
+}
+
+// Hours of a team member on a task, the estimate if one is set and otherwise the recorded task hours.
+type MemberAssignment struct {
+	MemberId  int64
+	TaskId    int64
+	StartDate time.Time
+	EndDate   time.Time
+	Hours     sdec.Decimal
+}
+
+// Dates, priority and parent of a task.
+type TaskSchedule struct {
+	TaskId    int64
+	Name      string
+	StartDate time.Time
+	EndDate   time.Time
+	Priority  int32
+	ParentId  int64
+}
+
+// Storage of team member capacity, days off and assignment estimates, and of the data for capacity reports
+// and leveling.
+type CapacityRepository interface {
+	// set the capacity of a team member, creating it if capacity.Version is 0, which becomes capacity.Version + 1
+	SetMemberCapacity(ctx context.Context, capacity *pb.MemberCapacity) error
+	// get the capacity of a team member with its days off from today, the defaults at version 0 if not set
+	GetMemberCapacity(ctx context.Context, memberId int64, mserviceId int64) (*pb.MemberCapacity, error)
+	// add a day off for a team member, or replace its reason
+	AddDayOff(ctx context.Context, memberId int64, mserviceId int64, dayOff time.Time, reason string) error
+	// remove a day off of a team member
+	RemoveDayOff(ctx context.Context, memberId int64, mserviceId int64, dayOff time.Time) error
+	// set the estimated hours of a team member on a task they are assigned to
+	SetAssignmentEstimate(ctx context.Context, taskId int64, memberId int64, mserviceId int64,
+		estimatedHours sdec.Decimal) error
+	// get the capacity of the team members of a project with their days off from start to end, and their
+	// assignments to tasks overlapping start to end
+	GetCapacityData(ctx context.Context, projectId int64, mserviceId int64, start time.Time,
+		end time.Time) ([]*MemberCapacity, []*MemberAssignment, error)
+	// get the dates, priority and parent of all tasks of a project
+	GetTaskSchedules(ctx context.Context, projectId int64, mserviceId int64) ([]*TaskSchedule, error)
+}
+
+// Team member assigned to a task, with the task details for a notification.
+type Recipient struct {
+	MserviceId      int64
+	ProjectId       int64
+	ProjectName     string
+	TaskId          int64
+	TaskName        string
+	TaskDescription string
+	StatusName      string
+	EndDate         time.Time
+	MemberId        int64
+	MemberName      string
+	Email           string
+	// failed reminder due for another attempt, with the attempts made, or 0 if none
+	NotificationId int64
+	Attempts       int32
+}
+
+// Notification sent, or failed to send, to a team member.
+type Notification struct {
+	MserviceId int64
+	MemberId   int64
+	TaskId     int64
+	Kind       string
+	Email      string
+	Subject    string
+	LastError  string
+}
+
+// Storage of the email notifications of team members.
+type NotificationRepository interface {
+	// get the team members assigned to a task who have not opted out, or only memberId if not 0
+	GetTaskRecipients(ctx context.Context, taskId int64, mserviceId int64, memberId int64) ([]*Recipient, error)
+	// get the team members who have not opted out assigned to tasks ending from start to end, unless a reminder of
+	// the kind for the task as last modified was sent, is held by a server or has no attempt left
+	GetDueRecipients(ctx context.Context, start time.Time, end time.Time, kind string) ([]*Recipient, error)
+	// log a notification, sent if it has no last error
+	LogNotification(ctx context.Context, notification *Notification) error
+	// claim a reminder for the task as last modified, logged as pending and held for holdSeconds, or hold the failed
+	// reminder notificationId for another attempt if not 0; ErrNotFound if another server claimed it first
+	ClaimReminder(ctx context.Context, notification *Notification, notificationId int64, holdSeconds int) (int64, error)
+	// record the outcome of an attempt at a reminder, with the next attempt in retrySeconds, or none if 0
+	RecordReminder(ctx context.Context, notificationId int64, lastError string, retrySeconds int) error
+}
+
+// Storage for the MServiceProject service, one repository per aggregate.
+type Store interface {
+	Projects() ProjectRepository
+	Tasks() TaskRepository
+	Members() MemberRepository
+	Types() TypeRepository
+	Assignments() AssignmentRepository
+	Persons() PersonRepository
+	Grants() GrantRepository
+	ApiKeys() ApiKeyRepository
+	Webhooks() WebhookRepository
+	Capacity() CapacityRepository
+	Notifications() NotificationRepository
+
+	// run fn with a Store whose repositories share a transaction, committed if fn returns nil and rolled
+	// back otherwise; fn runs in the current transaction if the Store already has one
+	WithTx(ctx context.Context, fn func(tx Store) error) error
+}
+
+// Get a new Store for a storage backend. The sql backend stores in the database connection, the memory
+// backend keeps everything in memory until the process exits.
+func NewStore(backend string, db *DB) (Store, error) {
+	switch backend {
+	case "", "sql":
+		if db == nil {
+			return nil, fmt.Errorf("storage %s requires a database connection", backend)
+		}
+		return NewSqlStore(db), nil
+	case "memory":
+		return NewMemoryStore(), nil
+	}
+
+	return nil, fmt.Errorf("unknown storage: %s", backend)
+}
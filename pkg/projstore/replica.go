@@ -0,0 +1,97 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projstore
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+)
+
+// Context key marking statements that may be served by a read replica.
+type replicaKey struct{}
+
+// Get a context whose statements may be served by a read replica of the DB, for rpcs that only read.
+func WithReplica(ctx context.Context) context.Context {
+	return context.WithValue(ctx, replicaKey{}, true)
+}
+
+// Read replica of a DB, with the result of its latest health check.
+type replica struct {
+	db      *DB
+	healthy atomic.Bool
+}
+
+// Add a read replica for statements in contexts from WithReplica. The replica is used while its latest
+// health check succeeded; the error of the first check is returned, with the replica added regardless.
+// Call before the DB is used.
+func (db *DB) AddReplica(replicaDb *DB) error {
+	err := replicaDb.Ping()
+
+	r := &replica{db: replicaDb}
+	r.healthy.Store(err == nil)
+	db.replicas = append(db.replicas, r)
+
+	return err
+}
+
+// Ping the read replicas every interval until the DB is closed. Reads go to the primary while a replica fails.
+// changed is called with the replica index when a replica fails, with the error, or recovers, with nil.
+// An interval of 0 disables the checks.
+func (db *DB) StartReplicaChecks(interval time.Duration, changed func(index int, err error)) {
+	if (len(db.replicas) == 0) || (interval <= 0) {
+		return
+	}
+
+	db.done = make(chan struct{})
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-db.done:
+				return
+			case <-ticker.C:
+			}
+
+			for i, r := range db.replicas {
+				err := r.db.Ping()
+				if r.healthy.Swap(err == nil) != (err == nil) {
+					changed(i, err)
+				}
+			}
+		}
+	}()
+}
+
+// Get the database for the statements of ctx: a healthy read replica, taken in turn, if ctx is from
+// WithReplica, otherwise the primary.
+func (db *DB) Reader(ctx context.Context) *DB {
+	if (len(db.replicas) == 0) || (ctx.Value(replicaKey{}) == nil) {
+		return db
+	}
+
+	n := uint64(len(db.replicas))
+	start := db.nextReplica.Add(1)
+	for i := uint64(0); i < n; i++ {
+		r := db.replicas[(start+i)%n]
+		if r.healthy.Load() {
+			return r.db
+		}
+	}
+
+	return db
+}
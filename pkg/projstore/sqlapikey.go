@@ -0,0 +1,116 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projstore
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+
+	pb "github.com/gaterace/mproject/pkg/mserviceproject"
+)
+
+// Api keys in tb_ApiKey.
+type sqlApiKeys struct {
+	*sqlStore
+}
+
+func (s *sqlApiKeys) CreateApiKey(ctx context.Context, apiKey *pb.ApiKey, keyHash string) (int64, error) {
+	sqlstring := `INSERT INTO tb_ApiKey (dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId, chvName,
+	chvKeyPrefix, chvKeyHash, chvRole, chvScopes, inbUserId, dtmExpires) VALUES(NOW(), NOW(), NOW(), FALSE, 1, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	return s.insert(ctx, sqlstring, "inbApiKeyId", apiKey.GetMserviceId(), apiKey.GetName(), apiKey.GetKeyPrefix(),
+		keyHash, apiKey.GetRole(), strings.Join(apiKey.GetScopes(), ","), apiKey.GetUserId(),
+		apiKey.GetExpires().TimeFromDateTime())
+}
+
+func (s *sqlApiKeys) RevokeApiKey(ctx context.Context, apiKeyId int64, mserviceId int64, version int32) error {
+	sqlstring := `UPDATE tb_ApiKey SET dtmDeleted = NOW(), intVersion = ?, bitIsDeleted = TRUE
+	WHERE inbApiKeyId = ? AND inbMserviceId = ? AND intVersion = ? AND bitIsDeleted = FALSE`
+
+	err := s.execOne(ctx, sqlstring, version+1, apiKeyId, mserviceId, version)
+	return s.versionError(ctx, err, "tb_ApiKey", "inbApiKeyId = ? AND inbMserviceId = ? AND bitIsDeleted = FALSE",
+		apiKeyId, mserviceId)
+}
+
+func (s *sqlApiKeys) GetApiKeys(ctx context.Context, mserviceId int64) ([]*pb.ApiKey, error) {
+	apiKeys := make([]*pb.ApiKey, 0)
+
+	sqlstring := `SELECT inbApiKeyId, dtmCreated, dtmModified, intVersion, inbMserviceId, chvName, chvKeyPrefix, chvRole,
+	chvScopes, inbUserId, dtmExpires, dtmLastUsed FROM tb_ApiKey WHERE inbMserviceId = ? AND bitIsDeleted = FALSE
+	ORDER BY inbApiKeyId`
+
+	err := s.query(ctx, sqlstring, func(rows *sql.Rows) error {
+		var created string
+		var modified string
+		var expires string
+		var lastUsed sql.NullString
+		var scopes string
+		var apiKey pb.ApiKey
+
+		err := rows.Scan(&apiKey.ApiKeyId, &created, &modified, &apiKey.Version, &apiKey.MserviceId, &apiKey.Name,
+			&apiKey.KeyPrefix, &apiKey.Role, &scopes, &apiKey.UserId, &expires, &lastUsed)
+		if err != nil {
+			return err
+		}
+
+		apiKey.Created = dml.DateTimeFromString(created)
+		apiKey.Modified = dml.DateTimeFromString(modified)
+		apiKey.Expires = dml.DateTimeFromString(expires)
+		if lastUsed.Valid {
+			apiKey.LastUsed = dml.DateTimeFromString(lastUsed.String)
+		}
+		if scopes != "" {
+			apiKey.Scopes = strings.Split(scopes, ",")
+		}
+
+		apiKeys = append(apiKeys, &apiKey)
+		return nil
+	}, mserviceId)
+
+	return apiKeys, err
+}
+
+func (s *sqlApiKeys) GetApiKeyByHash(ctx context.Context, keyHash string) (*pb.ApiKey, bool, error) {
+	sqlstring := `SELECT inbApiKeyId, inbMserviceId, chvRole, chvScopes, inbUserId, dtmExpires > NOW() FROM tb_ApiKey
+	WHERE chvKeyHash = ? AND bitIsDeleted = FALSE`
+
+	var scopes string
+	var current bool
+	var apiKey pb.ApiKey
+
+	err := s.queryRow(ctx, sqlstring, func(row *sql.Row) error {
+		return row.Scan(&apiKey.ApiKeyId, &apiKey.MserviceId, &apiKey.Role, &scopes, &apiKey.UserId, &current)
+	}, keyHash)
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	if scopes != "" {
+		apiKey.Scopes = strings.Split(scopes, ",")
+	}
+
+	return &apiKey, current, nil
+}
+
+func (s *sqlApiKeys) TouchApiKey(ctx context.Context, apiKeyId int64) error {
+	sqlstring := `UPDATE tb_ApiKey SET dtmLastUsed = NOW() WHERE inbApiKeyId = ?
+	AND (dtmLastUsed IS NULL OR dtmLastUsed < ` + s.db.Dialect.Ago(apiKeyTouchSeconds) + `)`
+
+	_, err := s.exec(ctx, sqlstring, apiKeyId)
+	return err
+}
@@ -0,0 +1,136 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projstore
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+
+	pb "github.com/gaterace/mproject/pkg/mserviceproject"
+)
+
+// Task assignments in tb_TaskToMember.
+type sqlAssignments struct {
+	*sqlStore
+}
+
+func (s *sqlAssignments) AddAssignment(ctx context.Context, projectId int64, taskId int64, memberId int64,
+	mserviceId int64) (int32, error) {
+	sqlstring := `SELECT bitIsDeleted, inbMserviceId, intVersion FROM tb_TaskToMember WHERE inbProjectId = ? AND inbTaskId = ?
+	AND inbMemberId = ?` + s.forUpdate()
+
+	var deleted bool
+	var rowMserviceId int64
+	var version int32
+	err := s.queryRow(ctx, sqlstring, func(row *sql.Row) error {
+		return row.Scan(&deleted, &rowMserviceId, &version)
+	}, projectId, taskId, memberId)
+
+	if err == ErrNotFound {
+		sqlstring = `INSERT INTO tb_TaskToMember
+		(inbProjectId, inbTaskId, inbMemberId, dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId,
+		decTaskHours) VALUES (?, ?, ?, NOW(), NOW(), NOW(), FALSE, 1, ?, 0.0)`
+
+		_, err = s.exec(ctx, sqlstring, projectId, taskId, memberId, mserviceId)
+		return 1, err
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	if !deleted || (rowMserviceId != mserviceId) {
+		return 0, ErrNotFound
+	}
+
+	// previously deleted, reuse with the task hours recorded before it was removed
+	sqlstring = `UPDATE tb_TaskToMember SET dtmCreated = NOW(), dtmModified = NOW(), dtmDeleted = NOW(),
+	bitIsDeleted = FALSE, intVersion = ? WHERE inbProjectId = ? AND inbTaskId = ? AND inbMemberId = ? AND inbMserviceId = ?
+	AND intVersion = ? AND bitIsDeleted = TRUE`
+
+	err = s.execOne(ctx, sqlstring, version+1, projectId, taskId, memberId, mserviceId, version)
+	return version + 1, s.versionError(ctx, err, "tb_TaskToMember", `inbProjectId = ? AND inbTaskId = ? AND inbMemberId = ?
+	AND inbMserviceId = ? AND bitIsDeleted = TRUE`, projectId, taskId, memberId, mserviceId)
+}
+
+func (s *sqlAssignments) RemoveAssignment(ctx context.Context, projectId int64, taskId int64, memberId int64,
+	mserviceId int64, version int32) error {
+	sqlstring := `UPDATE tb_TaskToMember SET dtmDeleted = NOW(), intVersion = ?,
+	bitIsDeleted = TRUE WHERE inbProjectId = ? AND inbTaskId = ? AND inbMemberId = ? AND inbMserviceId = ? AND intVersion = ?
+	AND bitIsDeleted = FALSE`
+
+	err := s.execOne(ctx, sqlstring, version+1, projectId, taskId, memberId, mserviceId, version)
+	return s.versionError(ctx, err, "tb_TaskToMember", `inbProjectId = ? AND inbTaskId = ? AND inbMemberId = ?
+	AND inbMserviceId = ? AND bitIsDeleted = FALSE`, projectId, taskId, memberId, mserviceId)
+}
+
+func (s *sqlAssignments) AddTaskHours(ctx context.Context, projectId int64, taskId int64, memberId int64,
+	mserviceId int64, version int32, taskHours *dml.Decimal) error {
+	sqlstring := `UPDATE tb_TaskToMember SET dtmModified = NOW(), intVersion = ?,
+	decTaskHours = decTaskHours + ? WHERE inbProjectId = ? AND inbTaskId = ? AND inbMemberId = ? AND inbMserviceId = ?
+	AND intVersion = ? AND bitIsDeleted = FALSE`
+
+	err := s.execOne(ctx, sqlstring, version+1, taskHours.StringFromDecimal(), projectId, taskId, memberId, mserviceId,
+		version)
+	return s.versionError(ctx, err, "tb_TaskToMember", `inbProjectId = ? AND inbTaskId = ? AND inbMemberId = ?
+	AND inbMserviceId = ? AND bitIsDeleted = FALSE`, projectId, taskId, memberId, mserviceId)
+}
+
+func (s *sqlAssignments) SetTaskHours(ctx context.Context, projectId int64, taskId int64, memberId int64,
+	mserviceId int64, version int32, taskHours *dml.Decimal) error {
+	sqlstring := `UPDATE tb_TaskToMember SET dtmModified = NOW(), intVersion = ?, decTaskHours = ?
+	WHERE inbProjectId = ? AND inbTaskId = ? AND inbMemberId = ? AND inbMserviceId = ? AND intVersion = ?
+	AND bitIsDeleted = FALSE`
+
+	err := s.execOne(ctx, sqlstring, version+1, taskHours.StringFromDecimal(), projectId, taskId, memberId, mserviceId,
+		version)
+	return s.versionError(ctx, err, "tb_TaskToMember", `inbProjectId = ? AND inbTaskId = ? AND inbMemberId = ?
+	AND inbMserviceId = ? AND bitIsDeleted = FALSE`, projectId, taskId, memberId, mserviceId)
+}
+
+func (s *sqlAssignments) GetAssignmentsByProject(ctx context.Context, projectId int64,
+	mserviceId int64) ([]*pb.TaskToMember, error) {
+	mbrtasks := make([]*pb.TaskToMember, 0)
+
+	sqlstring := `SELECT inbProjectId, inbTaskId, inbMemberId, dtmCreated, dtmModified,
+	inbMserviceId, decTaskHours, intVersion FROM tb_TaskToMember WHERE inbProjectId = ? AND inbMserviceId = ?
+	AND bitIsDeleted = FALSE`
+
+	err := s.query(ctx, sqlstring, func(rows *sql.Rows) error {
+		var created string
+		var modified string
+		var task_hours string
+		var t2m pb.TaskToMember
+
+		err := rows.Scan(&t2m.ProjectId, &t2m.TaskId, &t2m.MemberId, &created, &modified, &t2m.MserviceId, &task_hours,
+			&t2m.Version)
+		if err != nil {
+			return err
+		}
+
+		t2m.Created = dml.DateTimeFromString(created)
+		t2m.Modified = dml.DateTimeFromString(modified)
+		d, err := DecimalFromDb(task_hours)
+		if err == nil {
+			t2m.TaskHours = d
+		}
+
+		mbrtasks = append(mbrtasks, &t2m)
+		return nil
+	}, projectId, mserviceId)
+
+	return mbrtasks, err
+}
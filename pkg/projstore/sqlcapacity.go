@@ -0,0 +1,268 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projstore
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+	sdec "github.com/shopspring/decimal"
+
+	pb "github.com/gaterace/mproject/pkg/mserviceproject"
+)
+
+// Team member capacity in tb_MemberCapacity, days off in tb_MemberDayOff and assignment estimates in
+// tb_AssignmentEstimate.
+type sqlCapacity struct {
+	*sqlStore
+}
+
+// DATE and DATETIME formats as returned by the mysql driver. The postgres driver returns RFC 3339, which
+// begins with the same fields.
+const dbDateFormat = "2006-01-02"
+const dbDateTimeFormat = "2006-01-02 15:04:05"
+
+// Helper to parse a DATE or DATETIME as returned by the driver, in local time.
+func parseDbDateTime(s string) time.Time {
+	if len(s) > len(dbDateTimeFormat) {
+		s = s[:len(dbDateTimeFormat)]
+	}
+
+	layout := dbDateTimeFormat
+	if len(s) == len(dbDateFormat) {
+		layout = dbDateFormat
+	}
+
+	t, _ := time.ParseInLocation(layout, strings.Replace(s, "T", " ", 1), time.Local)
+	return t
+}
+
+func (s *sqlCapacity) SetMemberCapacity(ctx context.Context, capacity *pb.MemberCapacity) error {
+	hoursPerDay := capacity.GetHoursPerDay().StringFromDecimal()
+
+	if capacity.GetVersion() == 0 {
+		sqlstring := `INSERT INTO tb_MemberCapacity (inbMemberId, dtmCreated, dtmModified, intVersion, inbMserviceId,
+		inbProjectId, decHoursPerDay, intAllocationPercent) VALUES (?, NOW(), NOW(), 1, ?, ?, ?, ?)`
+
+		_, err := s.exec(ctx, sqlstring, capacity.GetMemberId(), capacity.GetMserviceId(), capacity.GetProjectId(),
+			hoursPerDay, capacity.GetAllocationPercent())
+		return err
+	}
+
+	sqlstring := `UPDATE tb_MemberCapacity SET dtmModified = NOW(), intVersion = ?, decHoursPerDay = ?,
+	intAllocationPercent = ? WHERE inbMemberId = ? AND inbMserviceId = ? AND intVersion = ?`
+
+	err := s.execOne(ctx, sqlstring, capacity.GetVersion()+1, hoursPerDay, capacity.GetAllocationPercent(),
+		capacity.GetMemberId(), capacity.GetMserviceId(), capacity.GetVersion())
+	return s.versionError(ctx, err, "tb_MemberCapacity", "inbMemberId = ? AND inbMserviceId = ?",
+		capacity.GetMemberId(), capacity.GetMserviceId())
+}
+
+func (s *sqlCapacity) GetMemberCapacity(ctx context.Context, memberId int64, mserviceId int64) (*pb.MemberCapacity, error) {
+	sqlstring1 := `SELECT dtmCreated, dtmModified, intVersion, decHoursPerDay, intAllocationPercent
+	FROM tb_MemberCapacity WHERE inbMemberId = ? AND inbMserviceId = ?`
+
+	var created string
+	var modified string
+	var hoursPerDay string
+	capacity := &pb.MemberCapacity{MemberId: memberId, MserviceId: mserviceId}
+
+	err := s.queryRow(ctx, sqlstring1, func(row *sql.Row) error {
+		return row.Scan(&created, &modified, &capacity.Version, &hoursPerDay, &capacity.AllocationPercent)
+	}, memberId, mserviceId)
+
+	if err == ErrNotFound {
+		// capacity not yet set, report the defaults with version 0
+		hoursPerDay = DefaultHoursPerDay
+		capacity.AllocationPercent = DefaultAllocationPercent
+	} else if err != nil {
+		return nil, err
+	} else {
+		capacity.Created = dml.DateTimeFromString(created)
+		capacity.Modified = dml.DateTimeFromString(modified)
+	}
+
+	capacity.HoursPerDay, _ = DecimalFromDb(hoursPerDay)
+
+	sqlstring2 := `SELECT dtmDayOff, chvReason FROM tb_MemberDayOff WHERE inbMemberId = ? AND inbMserviceId = ?
+	AND dtmDayOff >= CURRENT_DATE ORDER BY dtmDayOff`
+
+	err = s.query(ctx, sqlstring2, func(rows *sql.Rows) error {
+		var dayOff string
+		day := pb.MemberDayOff{MemberId: memberId}
+
+		err := rows.Scan(&dayOff, &day.Reason)
+		if err != nil {
+			return err
+		}
+
+		day.DayOff = dml.DateTimeFromString(dayOff)
+		capacity.DaysOff = append(capacity.DaysOff, &day)
+		return nil
+	}, memberId, mserviceId)
+
+	return capacity, err
+}
+
+func (s *sqlCapacity) AddDayOff(ctx context.Context, memberId int64, mserviceId int64, dayOff time.Time,
+	reason string) error {
+	sqlstring := `INSERT INTO tb_MemberDayOff (inbMemberId, dtmDayOff, dtmCreated, inbMserviceId, chvReason)
+	VALUES (?, ?, NOW(), ?, ?)` + s.db.Dialect.Upsert("inbMemberId, dtmDayOff", "chvReason = "+s.db.Dialect.Inserted("chvReason"))
+
+	_, err := s.exec(ctx, sqlstring, memberId, dayOff.Format(dbDateFormat), mserviceId, reason)
+	return err
+}
+
+func (s *sqlCapacity) RemoveDayOff(ctx context.Context, memberId int64, mserviceId int64, dayOff time.Time) error {
+	sqlstring := `DELETE FROM tb_MemberDayOff WHERE inbMemberId = ? AND inbMserviceId = ? AND dtmDayOff = ?`
+
+	return s.execOne(ctx, sqlstring, memberId, mserviceId, dayOff.Format(dbDateFormat))
+}
+
+func (s *sqlCapacity) SetAssignmentEstimate(ctx context.Context, taskId int64, memberId int64, mserviceId int64,
+	estimatedHours sdec.Decimal) error {
+	// make sure that the team member is assigned to the task in this mservice id
+	sqlstring1 := `SELECT inbProjectId FROM tb_TaskToMember WHERE inbTaskId = ? AND inbMemberId = ? AND inbMserviceId = ?
+	AND bitIsDeleted = FALSE`
+
+	var projectId int64
+	err := s.queryRow(ctx, sqlstring1, func(row *sql.Row) error {
+		return row.Scan(&projectId)
+	}, taskId, memberId, mserviceId)
+
+	if err != nil {
+		return err
+	}
+
+	sqlstring2 := `INSERT INTO tb_AssignmentEstimate (inbProjectId, inbTaskId, inbMemberId, dtmCreated, dtmModified,
+	inbMserviceId, decEstimatedHours) VALUES (?, ?, ?, NOW(), NOW(), ?, ?)` +
+		s.db.Dialect.Upsert("inbProjectId, inbTaskId, inbMemberId",
+			"dtmModified = NOW(), decEstimatedHours = "+s.db.Dialect.Inserted("decEstimatedHours"))
+
+	_, err = s.exec(ctx, sqlstring2, projectId, taskId, memberId, mserviceId, estimatedHours.String())
+	return err
+}
+
+func (s *sqlCapacity) GetCapacityData(ctx context.Context, projectId int64, mserviceId int64, start time.Time,
+	end time.Time) ([]*MemberCapacity, []*MemberAssignment, error) {
+	capacities := make([]*MemberCapacity, 0)
+	assignments := make([]*MemberAssignment, 0)
+	byMember := make(map[int64]*MemberCapacity)
+
+	sqlstring1 := `SELECT m.inbMemberId, m.chvName, COALESCE(c.decHoursPerDay, ?), COALESCE(c.intAllocationPercent, ?)
+	FROM tb_TeamMember AS m
+	LEFT JOIN tb_MemberCapacity AS c ON c.inbMemberId = m.inbMemberId
+	WHERE m.inbProjectId = ? AND m.inbMserviceId = ? AND m.bitIsDeleted = FALSE ORDER BY m.inbMemberId`
+
+	err := s.query(ctx, sqlstring1, func(rows *sql.Rows) error {
+		var c MemberCapacity
+		err := rows.Scan(&c.MemberId, &c.Name, &c.HoursPerDay, &c.AllocationPercent)
+		if err != nil {
+			return err
+		}
+
+		capacities = append(capacities, &c)
+		byMember[c.MemberId] = &c
+		return nil
+	}, DefaultHoursPerDay, DefaultAllocationPercent, projectId, mserviceId)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sqlstring2 := `SELECT d.inbMemberId, d.dtmDayOff FROM tb_MemberDayOff AS d
+	JOIN tb_TeamMember AS m ON m.inbMemberId = d.inbMemberId
+	WHERE m.inbProjectId = ? AND d.inbMserviceId = ? AND m.bitIsDeleted = FALSE AND d.dtmDayOff BETWEEN ? AND ?`
+
+	err = s.query(ctx, sqlstring2, func(rows *sql.Rows) error {
+		var memberId int64
+		var dayOff string
+		err := rows.Scan(&memberId, &dayOff)
+		if err != nil {
+			return err
+		}
+
+		if len(dayOff) > len(dbDateFormat) {
+			dayOff = dayOff[:len(dbDateFormat)]
+		}
+
+		if c, ok := byMember[memberId]; ok {
+			c.DaysOff = append(c.DaysOff, parseDbDateTime(dayOff))
+		}
+		return nil
+	}, projectId, mserviceId, start.Format(dbDateFormat), end.Format(dbDateFormat))
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// the estimate for a team member on a task takes precedence over recorded task hours
+	sqlstring3 := `SELECT a.inbMemberId, a.inbTaskId, t.dtmStartDate, t.dtmEndDate,
+	COALESCE(e.decEstimatedHours, a.decTaskHours)
+	FROM tb_TaskToMember AS a
+	JOIN tb_Task AS t ON t.inbTaskId = a.inbTaskId AND t.bitIsDeleted = FALSE
+	LEFT JOIN tb_AssignmentEstimate AS e ON e.inbProjectId = a.inbProjectId AND e.inbTaskId = a.inbTaskId
+	AND e.inbMemberId = a.inbMemberId
+	WHERE a.inbProjectId = ? AND a.inbMserviceId = ? AND a.bitIsDeleted = FALSE
+	AND t.dtmEndDate >= ? AND t.dtmStartDate <= ?`
+
+	err = s.query(ctx, sqlstring3, func(rows *sql.Rows) error {
+		var a MemberAssignment
+		var startDate string
+		var endDate string
+		err := rows.Scan(&a.MemberId, &a.TaskId, &startDate, &endDate, &a.Hours)
+		if err != nil {
+			return err
+		}
+
+		a.StartDate = parseDbDateTime(startDate)
+		a.EndDate = parseDbDateTime(endDate)
+		assignments = append(assignments, &a)
+		return nil
+	}, projectId, mserviceId, start, end)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return capacities, assignments, nil
+}
+
+func (s *sqlCapacity) GetTaskSchedules(ctx context.Context, projectId int64, mserviceId int64) ([]*TaskSchedule, error) {
+	schedules := make([]*TaskSchedule, 0)
+
+	sqlstring := `SELECT inbTaskId, chvName, dtmStartDate, dtmEndDate, intPriority, inbParentId FROM tb_Task
+	WHERE inbProjectId = ? AND inbMserviceId = ? AND bitIsDeleted = FALSE`
+
+	err := s.query(ctx, sqlstring, func(rows *sql.Rows) error {
+		var startDate string
+		var endDate string
+		var ts TaskSchedule
+
+		err := rows.Scan(&ts.TaskId, &ts.Name, &startDate, &endDate, &ts.Priority, &ts.ParentId)
+		if err != nil {
+			return err
+		}
+
+		ts.StartDate = parseDbDateTime(startDate)
+		ts.EndDate = parseDbDateTime(endDate)
+		schedules = append(schedules, &ts)
+		return nil
+	}, projectId, mserviceId)
+
+	return schedules, err
+}
@@ -0,0 +1,116 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projstore
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+
+	pb "github.com/gaterace/mproject/pkg/mserviceproject"
+)
+
+// Project grants in tb_ProjectGrant.
+type sqlGrants struct {
+	*sqlStore
+}
+
+func (s *sqlGrants) CreateGrant(ctx context.Context, grant *pb.ProjectGrant) (int64, error) {
+	sqlstring := `INSERT INTO tb_ProjectGrant (dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId,
+		inbProjectId, inbUserId, intProjectRoleId, intAccessLevel) VALUES(NOW(), NOW(), NOW(), FALSE, 1, ?, ?, ?, ?, ?)`
+
+	return s.insert(ctx, sqlstring, "inbGrantId", grant.GetMserviceId(), grant.GetProjectId(), grant.GetUserId(),
+		grant.GetProjectRoleId(), GrantAccessLevels[grant.GetAccess()])
+}
+
+func (s *sqlGrants) UpdateGrant(ctx context.Context, grant *pb.ProjectGrant) error {
+	sqlstring := `UPDATE tb_ProjectGrant SET dtmModified = NOW(), intVersion = ?, intAccessLevel = ?
+	WHERE inbGrantId = ? AND inbMserviceId = ? AND intVersion = ? AND bitIsDeleted = FALSE`
+
+	err := s.execOne(ctx, sqlstring, grant.GetVersion()+1, GrantAccessLevels[grant.GetAccess()], grant.GetGrantId(),
+		grant.GetMserviceId(), grant.GetVersion())
+	return s.versionError(ctx, err, "tb_ProjectGrant", "inbGrantId = ? AND inbMserviceId = ? AND bitIsDeleted = FALSE", grant.GetGrantId(), grant.GetMserviceId())
+}
+
+func (s *sqlGrants) DeleteGrant(ctx context.Context, grantId int64, mserviceId int64, version int32) error {
+	sqlstring := `UPDATE tb_ProjectGrant SET dtmDeleted = NOW(), intVersion = ?, bitIsDeleted = TRUE
+	WHERE inbGrantId = ? AND inbMserviceId = ? AND intVersion = ? AND bitIsDeleted = FALSE`
+
+	err := s.execOne(ctx, sqlstring, version+1, grantId, mserviceId, version)
+	return s.versionError(ctx, err, "tb_ProjectGrant", "inbGrantId = ? AND inbMserviceId = ? AND bitIsDeleted = FALSE", grantId, mserviceId)
+}
+
+func (s *sqlGrants) GrantExists(ctx context.Context, projectId int64, mserviceId int64, userId int64,
+	projectRoleId int32) (bool, error) {
+	sqlstring := `SELECT inbGrantId FROM tb_ProjectGrant WHERE inbProjectId = ? AND inbMserviceId = ? AND inbUserId = ?
+	AND intProjectRoleId = ? AND bitIsDeleted = FALSE LIMIT 1`
+
+	return s.exists(ctx, sqlstring, projectId, mserviceId, userId, projectRoleId)
+}
+
+func (s *sqlGrants) GetGrantsByProject(ctx context.Context, projectId int64, mserviceId int64) ([]*pb.ProjectGrant, error) {
+	grants := make([]*pb.ProjectGrant, 0)
+
+	sqlstring := `SELECT inbGrantId, dtmCreated, dtmModified, intVersion, inbMserviceId, inbProjectId, inbUserId,
+	intProjectRoleId, intAccessLevel FROM tb_ProjectGrant
+	WHERE inbProjectId = ? AND inbMserviceId = ? AND bitIsDeleted = FALSE ORDER BY inbGrantId`
+
+	err := s.query(ctx, sqlstring, func(rows *sql.Rows) error {
+		var created string
+		var modified string
+		var accessLevel int32
+		var grant pb.ProjectGrant
+
+		err := rows.Scan(&grant.GrantId, &created, &modified, &grant.Version, &grant.MserviceId, &grant.ProjectId,
+			&grant.UserId, &grant.ProjectRoleId, &accessLevel)
+		if err != nil {
+			return err
+		}
+
+		grant.Created = dml.DateTimeFromString(created)
+		grant.Modified = dml.DateTimeFromString(modified)
+		grant.Access = GrantAccessNames[accessLevel]
+		grants = append(grants, &grant)
+		return nil
+	}, projectId, mserviceId)
+
+	return grants, err
+}
+
+func (s *sqlGrants) GetGrantProject(ctx context.Context, grantId int64, mserviceId int64) (int64, error) {
+	sqlstring := `SELECT inbProjectId FROM tb_ProjectGrant WHERE inbGrantId = ? AND inbMserviceId = ? AND bitIsDeleted = FALSE`
+
+	var projectId int64
+	err := s.queryRow(ctx, sqlstring, func(row *sql.Row) error {
+		return row.Scan(&projectId)
+	}, grantId, mserviceId)
+
+	return projectId, err
+}
+
+func (s *sqlGrants) GetGrantedAccess(ctx context.Context, projectId int64, mserviceId int64, userId int64) (int32, error) {
+	sqlstring := `SELECT COALESCE(MAX(g.intAccessLevel), 0) FROM tb_ProjectGrant AS g
+	WHERE g.inbProjectId = ? AND g.inbMserviceId = ? AND g.bitIsDeleted = FALSE
+	AND ((g.inbUserId = ?) OR (g.intProjectRoleId > 0 AND g.intProjectRoleId IN
+	(SELECT m.intProjectRoleId FROM tb_TeamMember AS m JOIN tb_Person AS p ON p.inbPersonId = m.inbPersonId
+	WHERE m.inbProjectId = g.inbProjectId AND m.bitIsDeleted = FALSE AND p.inbUserId = ? AND p.bitIsDeleted = FALSE)))`
+
+	var granted int32
+	err := s.queryRow(ctx, sqlstring, func(row *sql.Row) error {
+		return row.Scan(&granted)
+	}, projectId, mserviceId, userId, userId)
+
+	return granted, err
+}
@@ -0,0 +1,199 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projstore
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+
+	pb "github.com/gaterace/mproject/pkg/mserviceproject"
+)
+
+// Team members in tb_TeamMember, with notification preferences in tb_MemberNotify.
+type sqlMembers struct {
+	*sqlStore
+}
+
+const memberColumns = `m.inbMemberId, m.dtmCreated, m.dtmModified, m.intVersion, m.inbMserviceId, m.inbProjectId, m.chvName,
+	m.intProjectRoleId, m.chvEmail, r.chvRoleName, m.inbPersonId`
+
+// Helper to scan a team member selected with memberColumns.
+func scanMember(row scanner) (*pb.TeamMember, error) {
+	var created string
+	var modified string
+	var member pb.TeamMember
+
+	err := row.Scan(&member.MemberId, &created, &modified, &member.Version, &member.MserviceId, &member.ProjectId,
+		&member.Name, &member.ProjectRoleId, &member.Email, &member.RoleName, &member.PersonId)
+	if err != nil {
+		return nil, err
+	}
+
+	member.Created = dml.DateTimeFromString(created)
+	member.Modified = dml.DateTimeFromString(modified)
+
+	return &member, nil
+}
+
+func (s *sqlMembers) CreateMember(ctx context.Context, member *pb.TeamMember) (int64, error) {
+	sqlstring := `INSERT INTO tb_TeamMember (dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId, inbProjectId,
+		chvName, intProjectRoleId, chvEmail, inbPersonId) VALUES(NOW(), NOW(), NOW(), FALSE, 1, ?, ?, ?, ?, ?, ?)`
+
+	return s.insert(ctx, sqlstring, "inbMemberId", member.GetMserviceId(), member.GetProjectId(), member.GetName(),
+		member.GetProjectRoleId(), member.GetEmail(), member.GetPersonId())
+}
+
+func (s *sqlMembers) UpdateMember(ctx context.Context, member *pb.TeamMember) error {
+	sqlstring := `UPDATE tb_TeamMember SET dtmModified = NOW(), intVersion = ?, chvName = ?, intProjectRoleId = ?, chvEmail = ?
+	WHERE inbMemberId = ? AND inbMserviceId = ? AND intVersion = ? AND bitIsDeleted = FALSE`
+
+	err := s.execOne(ctx, sqlstring, member.GetVersion()+1, member.GetName(), member.GetProjectRoleId(), member.GetEmail(),
+		member.GetMemberId(), member.GetMserviceId(), member.GetVersion())
+	return s.versionError(ctx, err, "tb_TeamMember", "inbMemberId = ? AND inbMserviceId = ? AND bitIsDeleted = FALSE", member.GetMemberId(), member.GetMserviceId())
+}
+
+func (s *sqlMembers) DeleteMember(ctx context.Context, memberId int64, mserviceId int64, version int32) error {
+	sqlstring := `UPDATE tb_TeamMember SET dtmDeleted = NOW(), intVersion = ?, bitIsDeleted = TRUE
+	WHERE inbMemberId = ? AND inbMserviceId = ? AND intVersion = ? AND bitIsDeleted = FALSE`
+
+	err := s.execOne(ctx, sqlstring, version+1, memberId, mserviceId, version)
+	return s.versionError(ctx, err, "tb_TeamMember", "inbMemberId = ? AND inbMserviceId = ? AND bitIsDeleted = FALSE", memberId, mserviceId)
+}
+
+func (s *sqlMembers) GetMemberById(ctx context.Context, memberId int64, mserviceId int64) (*pb.TeamMember, error) {
+	sqlstring := `SELECT ` + memberColumns + `
+	FROM tb_TeamMember AS m
+	JOIN tb_ProjectRoleType AS r ON m.intProjectRoleId = r.intProjectRoleId
+	WHERE m.inbMemberId = ? AND m.inbMserviceId = ? AND m.bitIsDeleted = FALSE`
+
+	var member *pb.TeamMember
+	err := s.queryRow(ctx, sqlstring, func(row *sql.Row) error {
+		var err error
+		member, err = scanMember(row)
+		return err
+	}, memberId, mserviceId)
+
+	return member, err
+}
+
+func (s *sqlMembers) GetMembersByProject(ctx context.Context, projectId int64, mserviceId int64) ([]*pb.TeamMember, error) {
+	members := make([]*pb.TeamMember, 0)
+
+	sqlstring := `SELECT ` + memberColumns + `
+	FROM tb_TeamMember AS m
+	JOIN tb_ProjectRoleType AS r ON m.intProjectRoleId = r.intProjectRoleId
+	WHERE m.inbProjectId = ? AND m.inbMserviceId = ? AND m.bitIsDeleted = FALSE`
+
+	err := s.query(ctx, sqlstring, func(rows *sql.Rows) error {
+		member, err := scanMember(rows)
+		if err == nil {
+			members = append(members, member)
+		}
+		return err
+	}, projectId, mserviceId)
+
+	return members, err
+}
+
+func (s *sqlMembers) GetMembersByTask(ctx context.Context, projectId int64, taskId int64,
+	mserviceId int64) ([]*pb.TeamMember, error) {
+	members := make([]*pb.TeamMember, 0)
+
+	sqlstring := `SELECT m.inbMemberId,  m.dtmCreated, m.dtmModified, m.intVersion,
+	m.inbMserviceId, m.inbProjectId, m.chvName, m.intProjectRoleId, m.chvEmail, t.decTaskHours, r.chvRoleName,
+	m.inbPersonId, t.intVersion
+	FROM tb_TaskToMember AS t
+	JOIN tb_TeamMember AS m ON t.inbMemberId = m.inbMemberId
+	JOIN tb_ProjectRoleType AS r ON m.intProjectRoleId = r.intProjectRoleId
+	WHERE t.inbProjectId = ? AND t.inbTaskId= ? AND t.inbMserviceId = ?
+	AND t.bitIsDeleted = FALSE AND m.bitIsDeleted = FALSE`
+
+	err := s.query(ctx, sqlstring, func(rows *sql.Rows) error {
+		var created string
+		var modified string
+		var task_hours string
+		var member pb.TeamMember
+
+		err := rows.Scan(&member.MemberId, &created, &modified, &member.Version, &member.MserviceId, &member.ProjectId,
+			&member.Name, &member.ProjectRoleId, &member.Email, &task_hours, &member.RoleName, &member.PersonId,
+			&member.AssignmentVersion)
+		if err != nil {
+			return err
+		}
+
+		member.Created = dml.DateTimeFromString(created)
+		member.Modified = dml.DateTimeFromString(modified)
+		d, err := DecimalFromDb(task_hours)
+		if err == nil {
+			member.TaskHours = d
+		}
+
+		members = append(members, &member)
+		return nil
+	}, projectId, taskId, mserviceId)
+
+	return members, err
+}
+
+func (s *sqlMembers) GetMemberProject(ctx context.Context, memberId int64, mserviceId int64) (int64, int64, error) {
+	sqlstring := `SELECT inbProjectId, inbPersonId FROM tb_TeamMember WHERE inbMemberId = ? AND inbMserviceId = ?
+	AND bitIsDeleted = FALSE` + s.forUpdate()
+
+	var projectId int64
+	var personId int64
+
+	err := s.queryRow(ctx, sqlstring, func(row *sql.Row) error {
+		return row.Scan(&projectId, &personId)
+	}, memberId, mserviceId)
+
+	return projectId, personId, err
+}
+
+func (s *sqlMembers) MemberExists(ctx context.Context, memberId int64, projectId int64, mserviceId int64) (bool, error) {
+	sqlstring := `SELECT inbMemberId FROM tb_TeamMember WHERE inbMemberId = ? AND inbProjectId = ? AND inbMserviceId = ?
+	AND bitIsDeleted = FALSE` + s.forUpdate()
+
+	return s.exists(ctx, sqlstring, memberId, projectId, mserviceId)
+}
+
+func (s *sqlMembers) PersonIsMember(ctx context.Context, projectId int64, personId int64, mserviceId int64) (bool, error) {
+	sqlstring := `SELECT inbMemberId FROM tb_TeamMember WHERE inbProjectId = ? AND inbPersonId = ? AND inbMserviceId = ?
+	AND bitIsDeleted = FALSE LIMIT 1`
+
+	return s.exists(ctx, sqlstring, projectId, personId, mserviceId)
+}
+
+func (s *sqlMembers) SetMemberNotify(ctx context.Context, memberId int64, mserviceId int64, optOut bool) error {
+	sqlstring := `INSERT INTO tb_MemberNotify (inbMemberId, dtmCreated, dtmModified, inbMserviceId, bitOptOut)
+	VALUES (?, NOW(), NOW(), ?, ?)` + s.db.Dialect.Upsert("inbMemberId",
+		"dtmModified = NOW(), bitOptOut = "+s.db.Dialect.Inserted("bitOptOut"))
+
+	_, err := s.exec(ctx, sqlstring, memberId, mserviceId, optOut)
+	return err
+}
+
+func (s *sqlMembers) GetMemberNotify(ctx context.Context, memberId int64, mserviceId int64) (bool, error) {
+	sqlstring := `SELECT COALESCE(n.bitOptOut, FALSE) FROM tb_TeamMember AS m
+	LEFT JOIN tb_MemberNotify AS n ON n.inbMemberId = m.inbMemberId
+	WHERE m.inbMemberId = ? AND m.inbMserviceId = ? AND m.bitIsDeleted = FALSE`
+
+	var optOut bool
+	err := s.queryRow(ctx, sqlstring, func(row *sql.Row) error {
+		return row.Scan(&optOut)
+	}, memberId, mserviceId)
+
+	return optOut, err
+}
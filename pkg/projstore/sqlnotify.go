@@ -0,0 +1,135 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projstore
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+)
+
+// Email notifications of team members in tb_Notification.
+type sqlNotifications struct {
+	*sqlStore
+}
+
+// Helper to get the assigned members who have not opted out, with task details, matching the extra where clause.
+// With a reminder kind, the reminder of the kind logged for the task as last modified is joined as x.
+func (s *sqlNotifications) getRecipients(ctx context.Context, kind string, where string,
+	args ...interface{}) ([]*Recipient, error) {
+	recipients := make([]*Recipient, 0)
+
+	reminder := "0, 0"
+	join := ""
+	if kind != "" {
+		reminder = "COALESCE(x.inbNotificationId, 0), COALESCE(x.intAttempts, 0)"
+		join = `LEFT JOIN tb_Notification AS x ON x.inbMemberId = a.inbMemberId AND x.inbTaskId = a.inbTaskId
+	AND x.chvKind = ? AND x.dtmTaskModified = t.dtmModified `
+		args = append([]interface{}{kind}, args...)
+	}
+
+	sqlstring := `SELECT a.inbMserviceId, p.inbProjectId, p.chvName, t.inbTaskId, t.chvName, t.chvDescription,
+	COALESCE(s.chvStatusName, ''), t.dtmEndDate, m.inbMemberId, m.chvName, m.chvEmail, ` + reminder + `
+	FROM tb_TaskToMember AS a
+	JOIN tb_Task AS t ON t.inbTaskId = a.inbTaskId AND t.bitIsDeleted = FALSE
+	JOIN tb_Project AS p ON p.inbProjectId = t.inbProjectId AND p.bitIsDeleted = FALSE
+	JOIN tb_TeamMember AS m ON m.inbMemberId = a.inbMemberId AND m.bitIsDeleted = FALSE
+	LEFT JOIN tb_StatusType AS s ON s.inbMserviceId = t.inbMserviceId AND s.intStatusId = t.intStatusId
+	AND s.bitIsDeleted = FALSE
+	LEFT JOIN tb_MemberNotify AS n ON n.inbMemberId = m.inbMemberId
+	` + join + `WHERE a.bitIsDeleted = FALSE AND m.chvEmail <> '' AND COALESCE(n.bitOptOut, FALSE) = FALSE ` + where +
+		` ORDER BY a.inbTaskId, a.inbMemberId`
+
+	err := s.query(ctx, sqlstring, func(rows *sql.Rows) error {
+		var endDate string
+		var r Recipient
+
+		err := rows.Scan(&r.MserviceId, &r.ProjectId, &r.ProjectName, &r.TaskId, &r.TaskName, &r.TaskDescription,
+			&r.StatusName, &endDate, &r.MemberId, &r.MemberName, &r.Email, &r.NotificationId, &r.Attempts)
+		if err != nil {
+			return err
+		}
+
+		r.EndDate = parseDbDateTime(endDate)
+		recipients = append(recipients, &r)
+		return nil
+	}, args...)
+
+	return recipients, err
+}
+
+func (s *sqlNotifications) GetTaskRecipients(ctx context.Context, taskId int64, mserviceId int64,
+	memberId int64) ([]*Recipient, error) {
+	if memberId != 0 {
+		return s.getRecipients(ctx, "", `AND a.inbTaskId = ? AND a.inbMserviceId = ? AND a.inbMemberId = ?`,
+			taskId, mserviceId, memberId)
+	}
+
+	return s.getRecipients(ctx, "", `AND a.inbTaskId = ? AND a.inbMserviceId = ?`, taskId, mserviceId)
+}
+
+func (s *sqlNotifications) GetDueRecipients(ctx context.Context, start time.Time, end time.Time,
+	kind string) ([]*Recipient, error) {
+	return s.getRecipients(ctx, kind, `AND t.dtmEndDate BETWEEN ? AND ?
+	AND (x.inbNotificationId IS NULL OR (x.bitIsSent = FALSE AND x.dtmNextAttempt <= NOW()))`, start, end)
+}
+
+func (s *sqlNotifications) LogNotification(ctx context.Context, notification *Notification) error {
+	sqlstring := `INSERT INTO tb_Notification (dtmCreated, inbMserviceId, inbMemberId, inbTaskId, chvKind, chvEmail,
+	chvSubject, bitIsSent, chvLastError, intAttempts) VALUES (NOW(), ?, ?, ?, ?, ?, ?, ?, ?, 1)`
+
+	_, err := s.exec(ctx, sqlstring, notification.MserviceId, notification.MemberId, notification.TaskId,
+		notification.Kind, notification.Email, notification.Subject, notification.LastError == "",
+		notification.LastError)
+	return err
+}
+
+func (s *sqlNotifications) ClaimReminder(ctx context.Context, notification *Notification, notificationId int64,
+	holdSeconds int) (int64, error) {
+	if notificationId != 0 {
+		sqlstring := `UPDATE tb_Notification SET dtmNextAttempt = ` + s.db.Dialect.Later(holdSeconds) + `
+		WHERE inbNotificationId = ? AND inbMserviceId = ? AND bitIsSent = FALSE AND dtmNextAttempt <= NOW()`
+
+		return notificationId, s.execOne(ctx, sqlstring, notificationId, notification.MserviceId)
+	}
+
+	// the unique key on the member, task, kind and task modification date lets only one server log the reminder
+	sqlstring := `INSERT INTO tb_Notification (dtmCreated, inbMserviceId, inbMemberId, inbTaskId, chvKind, chvEmail,
+	chvSubject, bitIsSent, chvLastError, dtmTaskModified, intAttempts, dtmNextAttempt)
+	SELECT NOW(), t.inbMserviceId, ?, t.inbTaskId, ?, ?, ?, FALSE, '', t.dtmModified, 0, ` +
+		s.db.Dialect.Later(holdSeconds) + ` FROM tb_Task AS t WHERE t.inbTaskId = ? AND t.inbMserviceId = ?`
+
+	notificationId, err := s.insert(ctx, sqlstring, "inbNotificationId", notification.MemberId, notification.Kind,
+		notification.Email, notification.Subject, notification.TaskId, notification.MserviceId)
+	var dbErr *DbError
+	if errors.As(err, &dbErr) && (dbErr.What == "Duplicate") {
+		return 0, ErrNotFound
+	}
+
+	return notificationId, err
+}
+
+func (s *sqlNotifications) RecordReminder(ctx context.Context, notificationId int64, lastError string,
+	retrySeconds int) error {
+	nextAttempt := "NULL"
+	if retrySeconds > 0 {
+		nextAttempt = s.db.Dialect.Later(retrySeconds)
+	}
+
+	sqlstring := `UPDATE tb_Notification SET intAttempts = intAttempts + 1, bitIsSent = ?, chvLastError = ?,
+	dtmNextAttempt = ` + nextAttempt + ` WHERE inbNotificationId = ?`
+
+	return s.execOne(ctx, sqlstring, lastError == "", lastError, notificationId)
+}
@@ -0,0 +1,253 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projstore
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+	sdec "github.com/shopspring/decimal"
+
+	pb "github.com/gaterace/mproject/pkg/mserviceproject"
+)
+
+// Persons in tb_Person.
+type sqlPersons struct {
+	*sqlStore
+}
+
+const personColumns = `inbPersonId, dtmCreated, dtmModified, bitIsDeleted, intVersion, inbMserviceId, chvName, chvEmail,
+	COALESCE(inbUserId, 0)`
+
+// Helper to scan a person selected with personColumns.
+func scanPerson(row scanner) (*pb.Person, error) {
+	var created string
+	var modified string
+	var person pb.Person
+
+	err := row.Scan(&person.PersonId, &created, &modified, &person.IsDeleted, &person.Version, &person.MserviceId,
+		&person.Name, &person.Email, &person.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	person.Created = dml.DateTimeFromString(created)
+	person.Modified = dml.DateTimeFromString(modified)
+
+	return &person, nil
+}
+
+// Helper to query a single person selected with personColumns.
+func (s *sqlPersons) queryPerson(ctx context.Context, sqlstring string, args ...interface{}) (*pb.Person, error) {
+	var person *pb.Person
+	err := s.queryRow(ctx, sqlstring, func(row *sql.Row) error {
+		var err error
+		person, err = scanPerson(row)
+		return err
+	}, args...)
+
+	return person, err
+}
+
+func (s *sqlPersons) CreatePerson(ctx context.Context, person *pb.Person) (int64, error) {
+	sqlstring := `INSERT INTO tb_Person (dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId,
+		chvName, chvEmail) VALUES(NOW(), NOW(), NOW(), FALSE, 1, ?, ?, ?)`
+
+	return s.insert(ctx, sqlstring, "inbPersonId", person.GetMserviceId(), person.GetName(), person.GetEmail())
+}
+
+func (s *sqlPersons) RestorePerson(ctx context.Context, personId int64, mserviceId int64, version int32,
+	name string) error {
+	sqlstring := `UPDATE tb_Person SET dtmModified = NOW(), bitIsDeleted = FALSE, intVersion = ?, chvName = ?
+	WHERE inbPersonId = ? AND inbMserviceId = ? AND intVersion = ? AND bitIsDeleted = TRUE`
+
+	err := s.execOne(ctx, sqlstring, version+1, name, personId, mserviceId, version)
+	return s.versionError(ctx, err, "tb_Person", "inbPersonId = ? AND inbMserviceId = ? AND bitIsDeleted = TRUE",
+		personId, mserviceId)
+}
+
+func (s *sqlPersons) UpdatePerson(ctx context.Context, person *pb.Person) error {
+	sqlstring := `UPDATE tb_Person SET dtmModified = NOW(), intVersion = ?, chvName = ?, chvEmail = ?
+	WHERE inbPersonId = ? AND inbMserviceId = ? AND intVersion = ? AND bitIsDeleted = FALSE`
+
+	err := s.execOne(ctx, sqlstring, person.GetVersion()+1, person.GetName(), person.GetEmail(), person.GetPersonId(),
+		person.GetMserviceId(), person.GetVersion())
+	return s.versionError(ctx, err, "tb_Person", "inbPersonId = ? AND inbMserviceId = ? AND bitIsDeleted = FALSE", person.GetPersonId(), person.GetMserviceId())
+}
+
+func (s *sqlPersons) DeletePerson(ctx context.Context, personId int64, mserviceId int64, version int32) error {
+	sqlstring := `UPDATE tb_Person SET dtmDeleted = NOW(), intVersion = ?, bitIsDeleted = TRUE
+	WHERE inbPersonId = ? AND inbMserviceId = ? AND intVersion = ? AND bitIsDeleted = FALSE`
+
+	err := s.execOne(ctx, sqlstring, version+1, personId, mserviceId, version)
+	return s.versionError(ctx, err, "tb_Person", "inbPersonId = ? AND inbMserviceId = ? AND bitIsDeleted = FALSE", personId, mserviceId)
+}
+
+func (s *sqlPersons) SetPersonUser(ctx context.Context, personId int64, mserviceId int64, version int32,
+	userId int64) error {
+	// unbound persons hold NULL, so that the user id stays unique within the account
+	var boundUserId interface{}
+	if userId != 0 {
+		boundUserId = userId
+	}
+
+	sqlstring := `UPDATE tb_Person SET dtmModified = NOW(), intVersion = ?, inbUserId = ?
+	WHERE inbPersonId = ? AND inbMserviceId = ? AND intVersion = ? AND bitIsDeleted = FALSE`
+
+	err := s.execOne(ctx, sqlstring, version+1, boundUserId, personId, mserviceId, version)
+	return s.versionError(ctx, err, "tb_Person", "inbPersonId = ? AND inbMserviceId = ? AND bitIsDeleted = FALSE", personId, mserviceId)
+}
+
+func (s *sqlPersons) GetPersonById(ctx context.Context, personId int64, mserviceId int64) (*pb.Person, error) {
+	sqlstring := `SELECT ` + personColumns + ` FROM tb_Person WHERE inbPersonId = ? AND inbMserviceId = ?
+	AND bitIsDeleted = FALSE`
+
+	return s.queryPerson(ctx, sqlstring, personId, mserviceId)
+}
+
+func (s *sqlPersons) GetPersonByEmail(ctx context.Context, email string, mserviceId int64) (*pb.Person, error) {
+	sqlstring := `SELECT ` + personColumns + ` FROM tb_Person WHERE chvEmail = ? AND inbMserviceId = ?`
+
+	return s.queryPerson(ctx, sqlstring, email, mserviceId)
+}
+
+func (s *sqlPersons) GetPersonByUser(ctx context.Context, userId int64, mserviceId int64) (*pb.Person, error) {
+	sqlstring := `SELECT ` + personColumns + ` FROM tb_Person WHERE inbUserId = ? AND inbMserviceId = ?
+	AND bitIsDeleted = FALSE`
+
+	return s.queryPerson(ctx, sqlstring, userId, mserviceId)
+}
+
+func (s *sqlPersons) GetPersons(ctx context.Context, mserviceId int64) ([]*pb.Person, error) {
+	persons := make([]*pb.Person, 0)
+
+	sqlstring := `SELECT ` + personColumns + ` FROM tb_Person WHERE inbMserviceId = ? AND bitIsDeleted = FALSE
+	ORDER BY chvName`
+
+	err := s.query(ctx, sqlstring, func(rows *sql.Rows) error {
+		person, err := scanPerson(rows)
+		if err == nil {
+			persons = append(persons, person)
+		}
+		return err
+	}, mserviceId)
+
+	return persons, err
+}
+
+func (s *sqlPersons) CountPersonMembers(ctx context.Context, personId int64, mserviceId int64) (int, error) {
+	sqlstring := `SELECT COUNT(*) FROM tb_TeamMember WHERE inbPersonId = ? AND inbMserviceId = ? AND bitIsDeleted = FALSE`
+
+	var count int
+	err := s.queryRow(ctx, sqlstring, func(row *sql.Row) error {
+		return row.Scan(&count)
+	}, personId, mserviceId)
+
+	return count, err
+}
+
+func (s *sqlPersons) GetPersonProjects(ctx context.Context, personId int64, mserviceId int64) ([]*pb.PersonProject, error) {
+	projects := make([]*pb.PersonProject, 0)
+
+	sqlstring := `SELECT m.inbMemberId, m.inbProjectId, p.chvName, m.intProjectRoleId, COALESCE(r.chvRoleName, ''),
+	COALESCE(SUM(a.decTaskHours), 0)
+	FROM tb_TeamMember AS m
+	JOIN tb_Project AS p ON p.inbProjectId = m.inbProjectId AND p.bitIsDeleted = FALSE
+	LEFT JOIN tb_ProjectRoleType AS r ON r.inbMserviceId = m.inbMserviceId AND r.intProjectRoleId = m.intProjectRoleId
+	LEFT JOIN tb_TaskToMember AS a ON a.inbMemberId = m.inbMemberId AND a.bitIsDeleted = FALSE
+	WHERE m.inbPersonId = ? AND m.inbMserviceId = ? AND m.bitIsDeleted = FALSE
+	GROUP BY m.inbMemberId, m.inbProjectId, p.chvName, m.intProjectRoleId, r.chvRoleName
+	ORDER BY p.chvName`
+
+	err := s.query(ctx, sqlstring, func(rows *sql.Rows) error {
+		var hours sdec.Decimal
+		var project pb.PersonProject
+
+		err := rows.Scan(&project.MemberId, &project.ProjectId, &project.ProjectName, &project.ProjectRoleId,
+			&project.RoleName, &hours)
+		if err != nil {
+			return err
+		}
+
+		project.TaskHours = dml.ConvertDecimal(hours)
+		projects = append(projects, &project)
+		return nil
+	}, personId, mserviceId)
+
+	return projects, err
+}
+
+func (s *sqlPersons) GetPersonTasks(ctx context.Context, personId int64, mserviceId int64) ([]*pb.PersonTask, error) {
+	tasks := make([]*pb.PersonTask, 0)
+
+	sqlstring := `SELECT m.inbMemberId, t.inbProjectId, p.chvName, t.inbTaskId, t.chvName, t.intStatusId,
+	COALESCE(st.chvStatusName, ''), t.dtmStartDate, t.dtmEndDate, a.decTaskHours
+	FROM tb_TeamMember AS m
+	JOIN tb_TaskToMember AS a ON a.inbMemberId = m.inbMemberId AND a.bitIsDeleted = FALSE
+	JOIN tb_Task AS t ON t.inbTaskId = a.inbTaskId AND t.bitIsDeleted = FALSE
+	JOIN tb_Project AS p ON p.inbProjectId = t.inbProjectId AND p.bitIsDeleted = FALSE
+	LEFT JOIN tb_StatusType AS st ON st.inbMserviceId = t.inbMserviceId AND st.intStatusId = t.intStatusId
+	WHERE m.inbPersonId = ? AND m.inbMserviceId = ? AND m.bitIsDeleted = FALSE
+	ORDER BY t.dtmEndDate, t.inbTaskId`
+
+	err := s.query(ctx, sqlstring, func(rows *sql.Rows) error {
+		var startDate string
+		var endDate string
+		var task_hours string
+		var task pb.PersonTask
+
+		err := rows.Scan(&task.MemberId, &task.ProjectId, &task.ProjectName, &task.TaskId, &task.TaskName,
+			&task.StatusId, &task.StatusName, &startDate, &endDate, &task_hours)
+		if err != nil {
+			return err
+		}
+
+		task.StartDate = dml.DateTimeFromString(startDate)
+		task.EndDate = dml.DateTimeFromString(endDate)
+		d, err := DecimalFromDb(task_hours)
+		if err == nil {
+			task.TaskHours = d
+		}
+
+		tasks = append(tasks, &task)
+		return nil
+	}, personId, mserviceId)
+
+	return tasks, err
+}
+
+func (s *sqlPersons) SyncPersonMembers(ctx context.Context, personId int64, mserviceId int64) error {
+	sqlstring := `UPDATE tb_TeamMember SET dtmModified = NOW(),
+	chvName = (SELECT p.chvName FROM tb_Person AS p WHERE p.inbPersonId = tb_TeamMember.inbPersonId),
+	chvEmail = (SELECT p.chvEmail FROM tb_Person AS p WHERE p.inbPersonId = tb_TeamMember.inbPersonId)
+	WHERE inbPersonId = ? AND bitIsDeleted = FALSE
+	AND EXISTS (SELECT 1 FROM tb_Person AS p WHERE p.inbPersonId = tb_TeamMember.inbPersonId AND p.inbMserviceId = ?
+	AND (p.chvName <> tb_TeamMember.chvName OR p.chvEmail <> tb_TeamMember.chvEmail))`
+
+	_, err := s.exec(ctx, sqlstring, personId, mserviceId)
+	return err
+}
+
+func (s *sqlPersons) UpdatePersonFromMember(ctx context.Context, memberId int64, personId int64, mserviceId int64) error {
+	sqlstring := `UPDATE tb_Person SET dtmModified = NOW(),
+	chvName = (SELECT m.chvName FROM tb_TeamMember AS m WHERE m.inbMemberId = ?),
+	chvEmail = (SELECT m.chvEmail FROM tb_TeamMember AS m WHERE m.inbMemberId = ?)
+	WHERE inbPersonId = ? AND inbMserviceId = ?
+	AND EXISTS (SELECT 1 FROM tb_TeamMember AS m WHERE m.inbMemberId = ? AND m.inbPersonId = tb_Person.inbPersonId
+	AND (m.chvName <> tb_Person.chvName OR m.chvEmail <> tb_Person.chvEmail))`
+
+	_, err := s.exec(ctx, sqlstring, memberId, memberId, personId, mserviceId, memberId)
+	return err
+}
@@ -0,0 +1,139 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projstore
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+
+	pb "github.com/gaterace/mproject/pkg/mserviceproject"
+)
+
+// Projects in tb_Project.
+type sqlProjects struct {
+	*sqlStore
+}
+
+const projectColumns = `p.inbProjectId, p.dtmCreated, p.dtmModified, p.intVersion, p.inbMserviceId, p.chvName,
+	p.chvDescription, p.intStatusId, p.dtmStartDate, p.dtmEndDate, s.chvStatusName`
+
+// Helper to scan a project selected with projectColumns.
+func scanProject(row scanner) (*pb.Project, error) {
+	var created string
+	var modified string
+	var start_date string
+	var end_date string
+
+	var project pb.Project
+
+	err := row.Scan(&project.ProjectId, &created, &modified, &project.Version, &project.MserviceId, &project.Name,
+		&project.Description, &project.StatusId, &start_date, &end_date, &project.StatusName)
+	if err != nil {
+		return nil, err
+	}
+
+	project.Created = dml.DateTimeFromString(created)
+	project.Modified = dml.DateTimeFromString(modified)
+	project.StartDate = dml.DateTimeFromString(start_date)
+	project.EndDate = dml.DateTimeFromString(end_date)
+
+	return &project, nil
+}
+
+func (s *sqlProjects) CreateProject(ctx context.Context, project *pb.Project) (int64, error) {
+	sqlstring := `INSERT INTO tb_Project
+	(dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId, chvName, chvDescription,
+		intStatusId, dtmStartDate, dtmEndDate) VALUES(NOW(), NOW(), NOW(), FALSE, 1, ?, ?, ?, ?, ?, ?)`
+
+	return s.insert(ctx, sqlstring, "inbProjectId", project.GetMserviceId(), project.GetName(), project.GetDescription(),
+		project.GetStatusId(), project.GetStartDate().TimeFromDateTime(), project.GetEndDate().TimeFromDateTime())
+}
+
+func (s *sqlProjects) UpdateProject(ctx context.Context, project *pb.Project) error {
+	sqlstring := `UPDATE tb_Project SET dtmModified = NOW(), intVersion = ?, chvName = ?, chvDescription = ?, intStatusId = ?,
+	dtmStartDate = ?, dtmEndDate = ?  WHERE inbProjectId = ? AND intVersion = ? AND inbMserviceId = ? AND bitIsDeleted = FALSE`
+
+	err := s.execOne(ctx, sqlstring, project.GetVersion()+1, project.GetName(), project.GetDescription(),
+		project.GetStatusId(), project.GetStartDate().TimeFromDateTime(), project.GetEndDate().TimeFromDateTime(),
+		project.GetProjectId(), project.GetVersion(), project.GetMserviceId())
+	return s.versionError(ctx, err, "tb_Project", "inbProjectId = ? AND inbMserviceId = ? AND bitIsDeleted = FALSE", project.GetProjectId(), project.GetMserviceId())
+}
+
+func (s *sqlProjects) DeleteProject(ctx context.Context, projectId int64, mserviceId int64, version int32) error {
+	sqlstring := `UPDATE tb_Project SET dtmDeleted = NOW(), intVersion = ?, bitIsDeleted = TRUE
+	 WHERE inbProjectId = ? AND intVersion = ? AND inbMserviceId = ? AND bitIsDeleted = FALSE`
+
+	err := s.execOne(ctx, sqlstring, version+1, projectId, version, mserviceId)
+	return s.versionError(ctx, err, "tb_Project", "inbProjectId = ? AND inbMserviceId = ? AND bitIsDeleted = FALSE", projectId, mserviceId)
+}
+
+func (s *sqlProjects) GetProjectById(ctx context.Context, projectId int64, mserviceId int64) (*pb.Project, error) {
+	sqlstring := `SELECT ` + projectColumns + `
+	FROM tb_Project AS p
+	JOIN tb_StatusType AS s ON p.intStatusId = s.intStatusId
+	WHERE p.inbProjectId = ? AND p.inbMserviceId = ?
+	AND p.bitIsDeleted = FALSE`
+
+	var project *pb.Project
+	err := s.queryRow(ctx, sqlstring, func(row *sql.Row) error {
+		var err error
+		project, err = scanProject(row)
+		return err
+	}, projectId, mserviceId)
+
+	return project, err
+}
+
+func (s *sqlProjects) GetProjectByName(ctx context.Context, name string, mserviceId int64) (*pb.Project, error) {
+	sqlstring := `SELECT ` + projectColumns + `
+	FROM tb_Project AS p
+	JOIN tb_StatusType AS s ON p.intStatusId = s.intStatusId
+	WHERE p.chvName = ? AND p.inbMserviceId = ?
+	AND p.bitIsDeleted = FALSE`
+
+	var project *pb.Project
+	err := s.queryRow(ctx, sqlstring, func(row *sql.Row) error {
+		var err error
+		project, err = scanProject(row)
+		return err
+	}, name, mserviceId)
+
+	return project, err
+}
+
+func (s *sqlProjects) GetProjectNames(ctx context.Context, mserviceId int64) ([]string, error) {
+	names := make([]string, 0)
+
+	sqlstring := `SELECT chvName FROM tb_Project WHERE inbMserviceId = ? AND  bitIsDeleted = FALSE`
+
+	err := s.query(ctx, sqlstring, func(rows *sql.Rows) error {
+		var projectName string
+		err := rows.Scan(&projectName)
+		if err == nil {
+			names = append(names, projectName)
+		}
+		return err
+	}, mserviceId)
+
+	return names, err
+}
+
+func (s *sqlProjects) ProjectExists(ctx context.Context, projectId int64, mserviceId int64) (bool, error) {
+	sqlstring := `SELECT inbProjectId FROM tb_Project WHERE inbProjectId = ? AND inbMserviceId = ? AND bitIsDeleted = FALSE` +
+		s.forUpdate()
+
+	return s.exists(ctx, sqlstring, projectId, mserviceId)
+}
@@ -0,0 +1,286 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projstore
+
+import (
+	"context"
+	"database/sql"
+)
+
+// Store in the mproject database.
+type sqlStore struct {
+	db *DB
+	// transaction of a Store passed to a WithTx function, otherwise nil
+	tx *Tx
+}
+
+// A *sql.Row or *sql.Rows.
+type scanner interface {
+	Scan(dest ...interface{}) error
+}
+
+// A *DB or *Tx.
+type preparer interface {
+	PrepareContext(ctx context.Context, query string) (*Stmt, error)
+	PrepareInsertContext(ctx context.Context, query string, idColumn string) (*InsertStmt, error)
+}
+
+// Get a new Store using the database connection.
+func NewSqlStore(db *DB) Store {
+	return &sqlStore{db: db}
+}
+
+func (s *sqlStore) Projects() ProjectRepository {
+	return &sqlProjects{s}
+}
+
+func (s *sqlStore) Tasks() TaskRepository {
+	return &sqlTasks{s}
+}
+
+func (s *sqlStore) Members() MemberRepository {
+	return &sqlMembers{s}
+}
+
+func (s *sqlStore) Types() TypeRepository {
+	return &sqlTypes{s}
+}
+
+func (s *sqlStore) Assignments() AssignmentRepository {
+	return &sqlAssignments{s}
+}
+
+func (s *sqlStore) Persons() PersonRepository {
+	return &sqlPersons{s}
+}
+
+func (s *sqlStore) Grants() GrantRepository {
+	return &sqlGrants{s}
+}
+
+func (s *sqlStore) ApiKeys() ApiKeyRepository {
+	return &sqlApiKeys{s}
+}
+
+func (s *sqlStore) Webhooks() WebhookRepository {
+	return &sqlWebhooks{s}
+}
+
+func (s *sqlStore) Capacity() CapacityRepository {
+	return &sqlCapacity{s}
+}
+
+func (s *sqlStore) Notifications() NotificationRepository {
+	return &sqlNotifications{s}
+}
+
+func (s *sqlStore) WithTx(ctx context.Context, fn func(tx Store) error) error {
+	if s.tx != nil {
+		return fn(s)
+	}
+
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return &DbError{What: "Begin", Err: err}
+	}
+
+	// no effect once committed
+	defer tx.Rollback()
+
+	err = fn(&sqlStore{db: s.db, tx: tx})
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return &DbError{What: "Commit", Err: err}
+	}
+
+	return nil
+}
+
+// Helper to get the connection to prepare statements on, the transaction of the Store if it has one, or a read
+// replica if ctx allows one.
+func (s *sqlStore) conn(ctx context.Context) preparer {
+	if s.tx != nil {
+		return s.tx
+	}
+
+	return s.db.Reader(ctx)
+}
+
+// Helper to get the clause that locks the rows of a SELECT in a transaction until it ends.
+func (s *sqlStore) forUpdate() string {
+	if s.tx == nil {
+		return ""
+	}
+
+	return s.db.Dialect.ForUpdate()
+}
+
+// Helper to run an insert, returning the new id generated for idColumn, or ErrNotFound if an INSERT ... SELECT
+// selected no row.
+func (s *sqlStore) insert(ctx context.Context, sqlstring string, idColumn string, args ...interface{}) (int64, error) {
+	stmt, err := s.conn(ctx).PrepareInsertContext(ctx, sqlstring, idColumn)
+	if err != nil {
+		return 0, &DbError{What: "Prepare", Err: err}
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.ExecContext(ctx, args...)
+	if err == sql.ErrNoRows {
+		return 0, ErrNotFound
+	} else if err != nil {
+		return 0, s.execError(err)
+	}
+
+	n, err := res.RowsAffected()
+	if (err == nil) && (n == 0) {
+		return 0, ErrNotFound
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return 0, &DbError{What: "LastInsertId", Err: err}
+	}
+
+	return id, nil
+}
+
+// Helper to run a statement, returning the number of rows affected.
+func (s *sqlStore) exec(ctx context.Context, sqlstring string, args ...interface{}) (int64, error) {
+	stmt, err := s.conn(ctx).PrepareContext(ctx, sqlstring)
+	if err != nil {
+		return 0, &DbError{What: "Prepare", Err: err}
+	}
+
+	defer stmt.Close()
+
+	res, err := stmt.ExecContext(ctx, args...)
+	if err != nil {
+		return 0, s.execError(err)
+	}
+
+	rowsAffected, _ := res.RowsAffected()
+	return rowsAffected, nil
+}
+
+// Helper to run an update that must change exactly one row, otherwise the row is not found.
+func (s *sqlStore) execOne(ctx context.Context, sqlstring string, args ...interface{}) error {
+	rowsAffected, err := s.exec(ctx, sqlstring, args...)
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected != 1 {
+		return ErrNotFound
+	}
+
+	return nil
+}
+
+// Helper to tell a version conflict from a row not found, when a versioned update changed no row. The row is
+// looked up in table by where, the conditions of the update without its version.
+func (s *sqlStore) versionError(ctx context.Context, err error, table string, where string, args ...interface{}) error {
+	if err != ErrNotFound {
+		return err
+	}
+
+	found, err := s.exists(ctx, "SELECT 1 FROM "+table+" WHERE "+where, args...)
+	if err != nil {
+		return err
+	} else if found {
+		return ErrVersionConflict
+	}
+
+	return ErrNotFound
+}
+
+// Helper to wrap an error from running a statement, telling a unique key violation from other failures.
+func (s *sqlStore) execError(err error) error {
+	if s.db.Dialect.IsDuplicate(err) {
+		return &DbError{What: "Duplicate", Err: err}
+	}
+
+	return &DbError{What: "Exec", Err: err}
+}
+
+// Helper to query a single row, scanned by scan.
+func (s *sqlStore) queryRow(ctx context.Context, sqlstring string, scan func(row *sql.Row) error,
+	args ...interface{}) error {
+	stmt, err := s.conn(ctx).PrepareContext(ctx, sqlstring)
+	if err != nil {
+		return &DbError{What: "Prepare", Err: err}
+	}
+
+	defer stmt.Close()
+
+	err = scan(stmt.QueryRowContext(ctx, args...))
+	if err == sql.ErrNoRows {
+		return ErrNotFound
+	} else if err != nil {
+		return &DbError{What: "QueryRow", Err: err}
+	}
+
+	return nil
+}
+
+// Helper to query rows, each scanned by scan.
+func (s *sqlStore) query(ctx context.Context, sqlstring string, scan func(rows *sql.Rows) error,
+	args ...interface{}) error {
+	stmt, err := s.conn(ctx).PrepareContext(ctx, sqlstring)
+	if err != nil {
+		return &DbError{What: "Prepare", Err: err}
+	}
+
+	defer stmt.Close()
+
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		return &DbError{What: "Query", Err: err}
+	}
+
+	defer rows.Close()
+	for rows.Next() {
+		err = scan(rows)
+		if err != nil {
+			return &DbError{What: "Scan", Err: err}
+		}
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return &DbError{What: "Query", Err: err}
+	}
+
+	return nil
+}
+
+// Helper to check whether a query returns a row.
+func (s *sqlStore) exists(ctx context.Context, sqlstring string, args ...interface{}) (bool, error) {
+	var id int64
+	err := s.queryRow(ctx, sqlstring, func(row *sql.Row) error {
+		return row.Scan(&id)
+	}, args...)
+
+	if err == ErrNotFound {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
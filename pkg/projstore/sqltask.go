@@ -0,0 +1,160 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projstore
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+
+	pb "github.com/gaterace/mproject/pkg/mserviceproject"
+)
+
+// Tasks in tb_Task.
+type sqlTasks struct {
+	*sqlStore
+}
+
+const taskColumns = `t.inbTaskId, t.dtmCreated, t.dtmModified, t.intVersion, t.inbMserviceId, t.inbProjectId, t.chvName,
+	t.chvDescription, t.intStatusId, t.dtmStartDate, t.dtmEndDate, t.intPriority, t.inbParentId, t.intPosition, s.chvStatusName`
+
+// Helper to scan a task selected with taskColumns.
+func scanTask(row scanner) (*pb.Task, error) {
+	var created string
+	var modified string
+	var start_date string
+	var end_date string
+
+	var task pb.Task
+
+	err := row.Scan(&task.TaskId, &created, &modified, &task.Version, &task.MserviceId, &task.ProjectId, &task.Name,
+		&task.Description, &task.StatusId, &start_date, &end_date, &task.Priority, &task.ParentId, &task.Position,
+		&task.StatusName)
+	if err != nil {
+		return nil, err
+	}
+
+	task.Created = dml.DateTimeFromString(created)
+	task.Modified = dml.DateTimeFromString(modified)
+	task.StartDate = dml.DateTimeFromString(start_date)
+	task.EndDate = dml.DateTimeFromString(end_date)
+
+	return &task, nil
+}
+
+func (s *sqlTasks) CreateTask(ctx context.Context, task *pb.Task) (int64, error) {
+	sqlstring := `INSERT INTO tb_Task (dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId,
+		inbProjectId, chvName, chvDescription, intStatusId, dtmStartDate, dtmEndDate, intPriority, inbParentId,
+		intPosition) VALUES (NOW(), NOW(), NOW(), FALSE, 1, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+	return s.insert(ctx, sqlstring, "inbTaskId", task.GetMserviceId(), task.GetProjectId(), task.GetName(),
+		task.GetDescription(), task.GetStatusId(), task.GetStartDate().TimeFromDateTime(),
+		task.GetEndDate().TimeFromDateTime(), task.GetPriority(), task.GetParentId(), task.GetPosition())
+}
+
+func (s *sqlTasks) UpdateTask(ctx context.Context, task *pb.Task) error {
+	sqlstring := `UPDATE tb_Task SET dtmModified = NOW(), intVersion = ?, chvName = ?, chvDescription = ?, intStatusId = ?,
+	dtmStartDate = ?, dtmEndDate = ?, intPriority = ?, intPosition = ? WHERE inbTaskId = ? AND intVersion = ?
+	AND inbMserviceId = ? AND bitIsDeleted = FALSE`
+
+	err := s.execOne(ctx, sqlstring, task.GetVersion()+1, task.GetName(), task.GetDescription(), task.GetStatusId(),
+		task.GetStartDate().TimeFromDateTime(), task.GetEndDate().TimeFromDateTime(), task.GetPriority(),
+		task.GetPosition(), task.GetTaskId(), task.GetVersion(), task.GetMserviceId())
+	return s.versionError(ctx, err, "tb_Task", "inbTaskId = ? AND inbMserviceId = ? AND bitIsDeleted = FALSE",
+		task.GetTaskId(), task.GetMserviceId())
+}
+
+func (s *sqlTasks) DeleteTask(ctx context.Context, taskId int64, mserviceId int64, version int32) error {
+	sqlstring := `UPDATE tb_Task SET dtmDeleted = NOW(), intVersion = ?, bitIsDeleted = TRUE
+	 WHERE inbTaskId = ? AND intVersion = ? AND inbMserviceId = ? AND bitIsDeleted = FALSE`
+
+	err := s.execOne(ctx, sqlstring, version+1, taskId, version, mserviceId)
+	return s.versionError(ctx, err, "tb_Task", "inbTaskId = ? AND inbMserviceId = ? AND bitIsDeleted = FALSE",
+		taskId, mserviceId)
+}
+
+func (s *sqlTasks) TouchTask(ctx context.Context, taskId int64, mserviceId int64, version int32) error {
+	sqlstring := `UPDATE tb_Task SET dtmModified = NOW(), intVersion = ?
+	 WHERE inbTaskId = ? AND intVersion = ? AND inbMserviceId = ? AND bitIsDeleted = FALSE`
+
+	err := s.execOne(ctx, sqlstring, version+1, taskId, version, mserviceId)
+	return s.versionError(ctx, err, "tb_Task", "inbTaskId = ? AND inbMserviceId = ? AND bitIsDeleted = FALSE",
+		taskId, mserviceId)
+}
+
+func (s *sqlTasks) SetTaskPosition(ctx context.Context, taskId int64, parentId int64, mserviceId int64,
+	position int32) error {
+	sqlstring := `UPDATE tb_Task SET dtmModified = NOW(), intVersion = intVersion + 1, intPosition = ?
+	WHERE inbTaskId = ? AND inbMserviceId = ? AND bitIsDeleted = FALSE AND inbParentId = ?`
+
+	return s.execOne(ctx, sqlstring, position, taskId, mserviceId, parentId)
+}
+
+func (s *sqlTasks) GetTaskById(ctx context.Context, taskId int64, mserviceId int64) (*pb.Task, error) {
+	sqlstring := `SELECT ` + taskColumns + `
+	FROM tb_Task AS t
+	JOIN tb_StatusType AS s ON t.intStatusId = s.intStatusId
+	WHERE t.inbTaskId = ? AND t.inbMserviceId = ? AND t.bitIsDeleted = FALSE`
+
+	var task *pb.Task
+	err := s.queryRow(ctx, sqlstring, func(row *sql.Row) error {
+		var err error
+		task, err = scanTask(row)
+		return err
+	}, taskId, mserviceId)
+
+	return task, err
+}
+
+func (s *sqlTasks) GetTasksByProject(ctx context.Context, projectId int64, mserviceId int64) ([]*pb.Task, error) {
+	tasks := make([]*pb.Task, 0)
+
+	sqlstring := `SELECT ` + taskColumns + `
+	FROM tb_Task AS t
+	JOIN tb_StatusType AS s ON t.intStatusId = s.intStatusId
+	WHERE t.inbProjectId = ? AND t.inbMserviceId = ? AND t.bitIsDeleted = FALSE
+	ORDER by t.inbParentId, t.intPosition`
+
+	err := s.query(ctx, sqlstring, func(rows *sql.Rows) error {
+		task, err := scanTask(rows)
+		if err == nil {
+			tasks = append(tasks, task)
+		}
+		return err
+	}, projectId, mserviceId)
+
+	return tasks, err
+}
+
+func (s *sqlTasks) GetTaskProject(ctx context.Context, taskId int64, mserviceId int64) (int64, int32, error) {
+	sqlstring := `SELECT inbProjectId, intStatusId FROM tb_Task WHERE inbTaskId = ? AND inbMserviceId = ? AND bitIsDeleted = FALSE` +
+		s.forUpdate()
+
+	var projectId int64
+	var statusId int32
+
+	err := s.queryRow(ctx, sqlstring, func(row *sql.Row) error {
+		return row.Scan(&projectId, &statusId)
+	}, taskId, mserviceId)
+
+	return projectId, statusId, err
+}
+
+func (s *sqlTasks) TaskExists(ctx context.Context, taskId int64, projectId int64) (bool, error) {
+	sqlstring := `SELECT inbTaskId FROM tb_Task WHERE inbTaskId = ? AND inbProjectId = ? AND bitIsDeleted = FALSE` +
+		s.forUpdate()
+
+	return s.exists(ctx, sqlstring, taskId, projectId)
+}
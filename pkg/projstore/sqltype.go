@@ -0,0 +1,182 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projstore
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+
+	pb "github.com/gaterace/mproject/pkg/mserviceproject"
+)
+
+// Status types in tb_StatusType and project role types in tb_ProjectRoleType.
+type sqlTypes struct {
+	*sqlStore
+}
+
+// Helper to scan a status type.
+func scanStatusType(row scanner) (*pb.StatusType, error) {
+	var created string
+	var modified string
+	var statusType pb.StatusType
+
+	err := row.Scan(&statusType.StatusId, &created, &modified, &statusType.Version, &statusType.MserviceId,
+		&statusType.StatusName, &statusType.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	statusType.Created = dml.DateTimeFromString(created)
+	statusType.Modified = dml.DateTimeFromString(modified)
+
+	return &statusType, nil
+}
+
+// Helper to scan a project role type.
+func scanRoleType(row scanner) (*pb.ProjectRoleType, error) {
+	var created string
+	var modified string
+	var role pb.ProjectRoleType
+
+	err := row.Scan(&role.ProjectRoleId, &created, &modified, &role.Version, &role.MserviceId, &role.RoleName,
+		&role.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	role.Created = dml.DateTimeFromString(created)
+	role.Modified = dml.DateTimeFromString(modified)
+
+	return &role, nil
+}
+
+func (s *sqlTypes) CreateStatusType(ctx context.Context, statusType *pb.StatusType) error {
+	sqlstring := `INSERT INTO tb_StatusType
+		(intStatusId, dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId,
+			chvStatusName, chvDescription) VALUES (?, NOW(), NOW(), NOW(), FALSE, 1, ?, ?, ?)`
+
+	_, err := s.exec(ctx, sqlstring, statusType.GetStatusId(), statusType.GetMserviceId(), statusType.GetStatusName(),
+		statusType.GetDescription())
+	return err
+}
+
+func (s *sqlTypes) UpdateStatusType(ctx context.Context, statusType *pb.StatusType) error {
+	sqlstring := `UPDATE tb_StatusType SET dtmModified = NOW(), intVersion = ?, chvStatusName = ?, chvDescription = ?
+	WHERE intStatusId = ? AND inbMserviceId = ? AND bitIsDeleted = FALSE AND intVersion = ?`
+
+	err := s.execOne(ctx, sqlstring, statusType.GetVersion()+1, statusType.GetStatusName(), statusType.GetDescription(),
+		statusType.GetStatusId(), statusType.GetMserviceId(), statusType.GetVersion())
+	return s.versionError(ctx, err, "tb_StatusType", "intStatusId = ? AND inbMserviceId = ? AND bitIsDeleted = FALSE", statusType.GetStatusId(),
+		statusType.GetMserviceId())
+}
+
+func (s *sqlTypes) DeleteStatusType(ctx context.Context, statusId int32, mserviceId int64, version int32) error {
+	sqlstring := `UPDATE tb_StatusType SET dtmDeleted = NOW(), bitIsDeleted = TRUE, intVersion = ?
+	WHERE intStatusId = ? AND inbMserviceId = ? AND bitIsDeleted = FALSE AND intVersion = ?`
+
+	err := s.execOne(ctx, sqlstring, version+1, statusId, mserviceId, version)
+	return s.versionError(ctx, err, "tb_StatusType", "intStatusId = ? AND inbMserviceId = ? AND bitIsDeleted = FALSE", statusId, mserviceId)
+}
+
+func (s *sqlTypes) GetStatusType(ctx context.Context, statusId int32, mserviceId int64) (*pb.StatusType, error) {
+	sqlstring := `SELECT intStatusId, dtmCreated, dtmModified, intVersion, inbMserviceId, chvStatusName, chvDescription
+	FROM tb_StatusType WHERE intStatusId = ? AND inbMserviceId = ? AND bitIsDeleted = FALSE`
+
+	var statusType *pb.StatusType
+	err := s.queryRow(ctx, sqlstring, func(row *sql.Row) error {
+		var err error
+		statusType, err = scanStatusType(row)
+		return err
+	}, statusId, mserviceId)
+
+	return statusType, err
+}
+
+func (s *sqlTypes) GetStatusTypes(ctx context.Context, mserviceId int64) ([]*pb.StatusType, error) {
+	statusTypes := make([]*pb.StatusType, 0)
+
+	sqlstring := `SELECT intStatusId, dtmCreated, dtmModified, intVersion, inbMserviceId, chvStatusName, chvDescription
+	FROM tb_StatusType WHERE inbMserviceId = ? AND bitIsDeleted = FALSE`
+
+	err := s.query(ctx, sqlstring, func(rows *sql.Rows) error {
+		statusType, err := scanStatusType(rows)
+		if err == nil {
+			statusTypes = append(statusTypes, statusType)
+		}
+		return err
+	}, mserviceId)
+
+	return statusTypes, err
+}
+
+func (s *sqlTypes) CreateRoleType(ctx context.Context, roleType *pb.ProjectRoleType) error {
+	sqlstring := `INSERT INTO tb_ProjectRoleType
+	(intProjectRoleId, dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId, chvRoleName, chvDescription)
+	VALUES(?, NOW(), NOW(), NOW(), FALSE, 1, ?, ?, ?)`
+
+	_, err := s.exec(ctx, sqlstring, roleType.GetProjectRoleId(), roleType.GetMserviceId(), roleType.GetRoleName(),
+		roleType.GetDescription())
+	return err
+}
+
+func (s *sqlTypes) UpdateRoleType(ctx context.Context, roleType *pb.ProjectRoleType) error {
+	sqlstring := `UPDATE tb_ProjectRoleType SET dtmModified = NOW(), intVersion = ?, chvRoleName = ?, chvDescription = ?
+	WHERE  intProjectRoleId = ? AND inbMserviceId = ? AND intVersion = ? AND bitIsDeleted = FALSE`
+
+	err := s.execOne(ctx, sqlstring, roleType.GetVersion()+1, roleType.GetRoleName(), roleType.GetDescription(),
+		roleType.GetProjectRoleId(), roleType.GetMserviceId(), roleType.GetVersion())
+	return s.versionError(ctx, err, "tb_ProjectRoleType", "intProjectRoleId = ? AND inbMserviceId = ? AND bitIsDeleted = FALSE", roleType.GetProjectRoleId(),
+		roleType.GetMserviceId())
+}
+
+func (s *sqlTypes) DeleteRoleType(ctx context.Context, projectRoleId int32, mserviceId int64, version int32) error {
+	sqlstring := `UPDATE tb_ProjectRoleType SET dtmDeleted = NOW(), intVersion = ?, bitIsDeleted = TRUE
+	WHERE  intProjectRoleId = ? AND inbMserviceId = ? AND intVersion = ? AND bitIsDeleted = FALSE`
+
+	err := s.execOne(ctx, sqlstring, version+1, projectRoleId, mserviceId, version)
+	return s.versionError(ctx, err, "tb_ProjectRoleType", "intProjectRoleId = ? AND inbMserviceId = ? AND bitIsDeleted = FALSE", projectRoleId, mserviceId)
+}
+
+func (s *sqlTypes) GetRoleType(ctx context.Context, projectRoleId int32, mserviceId int64) (*pb.ProjectRoleType, error) {
+	sqlstring := `SELECT intProjectRoleId, dtmCreated, dtmModified, intVersion, inbMserviceId, chvRoleName, chvDescription
+	FROM tb_ProjectRoleType WHERE intProjectRoleId = ? AND inbMserviceId = ? AND bitIsDeleted = FALSE`
+
+	var role *pb.ProjectRoleType
+	err := s.queryRow(ctx, sqlstring, func(row *sql.Row) error {
+		var err error
+		role, err = scanRoleType(row)
+		return err
+	}, projectRoleId, mserviceId)
+
+	return role, err
+}
+
+func (s *sqlTypes) GetRoleTypes(ctx context.Context, mserviceId int64) ([]*pb.ProjectRoleType, error) {
+	roles := make([]*pb.ProjectRoleType, 0)
+
+	sqlstring := `SELECT intProjectRoleId, dtmCreated, dtmModified, intVersion, inbMserviceId, chvRoleName, chvDescription
+	FROM tb_ProjectRoleType WHERE inbMserviceId = ? AND bitIsDeleted = FALSE`
+
+	err := s.query(ctx, sqlstring, func(rows *sql.Rows) error {
+		role, err := scanRoleType(rows)
+		if err == nil {
+			roles = append(roles, role)
+		}
+		return err
+	}, mserviceId)
+
+	return roles, err
+}
@@ -0,0 +1,229 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projstore
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+
+	pb "github.com/gaterace/mproject/pkg/mserviceproject"
+)
+
+// Webhooks in tb_Webhook, with their delivery log in tb_WebhookDelivery.
+type sqlWebhooks struct {
+	*sqlStore
+}
+
+func (s *sqlWebhooks) CreateWebhook(ctx context.Context, webhook *pb.Webhook, secret string) (int64, error) {
+	sqlstring := `INSERT INTO tb_Webhook (dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId, chvUrl,
+	chvSecret, chvEventTypes, bitIsActive) VALUES(NOW(), NOW(), NOW(), FALSE, 1, ?, ?, ?, ?, ?)`
+
+	return s.insert(ctx, sqlstring, "inbWebhookId", webhook.GetMserviceId(), webhook.GetUrl(), secret,
+		strings.Join(webhook.GetEventTypes(), ","), webhook.GetIsActive())
+}
+
+func (s *sqlWebhooks) UpdateWebhook(ctx context.Context, webhook *pb.Webhook) error {
+	sqlstring := `UPDATE tb_Webhook SET dtmModified = NOW(), intVersion = ?, chvUrl = ?, chvEventTypes = ?, bitIsActive = ?
+	WHERE inbWebhookId = ? AND inbMserviceId = ? AND intVersion = ? AND bitIsDeleted = FALSE`
+
+	err := s.execOne(ctx, sqlstring, webhook.GetVersion()+1, webhook.GetUrl(), strings.Join(webhook.GetEventTypes(), ","),
+		webhook.GetIsActive(), webhook.GetWebhookId(), webhook.GetMserviceId(), webhook.GetVersion())
+	return s.versionError(ctx, err, "tb_Webhook", "inbWebhookId = ? AND inbMserviceId = ? AND bitIsDeleted = FALSE", webhook.GetWebhookId(), webhook.GetMserviceId())
+}
+
+func (s *sqlWebhooks) DeleteWebhook(ctx context.Context, webhookId int64, mserviceId int64, version int32) error {
+	sqlstring := `UPDATE tb_Webhook SET dtmDeleted = NOW(), intVersion = ?, bitIsDeleted = TRUE
+	WHERE inbWebhookId = ? AND inbMserviceId = ? AND intVersion = ? AND bitIsDeleted = FALSE`
+
+	err := s.execOne(ctx, sqlstring, version+1, webhookId, mserviceId, version)
+	return s.versionError(ctx, err, "tb_Webhook", "inbWebhookId = ? AND inbMserviceId = ? AND bitIsDeleted = FALSE", webhookId, mserviceId)
+}
+
+func (s *sqlWebhooks) GetWebhooks(ctx context.Context, mserviceId int64) ([]*pb.Webhook, error) {
+	webhooks := make([]*pb.Webhook, 0)
+
+	sqlstring := `SELECT inbWebhookId, dtmCreated, dtmModified, dtmDeleted, bitIsDeleted, intVersion, inbMserviceId, chvUrl,
+	chvEventTypes, bitIsActive FROM tb_Webhook WHERE inbMserviceId = ? AND bitIsDeleted = FALSE ORDER BY inbWebhookId`
+
+	err := s.query(ctx, sqlstring, func(rows *sql.Rows) error {
+		var created string
+		var modified string
+		var deleted string
+		var eventTypes string
+		var webhook pb.Webhook
+
+		err := rows.Scan(&webhook.WebhookId, &created, &modified, &deleted, &webhook.IsDeleted, &webhook.Version,
+			&webhook.MserviceId, &webhook.Url, &eventTypes, &webhook.IsActive)
+		if err != nil {
+			return err
+		}
+
+		webhook.Created = dml.DateTimeFromString(created)
+		webhook.Modified = dml.DateTimeFromString(modified)
+		webhook.Deleted = dml.DateTimeFromString(deleted)
+		if eventTypes != "" {
+			webhook.EventTypes = strings.Split(eventTypes, ",")
+		}
+
+		webhooks = append(webhooks, &webhook)
+		return nil
+	}, mserviceId)
+
+	return webhooks, err
+}
+
+const deliveryColumns = `d.inbDeliveryId, d.dtmCreated, d.dtmModified, d.inbMserviceId, d.inbWebhookId, d.chvEventType,
+	d.inbSequence, d.txtPayload, d.intAttempts, d.intResponseCode, d.chvLastError, d.bitIsDelivered`
+
+// Helper to scan a delivery selected with deliveryColumns, followed by any extra columns.
+func scanDelivery(row scanner, extra ...interface{}) (*pb.WebhookDelivery, error) {
+	var created string
+	var modified string
+	var delivery pb.WebhookDelivery
+
+	dest := []interface{}{&delivery.DeliveryId, &created, &modified, &delivery.MserviceId, &delivery.WebhookId,
+		&delivery.EventType, &delivery.Sequence, &delivery.Payload, &delivery.Attempts, &delivery.ResponseCode,
+		&delivery.LastError, &delivery.IsDelivered}
+
+	err := row.Scan(append(dest, extra...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	delivery.Created = dml.DateTimeFromString(created)
+	delivery.Modified = dml.DateTimeFromString(modified)
+
+	return &delivery, nil
+}
+
+func (s *sqlWebhooks) GetDeliveries(ctx context.Context, webhookId int64, mserviceId int64,
+	limit int32) ([]*pb.WebhookDelivery, error) {
+	deliveries := make([]*pb.WebhookDelivery, 0)
+
+	sqlstring := `SELECT ` + deliveryColumns + ` FROM tb_WebhookDelivery AS d
+	WHERE d.inbWebhookId = ? AND d.inbMserviceId = ? ORDER BY d.inbDeliveryId DESC LIMIT ?`
+
+	err := s.query(ctx, sqlstring, func(rows *sql.Rows) error {
+		delivery, err := scanDelivery(rows)
+		if err == nil {
+			deliveries = append(deliveries, delivery)
+		}
+		return err
+	}, webhookId, mserviceId, limit)
+
+	return deliveries, err
+}
+
+func (s *sqlWebhooks) GetSubscribedWebhooks(ctx context.Context, mserviceId int64, eventType string) ([]int64, error) {
+	webhookIds := make([]int64, 0)
+
+	sqlstring := `SELECT inbWebhookId, chvEventTypes FROM tb_Webhook WHERE inbMserviceId = ? AND bitIsActive = TRUE
+	AND bitIsDeleted = FALSE ORDER BY inbWebhookId`
+
+	err := s.query(ctx, sqlstring, func(rows *sql.Rows) error {
+		var webhookId int64
+		var eventTypes string
+		err := rows.Scan(&webhookId, &eventTypes)
+		if err != nil {
+			return err
+		}
+
+		for _, t := range strings.Split(eventTypes, ",") {
+			if t == eventType {
+				webhookIds = append(webhookIds, webhookId)
+				break
+			}
+		}
+		return nil
+	}, mserviceId)
+
+	return webhookIds, err
+}
+
+func (s *sqlWebhooks) CreateDelivery(ctx context.Context, delivery *pb.WebhookDelivery, holdSeconds int) (int64, error) {
+	sqlstring := `INSERT INTO tb_WebhookDelivery (dtmCreated, dtmModified, inbMserviceId, inbWebhookId, chvEventType,
+	inbSequence, txtPayload, intAttempts, intResponseCode, chvLastError, bitIsDelivered, dtmNextAttempt)
+	VALUES (NOW(), NOW(), ?, ?, ?, ?, ?, 0, 0, '', FALSE, ` + s.db.Dialect.Later(holdSeconds) + `)`
+
+	return s.insert(ctx, sqlstring, "inbDeliveryId", delivery.GetMserviceId(), delivery.GetWebhookId(),
+		delivery.GetEventType(), delivery.GetSequence(), delivery.GetPayload())
+}
+
+func (s *sqlWebhooks) GetDelivery(ctx context.Context, deliveryId int64,
+	mserviceId int64) (*pb.WebhookDelivery, string, string, error) {
+	sqlstring := `SELECT ` + deliveryColumns + `, w.chvUrl, w.chvSecret
+	FROM tb_WebhookDelivery AS d
+	JOIN tb_Webhook AS w ON d.inbWebhookId = w.inbWebhookId
+	WHERE d.inbDeliveryId = ? AND d.inbMserviceId = ? AND w.bitIsDeleted = FALSE`
+
+	var delivery *pb.WebhookDelivery
+	var url string
+	var secret string
+
+	err := s.queryRow(ctx, sqlstring, func(row *sql.Row) error {
+		var err error
+		delivery, err = scanDelivery(row, &url, &secret)
+		return err
+	}, deliveryId, mserviceId)
+
+	return delivery, url, secret, err
+}
+
+func (s *sqlWebhooks) GetDueDeliveries(ctx context.Context, limit int32) ([]*pb.WebhookDelivery, error) {
+	deliveries := make([]*pb.WebhookDelivery, 0)
+
+	sqlstring := `SELECT ` + deliveryColumns + ` FROM tb_WebhookDelivery AS d
+	JOIN tb_Webhook AS w ON d.inbWebhookId = w.inbWebhookId
+	WHERE d.dtmNextAttempt <= NOW() AND w.bitIsDeleted = FALSE ORDER BY d.dtmNextAttempt LIMIT ?`
+
+	err := s.query(ctx, sqlstring, func(rows *sql.Rows) error {
+		delivery, err := scanDelivery(rows)
+		if err == nil {
+			deliveries = append(deliveries, delivery)
+		}
+		return err
+	}, limit)
+
+	return deliveries, err
+}
+
+func (s *sqlWebhooks) ClaimDelivery(ctx context.Context, deliveryId int64, mserviceId int64, holdSeconds int) error {
+	sqlstring := `UPDATE tb_WebhookDelivery SET dtmNextAttempt = ` + s.db.Dialect.Later(holdSeconds) + `
+	WHERE inbDeliveryId = ? AND inbMserviceId = ? AND dtmNextAttempt <= NOW()`
+
+	return s.execOne(ctx, sqlstring, deliveryId, mserviceId)
+}
+
+func (s *sqlWebhooks) RetryDelivery(ctx context.Context, deliveryId int64, mserviceId int64, holdSeconds int) error {
+	sqlstring := `UPDATE tb_WebhookDelivery SET dtmNextAttempt = ` + s.db.Dialect.Later(holdSeconds) + `
+	WHERE inbDeliveryId = ? AND inbMserviceId = ?`
+
+	return s.execOne(ctx, sqlstring, deliveryId, mserviceId)
+}
+
+func (s *sqlWebhooks) RecordAttempt(ctx context.Context, deliveryId int64, mserviceId int64, responseCode int32,
+	lastError string, retrySeconds int) error {
+	nextAttempt := "NULL"
+	if retrySeconds > 0 {
+		nextAttempt = s.db.Dialect.Later(retrySeconds)
+	}
+
+	sqlstring := `UPDATE tb_WebhookDelivery SET dtmModified = NOW(), intAttempts = intAttempts + 1, intResponseCode = ?,
+	chvLastError = ?, bitIsDelivered = ?, dtmNextAttempt = ` + nextAttempt + ` WHERE inbDeliveryId = ? AND inbMserviceId = ?`
+
+	return s.execOne(ctx, sqlstring, responseCode, lastError, lastError == "", deliveryId, mserviceId)
+}
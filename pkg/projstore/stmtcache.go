@@ -0,0 +1,103 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projstore
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+)
+
+// Prepared statement. A statement from the statement cache of its DB stays open when closed, to be reused.
+type Stmt struct {
+	*sql.Stmt
+	cached bool
+}
+
+func (s *Stmt) Close() error {
+	if s.cached {
+		return nil
+	}
+
+	return s.Stmt.Close()
+}
+
+// Prepared statements of a DB kept for reuse, keyed by their rewritten SQL. Statements stay prepared until the DB
+// is closed; once size statements are cached, others are prepared for each use as without a cache.
+type stmtCache struct {
+	mu    sync.RWMutex
+	size  int
+	stmts map[string]*sql.Stmt
+}
+
+// Keep up to size prepared statements for reuse, 0 to prepare statements for each use. Call before the DB is used.
+func (db *DB) SetStmtCacheSize(size int) {
+	if size <= 0 {
+		db.stmts = nil
+		return
+	}
+
+	db.stmts = &stmtCache{size: size, stmts: make(map[string]*sql.Stmt)}
+}
+
+// Helper to get the cached statement for a query, preparing it if there is room. Returns nil if the cache is full.
+func (c *stmtCache) get(ctx context.Context, sqlDb *sql.DB, query string) (*Stmt, error) {
+	c.mu.RLock()
+	stmt, ok := c.stmts[query]
+	full := len(c.stmts) >= c.size
+	c.mu.RUnlock()
+
+	if ok {
+		return &Stmt{Stmt: stmt, cached: true}, nil
+	}
+
+	if full {
+		return nil, nil
+	}
+
+	stmt, err := sqlDb.PrepareContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// prepared meanwhile by another caller
+	if other, ok := c.stmts[query]; ok {
+		stmt.Close()
+		return &Stmt{Stmt: other, cached: true}, nil
+	}
+
+	// filled meanwhile
+	if len(c.stmts) >= c.size {
+		stmt.Close()
+		return nil, nil
+	}
+
+	c.stmts[query] = stmt
+
+	return &Stmt{Stmt: stmt, cached: true}, nil
+}
+
+// Helper to close the cached statements.
+func (c *stmtCache) close() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for query, stmt := range c.stmts {
+		stmt.Close()
+		delete(c.stmts, query)
+	}
+}
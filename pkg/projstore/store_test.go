@@ -0,0 +1,767 @@
+// Copyright 2019-2022 Demian Harvill
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projstore
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gaterace/dml-go/pkg/dml"
+
+	pb "github.com/gaterace/mproject/pkg/mserviceproject"
+)
+
+// Environment variables with the data source names of mysql and postgres databases to also run the store tests
+// against.
+const (
+	mysqlDsnEnv    = "MPROJECT_TEST_MYSQL_DSN"
+	postgresDsnEnv = "MPROJECT_TEST_POSTGRES_DSN"
+)
+
+// Store to run the tests against, created for each test.
+type testBackend struct {
+	name     string
+	newStore func(t *testing.T) Store
+}
+
+// Helper to get the stores to test: memory, sqlite in a temporary file, and mysql and postgres if configured.
+func testBackends() []testBackend {
+	backends := []testBackend{
+		{"memory", func(t *testing.T) Store {
+			return NewMemoryStore()
+		}},
+		{"sqlite", func(t *testing.T) Store {
+			return openTestStore(t, "sqlite", SqliteDsn(filepath.Join(t.TempDir(), "mproject.db")))
+		}},
+	}
+
+	for _, driver := range []struct{ name, env string }{{"mysql", mysqlDsnEnv}, {"postgres", postgresDsnEnv}} {
+		dsn := os.Getenv(driver.env)
+		if dsn != "" {
+			name := driver.name
+			backends = append(backends, testBackend{name, func(t *testing.T) Store {
+				return openTestStore(t, name, dsn)
+			}})
+		}
+	}
+
+	return backends
+}
+
+// Helper to open a migrated database as a sql store, closed at the end of the test.
+func openTestStore(t *testing.T, driver string, dsn string) Store {
+	t.Helper()
+
+	db, err := OpenDB(driver, dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Cleanup(func() { db.Close() })
+
+	_, err = db.MigrateUp()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return NewSqlStore(db)
+}
+
+// Helper to get an mservice id not used by earlier runs against a shared database, with status type 1 and
+// project role type 1.
+func newTestAccount(t *testing.T, store Store) int64 {
+	t.Helper()
+
+	ctx := context.Background()
+	mserviceId := time.Now().UnixNano() / 1000
+
+	err := store.Types().CreateStatusType(ctx, &pb.StatusType{MserviceId: mserviceId, StatusId: 1,
+		StatusName: "open", Description: "open"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = store.Types().CreateRoleType(ctx, &pb.ProjectRoleType{MserviceId: mserviceId, ProjectRoleId: 1,
+		RoleName: "developer", Description: "developer"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return mserviceId
+}
+
+// Helper to create a project, returning its id.
+func createProject(t *testing.T, store Store, mserviceId int64, name string) int64 {
+	t.Helper()
+
+	projectId, err := store.Projects().CreateProject(context.Background(), &pb.Project{MserviceId: mserviceId,
+		Name: name, Description: "test project", StatusId: 1, StartDate: dml.DateTimeFromString("2030-01-07"),
+		EndDate: dml.DateTimeFromString("2030-03-01")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return projectId
+}
+
+// Helper to create a team member in a project along with its person.
+func createMember(t *testing.T, store Store, mserviceId int64, projectId int64, name string) int64 {
+	t.Helper()
+
+	ctx := context.Background()
+	personId, err := store.Persons().CreatePerson(ctx, &pb.Person{MserviceId: mserviceId, Name: name,
+		Email: name + "@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	memberId, err := store.Members().CreateMember(ctx, &pb.TeamMember{MserviceId: mserviceId, ProjectId: projectId,
+		Name: name, ProjectRoleId: 1, Email: name + "@example.com", PersonId: personId})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return memberId
+}
+
+func TestRebind(t *testing.T) {
+	tests := []struct {
+		driver string
+		in     string
+		want   string
+	}{
+		{"mysql", "UPDATE t SET a = ?, b = NOW() WHERE c = ?", "UPDATE t SET a = ?, b = NOW() WHERE c = ?"},
+		{"postgres", "UPDATE t SET a = ?, b = NOW() WHERE c = ?", "UPDATE t SET a = $1, b = NOW() WHERE c = $2"},
+		{"postgres", "SELECT a FROM t WHERE b = '?' AND c = ?", "SELECT a FROM t WHERE b = '?' AND c = $1"},
+		{"sqlite", "UPDATE t SET a = ?, b = NOW() WHERE c = ?",
+			"UPDATE t SET a = ?, b = CURRENT_TIMESTAMP WHERE c = ?"},
+	}
+
+	for _, tt := range tests {
+		dialect, err := NewDialect(tt.driver)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got := dialect.Rebind(tt.in); got != tt.want {
+			t.Errorf("%s: got %q, want %q", tt.driver, got, tt.want)
+		}
+	}
+}
+
+func TestStore(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func(t *testing.T, store Store)
+	}{
+		{"insert ids", testInsertIds},
+		{"version conflicts", testVersionConflicts},
+		{"unique names", testUniqueNames},
+		{"assignment reuse", testAssignmentReuse},
+		{"person reuse", testPersonReuse},
+		{"person members", testPersonMembers},
+		{"transaction rollback", testRollback},
+		{"due notifications", testDueNotifications},
+		{"api key use", testApiKeyUse},
+		{"delivery attempts", testDeliveryAttempts},
+	}
+
+	for _, backend := range testBackends() {
+		for _, tt := range tests {
+			t.Run(backend.name+"/"+tt.name, func(t *testing.T) {
+				tt.run(t, backend.newStore(t))
+			})
+		}
+	}
+}
+
+// New ids come from LastInsertId, or from INSERT ... RETURNING on postgres.
+func testInsertIds(t *testing.T, store Store) {
+	ctx := context.Background()
+	mserviceId := newTestAccount(t, store)
+
+	first := createProject(t, store, mserviceId, "alpha")
+	second := createProject(t, store, mserviceId, "beta")
+	if (first <= 0) || (second <= first) {
+		t.Fatalf("project ids: got %d and %d", first, second)
+	}
+
+	project, err := store.Projects().GetProjectById(ctx, second, mserviceId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if (project.GetName() != "beta") || (project.GetVersion() != 1) {
+		t.Errorf("project: got %s version %d, want beta version 1", project.GetName(), project.GetVersion())
+	}
+
+	webhookId, err := store.Webhooks().CreateWebhook(ctx, &pb.Webhook{MserviceId: mserviceId,
+		Url: "https://hooks.example.com/mproject", EventTypes: []string{"task_created"}, IsActive: true}, "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	deliveryId, err := store.Webhooks().CreateDelivery(ctx, &pb.WebhookDelivery{MserviceId: mserviceId,
+		WebhookId: webhookId, EventType: "task_created", Sequence: 1, Payload: "{}"}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	delivery, url, secret, err := store.Webhooks().GetDelivery(ctx, deliveryId, mserviceId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if (delivery.GetWebhookId() != webhookId) || (url != "https://hooks.example.com/mproject") || (secret != "secret") {
+		t.Errorf("delivery: got %v %s %s", delivery, url, secret)
+	}
+}
+
+// Updates and deletes at a stale version change nothing.
+func testVersionConflicts(t *testing.T, store Store) {
+	ctx := context.Background()
+	mserviceId := newTestAccount(t, store)
+	projectId := createProject(t, store, mserviceId, "alpha")
+
+	project := &pb.Project{ProjectId: projectId, MserviceId: mserviceId, Version: 1, Name: "beta",
+		Description: "renamed", StatusId: 1, StartDate: dml.DateTimeFromString("2030-01-07"),
+		EndDate: dml.DateTimeFromString("2030-03-01")}
+
+	err := store.Projects().UpdateProject(ctx, project)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	project.Name = "gamma"
+	err = store.Projects().UpdateProject(ctx, project)
+	if !errors.Is(err, ErrVersionConflict) {
+		t.Errorf("stale update: got %v, want ErrVersionConflict", err)
+	}
+
+	err = store.Projects().DeleteProject(ctx, projectId, mserviceId, 1)
+	if !errors.Is(err, ErrVersionConflict) {
+		t.Errorf("stale delete: got %v, want ErrVersionConflict", err)
+	}
+
+	current, err := store.Projects().GetProjectById(ctx, projectId, mserviceId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if (current.GetName() != "beta") || (current.GetVersion() != 2) {
+		t.Errorf("project: got %s version %d, want beta version 2", current.GetName(), current.GetVersion())
+	}
+
+	err = store.Projects().DeleteProject(ctx, projectId, mserviceId, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = store.Projects().GetProjectById(ctx, projectId, mserviceId)
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("deleted project: got %v, want ErrNotFound", err)
+	}
+
+	// a deleted row is not found, whatever the version
+	project.Version = 3
+	err = store.Projects().UpdateProject(ctx, project)
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("update of deleted project: got %v, want ErrNotFound", err)
+	}
+}
+
+// Unique keys fail the statement as a Duplicate error, including for deleted rows.
+func testUniqueNames(t *testing.T, store Store) {
+	ctx := context.Background()
+	mserviceId := newTestAccount(t, store)
+	projectId := createProject(t, store, mserviceId, "alpha")
+
+	err := store.Projects().DeleteProject(ctx, projectId, mserviceId, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = store.Projects().CreateProject(ctx, &pb.Project{MserviceId: mserviceId, Name: "alpha",
+		Description: "again", StatusId: 1, StartDate: dml.DateTimeFromString("2030-01-07"),
+		EndDate: dml.DateTimeFromString("2030-03-01")})
+
+	var dbErr *DbError
+	if !errors.As(err, &dbErr) || (dbErr.What != "Duplicate") {
+		t.Errorf("duplicate name: got %v, want a Duplicate DbError", err)
+	}
+}
+
+// A removed assignment is restored by adding it again, at a later version and with its task hours.
+func testAssignmentReuse(t *testing.T, store Store) {
+	ctx := context.Background()
+	mserviceId := newTestAccount(t, store)
+	projectId := createProject(t, store, mserviceId, "alpha")
+
+	taskId, err := store.Tasks().CreateTask(ctx, &pb.Task{MserviceId: mserviceId, ProjectId: projectId,
+		Name: "build", Description: "test task", StatusId: 1, StartDate: dml.DateTimeFromString("2030-01-07"),
+		EndDate: dml.DateTimeFromString("2030-01-18")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	memberId := createMember(t, store, mserviceId, projectId, "ann")
+
+	version, err := store.Assignments().AddAssignment(ctx, projectId, taskId, memberId, mserviceId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = store.Assignments().AddAssignment(ctx, projectId, taskId, memberId, mserviceId)
+	if err == nil {
+		t.Error("assignment added twice")
+	}
+
+	hours, _ := dml.DecimalFromString("6.50")
+	err = store.Assignments().AddTaskHours(ctx, projectId, taskId, memberId, mserviceId, version, hours)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	version++
+
+	err = store.Assignments().RemoveAssignment(ctx, projectId, taskId, memberId, mserviceId, version+1)
+	if !errors.Is(err, ErrVersionConflict) {
+		t.Errorf("stale remove: got %v, want ErrVersionConflict", err)
+	}
+
+	err = store.Assignments().RemoveAssignment(ctx, projectId, taskId, memberId, mserviceId, version)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	members, err := store.Members().GetMembersByTask(ctx, projectId, taskId, mserviceId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(members) != 0 {
+		t.Errorf("members after remove: got %d, want 0", len(members))
+	}
+
+	restored, err := store.Assignments().AddAssignment(ctx, projectId, taskId, memberId, mserviceId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if restored <= version+1 {
+		t.Errorf("restored version: got %d, want more than %d", restored, version+1)
+	}
+
+	members, err = store.Members().GetMembersByTask(ctx, projectId, taskId, mserviceId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if (len(members) != 1) || (members[0].GetAssignmentVersion() != restored) {
+		t.Fatalf("members after restore: got %v, want one at version %d", members, restored)
+	}
+
+	if members[0].GetTaskHours().StringFromDecimal() != "6.50" {
+		t.Errorf("task hours after restore: got %s, want 6.50", members[0].GetTaskHours().StringFromDecimal())
+	}
+}
+
+// A deleted person keeps its email, and is restored rather than created again.
+func testPersonReuse(t *testing.T, store Store) {
+	ctx := context.Background()
+	mserviceId := newTestAccount(t, store)
+
+	personId, err := store.Persons().CreatePerson(ctx, &pb.Person{MserviceId: mserviceId, Name: "ann",
+		Email: "ann@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = store.Persons().DeletePerson(ctx, personId, mserviceId, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = store.Persons().GetPersonById(ctx, personId, mserviceId)
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("deleted person: got %v, want ErrNotFound", err)
+	}
+
+	deleted, err := store.Persons().GetPersonByEmail(ctx, "ann@example.com", mserviceId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !deleted.GetIsDeleted() || (deleted.GetVersion() != 2) {
+		t.Errorf("person by email: got deleted %v version %d, want deleted version 2", deleted.GetIsDeleted(),
+			deleted.GetVersion())
+	}
+
+	err = store.Persons().RestorePerson(ctx, personId, mserviceId, 2, "ann b")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	person, err := store.Persons().GetPersonById(ctx, personId, mserviceId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if (person.GetName() != "ann b") || (person.GetVersion() != 3) {
+		t.Errorf("restored person: got %s version %d, want ann b version 3", person.GetName(), person.GetVersion())
+	}
+}
+
+// A failed transaction leaves nothing behind.
+func testRollback(t *testing.T, store Store) {
+	ctx := context.Background()
+	mserviceId := newTestAccount(t, store)
+	failed := errors.New("failed")
+
+	err := store.WithTx(ctx, func(tx Store) error {
+		createProject(t, tx, mserviceId, "alpha")
+		return failed
+	})
+	if err != failed {
+		t.Fatalf("WithTx: got %v, want %v", err, failed)
+	}
+
+	names, err := store.Projects().GetProjectNames(ctx, mserviceId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(names) != 0 {
+		t.Errorf("projects after rollback: got %v, want none", names)
+	}
+}
+
+// A person is a team member of a project once, names need not be unique, and copying the name and email between
+// a person and their team members leaves the versions alone.
+func testPersonMembers(t *testing.T, store Store) {
+	ctx := context.Background()
+	mserviceId := newTestAccount(t, store)
+	alphaId := createProject(t, store, mserviceId, "alpha")
+	betaId := createProject(t, store, mserviceId, "beta")
+
+	annId := createMember(t, store, mserviceId, alphaId, "ann")
+	createMember(t, store, mserviceId, alphaId, "bob")
+
+	ann, err := store.Members().GetMemberById(ctx, annId, mserviceId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = store.Members().CreateMember(ctx, &pb.TeamMember{MserviceId: mserviceId, ProjectId: alphaId,
+		Name: "ann again", ProjectRoleId: 1, Email: "ann@example.com", PersonId: ann.GetPersonId()})
+	if err == nil {
+		t.Error("person added to a project twice")
+	}
+
+	betaAnnId, err := store.Members().CreateMember(ctx, &pb.TeamMember{MserviceId: mserviceId, ProjectId: betaId,
+		Name: "ann", ProjectRoleId: 1, Email: "ann@example.com", PersonId: ann.GetPersonId()})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = store.Members().UpdateMember(ctx, &pb.TeamMember{MemberId: annId, Version: 1, MserviceId: mserviceId,
+		Name: "bob", ProjectRoleId: 1, Email: "ann.b@example.com"})
+	if err != nil {
+		t.Fatalf("member sharing a name: %v", err)
+	}
+
+	err = store.Persons().UpdatePersonFromMember(ctx, annId, ann.GetPersonId(), mserviceId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = store.Persons().SyncPersonMembers(ctx, ann.GetPersonId(), mserviceId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	person, err := store.Persons().GetPersonById(ctx, ann.GetPersonId(), mserviceId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if (person.GetEmail() != "ann.b@example.com") || (person.GetVersion() != 1) {
+		t.Errorf("person: got %s version %d, want ann.b@example.com version 1", person.GetEmail(), person.GetVersion())
+	}
+
+	betaAnn, err := store.Members().GetMemberById(ctx, betaAnnId, mserviceId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if (betaAnn.GetEmail() != "ann.b@example.com") || (betaAnn.GetVersion() != 1) {
+		t.Errorf("other member: got %s version %d, want ann.b@example.com version 1", betaAnn.GetEmail(),
+			betaAnn.GetVersion())
+	}
+}
+
+// A due reminder goes once to members who have not opted out, claimed by one server, until the task is modified.
+func testDueNotifications(t *testing.T, store Store) {
+	ctx := context.Background()
+	mserviceId := newTestAccount(t, store)
+	projectId := createProject(t, store, mserviceId, "alpha")
+
+	now := time.Now()
+	taskId, err := store.Tasks().CreateTask(ctx, &pb.Task{MserviceId: mserviceId, ProjectId: projectId,
+		Name: "build", Description: "test task", StatusId: 1, StartDate: dml.DateTimeFromTime(now),
+		EndDate: dml.DateTimeFromTime(now.Add(24 * time.Hour))})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	memberIds := make([]int64, 0)
+	for _, name := range []string{"ann", "bob"} {
+		memberId := createMember(t, store, mserviceId, projectId, name)
+
+		_, err = store.Assignments().AddAssignment(ctx, projectId, taskId, memberId, mserviceId)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		memberIds = append(memberIds, memberId)
+	}
+
+	err = store.Members().SetMemberNotify(ctx, memberIds[1], mserviceId, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the start is a day back, as postgres and sqlite compare the stored time in UTC
+	due := func() []*Recipient {
+		t.Helper()
+		all, err := store.Notifications().GetDueRecipients(ctx, now.Add(-24*time.Hour), now.Add(48*time.Hour),
+			"task_due")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		recipients := make([]*Recipient, 0)
+		for _, recipient := range all {
+			if recipient.MserviceId == mserviceId {
+				recipients = append(recipients, recipient)
+			}
+		}
+		return recipients
+	}
+
+	recipients := due()
+	if (len(recipients) != 1) || (recipients[0].MemberId != memberIds[0]) || (recipients[0].TaskName != "build") {
+		t.Fatalf("due recipients: got %v, want ann", recipients)
+	}
+
+	reminder := Notification{MserviceId: mserviceId, MemberId: memberIds[0], TaskId: taskId, Kind: "task_due",
+		Email: "ann@example.com", Subject: "due"}
+	notificationId, err := store.Notifications().ClaimReminder(ctx, &reminder, 0, 60)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = store.Notifications().ClaimReminder(ctx, &reminder, 0, 60); err != ErrNotFound {
+		t.Errorf("second claim: got %v, want %v", err, ErrNotFound)
+	}
+
+	if recipients = due(); len(recipients) != 0 {
+		t.Errorf("due recipients while claimed: got %v, want none", recipients)
+	}
+
+	// a failed send is due again once its retry delay has passed, the second of the stored time rounded down
+	err = store.Notifications().RecordReminder(ctx, notificationId, "relay down", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	time.Sleep(2100 * time.Millisecond)
+
+	recipients = due()
+	if (len(recipients) != 1) || (recipients[0].NotificationId != notificationId) || (recipients[0].Attempts != 1) {
+		t.Fatalf("due recipients after failed send: got %v, want ann's reminder after 1 attempt", recipients)
+	}
+
+	if _, err = store.Notifications().ClaimReminder(ctx, &reminder, notificationId, 60); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = store.Notifications().ClaimReminder(ctx, &reminder, notificationId, 60); err != ErrNotFound {
+		t.Errorf("second retry claim: got %v, want %v", err, ErrNotFound)
+	}
+
+	// a failed send with no attempt left is not retried
+	err = store.Notifications().RecordReminder(ctx, notificationId, "relay down", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if recipients = due(); len(recipients) != 0 {
+		t.Errorf("due recipients after last attempt: got %v, want none", recipients)
+	}
+
+	if _, err = store.Notifications().ClaimReminder(ctx, &reminder, notificationId, 60); err != ErrNotFound {
+		t.Errorf("claim after last attempt: got %v, want %v", err, ErrNotFound)
+	}
+
+	recipients, err = store.Notifications().GetTaskRecipients(ctx, taskId, mserviceId, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(recipients) != 1 {
+		t.Errorf("task recipients: got %d, want 1", len(recipients))
+	}
+}
+
+// The first use of an api key is recorded, and later uses within a minute leave it alone.
+func testApiKeyUse(t *testing.T, store Store) {
+	ctx := context.Background()
+	mserviceId := newTestAccount(t, store)
+
+	apiKeyId, err := store.ApiKeys().CreateApiKey(ctx, &pb.ApiKey{MserviceId: mserviceId, Name: "ci",
+		KeyPrefix: "mpk_test", Role: "projro", Expires: dml.DateTimeFromTime(time.Now().Add(24 * time.Hour))},
+		"hash"+strconv.FormatInt(mserviceId, 10))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lastUsed := func() *dml.DateTime {
+		t.Helper()
+		apiKeys, err := store.ApiKeys().GetApiKeys(ctx, mserviceId)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, apiKey := range apiKeys {
+			if apiKey.GetApiKeyId() == apiKeyId {
+				return apiKey.GetLastUsed()
+			}
+		}
+
+		t.Fatalf("api key %d not found", apiKeyId)
+		return nil
+	}
+
+	if lastUsed() != nil {
+		t.Fatalf("new api key: got last used %v, want none", lastUsed())
+	}
+
+	err = store.ApiKeys().TouchApiKey(ctx, apiKeyId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	first := lastUsed()
+	if first == nil {
+		t.Fatal("used api key: got no last used")
+	}
+
+	time.Sleep(1100 * time.Millisecond)
+
+	err = store.ApiKeys().TouchApiKey(ctx, apiKeyId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !lastUsed().TimeFromDateTime().Equal(first.TimeFromDateTime()) {
+		t.Errorf("api key used again: got last used %v, want %v", lastUsed(), first)
+	}
+}
+
+// A delivery is attempted by one claimant at a time, and is due again only when a retry is recorded.
+func testDeliveryAttempts(t *testing.T, store Store) {
+	ctx := context.Background()
+	mserviceId := newTestAccount(t, store)
+
+	webhookId, err := store.Webhooks().CreateWebhook(ctx, &pb.Webhook{MserviceId: mserviceId,
+		Url: "https://hooks.example.com/mproject", EventTypes: []string{"task_created"}, IsActive: true}, "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	deliveryId, err := store.Webhooks().CreateDelivery(ctx, &pb.WebhookDelivery{MserviceId: mserviceId,
+		WebhookId: webhookId, EventType: "task_created", Sequence: 1, Payload: "{}"}, 60)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	isDue := func() bool {
+		t.Helper()
+		deliveries, err := store.Webhooks().GetDueDeliveries(ctx, 1000)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, delivery := range deliveries {
+			if delivery.GetDeliveryId() == deliveryId {
+				return true
+			}
+		}
+		return false
+	}
+
+	if isDue() {
+		t.Error("held delivery is due")
+	}
+
+	err = store.Webhooks().ClaimDelivery(ctx, deliveryId, mserviceId, 60)
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("claim of held delivery: got %v, want ErrNotFound", err)
+	}
+
+	err = store.Webhooks().RecordAttempt(ctx, deliveryId, mserviceId, 503, "unexpected status 503", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = store.Webhooks().RetryDelivery(ctx, deliveryId, mserviceId, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !isDue() {
+		t.Fatal("delivery to retry is not due")
+	}
+
+	err = store.Webhooks().ClaimDelivery(ctx, deliveryId, mserviceId, 60)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = store.Webhooks().ClaimDelivery(ctx, deliveryId, mserviceId, 60)
+	if !errors.Is(err, ErrNotFound) {
+		t.Errorf("second claim: got %v, want ErrNotFound", err)
+	}
+
+	err = store.Webhooks().RecordAttempt(ctx, deliveryId, mserviceId, 200, "", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	delivery, _, _, err := store.Webhooks().GetDelivery(ctx, deliveryId, mserviceId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !delivery.GetIsDelivered() || (delivery.GetAttempts() != 2) || isDue() {
+		t.Errorf("delivered: got delivered %v attempts %d due %v, want delivered after 2 attempts and not due",
+			delivery.GetIsDelivered(), delivery.GetAttempts(), isDue())
+	}
+}
@@ -0,0 +1 @@
+CREATE DATABASE mproject ENCODING 'UTF8';